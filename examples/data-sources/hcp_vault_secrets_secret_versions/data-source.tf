@@ -0,0 +1,4 @@
+data "hcp_vault_secrets_secret_versions" "example" {
+  app_name    = "example-vault-secrets-app"
+  secret_name = "my_secret"
+}
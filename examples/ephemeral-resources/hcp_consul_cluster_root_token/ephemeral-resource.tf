@@ -0,0 +1,10 @@
+ephemeral "hcp_consul_cluster_root_token" "example" {
+  cluster_id = "consul-cluster-1"
+}
+
+provider "consul" {
+  address    = hcp_consul_cluster.example.consul_public_endpoint_url
+  datacenter = hcp_consul_cluster.example.datacenter
+  ca_pem     = ephemeral.hcp_consul_cluster_root_token.example.consul_ca
+  token      = ephemeral.hcp_consul_cluster_root_token.example.secret_id
+}
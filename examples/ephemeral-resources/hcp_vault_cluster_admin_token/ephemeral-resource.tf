@@ -0,0 +1,13 @@
+data "hcp_vault_cluster" "example" {
+  cluster_id = "test-vault-cluster"
+}
+
+ephemeral "hcp_vault_cluster_admin_token" "example" {
+  cluster_id = data.hcp_vault_cluster.example.cluster_id
+}
+
+provider "vault" {
+  address   = data.hcp_vault_cluster.example.vault_public_endpoint_url
+  namespace = data.hcp_vault_cluster.example.namespace
+  token     = ephemeral.hcp_vault_cluster_admin_token.example.token
+}
@@ -0,0 +1,3 @@
+ephemeral "hcp_vault_secrets_app" "example" {
+  app_name = "example-vault-secrets-app"
+}
@@ -0,0 +1,4 @@
+ephemeral "hcp_vault_secrets_secret" "example" {
+  app_name    = "example-vault-secrets-app"
+  secret_name = "my_secret"
+}
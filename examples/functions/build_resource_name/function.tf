@@ -0,0 +1,16 @@
+variable "project_id" {
+  type = string
+}
+
+output "app_resource_name" {
+  value = provider::hcp::build_resource_name("vault-secrets", [
+    { type = "project", name = var.project_id },
+    { type = "app", name = "example-app" },
+  ])
+}
+
+output "project_resource_name" {
+  value = provider::hcp::build_resource_name(null, [
+    { type = "project", name = var.project_id },
+  ])
+}
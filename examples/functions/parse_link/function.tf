@@ -0,0 +1,14 @@
+resource "hcp_hvn" "example" {
+  hvn_id         = "hvn"
+  cloud_provider = "aws"
+  region         = "us-west-2"
+  cidr_block     = "172.25.16.0/20"
+}
+
+locals {
+  hvn = provider::hcp::parse_link(hcp_hvn.example.self_link)
+}
+
+output "hvn_type" {
+  value = local.hvn.type
+}
@@ -0,0 +1,15 @@
+resource "hcp_service_principal" "example" {
+  name = "example-sp"
+}
+
+locals {
+  service_principal = provider::hcp::parse_resource_name(hcp_service_principal.example.resource_name)
+}
+
+output "service_principal_project_id" {
+  value = local.service_principal.project_id
+}
+
+output "service_principal_name" {
+  value = local.service_principal.name
+}
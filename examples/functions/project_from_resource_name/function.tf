@@ -0,0 +1,7 @@
+resource "hcp_service_principal" "example" {
+  name = "example-sp"
+}
+
+output "service_principal_project_id" {
+  value = provider::hcp::project_from_resource_name(hcp_service_principal.example.resource_name)
+}
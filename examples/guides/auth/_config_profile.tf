@@ -0,0 +1,4 @@
+// Uses the login and the default project of the "dev" profile of the HCP CLI.
+provider "hcp" {
+  profile = "dev"
+}
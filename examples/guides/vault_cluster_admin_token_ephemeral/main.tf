@@ -0,0 +1,14 @@
+resource "hcp_vault_cluster" "example_vault_cluster" {
+  hvn_id     = hcp_hvn.example_hvn.hvn_id
+  cluster_id = "hcp-tf-example-vault-cluster"
+}
+
+ephemeral "hcp_vault_cluster_admin_token" "example_vault_admin_token" {
+  cluster_id = hcp_vault_cluster.example_vault_cluster.cluster_id
+}
+
+provider "vault" {
+  address   = hcp_vault_cluster.example_vault_cluster.vault_public_endpoint_url
+  namespace = hcp_vault_cluster.example_vault_cluster.namespace
+  token     = ephemeral.hcp_vault_cluster_admin_token.example_vault_admin_token.token
+}
@@ -0,0 +1,7 @@
+removed {
+  from = hcp_vault_cluster_admin_token.example_vault_admin_token
+
+  lifecycle {
+    destroy = false
+  }
+}
@@ -0,0 +1,11 @@
+resource "hcp_vault_secrets_app" "example" {
+  app_name    = "example-app-name"
+  description = "My new app!"
+}
+resource "hcp_vault_secrets_app_secrets" "example" {
+  app_name = hcp_vault_secrets_app.example.app_name
+  secrets = {
+    database_password = "a test secret"
+    api_key           = "another test secret"
+  }
+}
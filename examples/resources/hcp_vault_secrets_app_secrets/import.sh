@@ -0,0 +1,7 @@
+# Vault Secrets app secrets can be imported by specifying the name of the app,
+# which imports all static secrets of the app.
+terraform import hcp_vault_secrets_app_secrets.example example-app-name
+
+# Append ":authoritative" to the name of the app to manage the imported secrets
+# authoritatively.
+terraform import hcp_vault_secrets_app_secrets.example example-app-name:authoritative
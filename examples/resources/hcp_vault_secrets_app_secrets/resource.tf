@@ -0,0 +1,7 @@
+resource "hcp_vault_secrets_app_secrets" "example" {
+  app_name = "example-app-name"
+  secrets = {
+    database_password = "hashi123"
+    api_key           = "hashi456"
+  }
+}
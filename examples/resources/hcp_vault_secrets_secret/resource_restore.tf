@@ -0,0 +1,5 @@
+resource "hcp_vault_secrets_secret" "example" {
+  app_name        = "example-app-name"
+  secret_name     = "example_secret"
+  restore_version = 2
+}
@@ -0,0 +1,6 @@
+resource "hcp_vault_secrets_secret" "example" {
+  app_name                = "example-app-name"
+  secret_name             = "example_secret"
+  secret_value_wo         = "hashi123"
+  secret_value_wo_version = 1
+}
@@ -4,16 +4,14 @@
 package clients
 
 import (
-	"errors"
 	"fmt"
-	"log"
-	"strings"
+	"net/http"
+	"os"
 
 	"github.com/hashicorp/hcp-sdk-go/auth"
 	"github.com/hashicorp/hcp-sdk-go/auth/workload"
 	"github.com/hashicorp/hcp-sdk-go/config/geography"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	cloud_billing "github.com/hashicorp/hcp-sdk-go/clients/cloud-billing/preview/2020-11-05/client"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-billing/preview/2020-11-05/client/billing_account_service"
@@ -68,6 +66,8 @@ import (
 	radar_resource_service "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-radar/preview/2023-05-01/client/resource_service"
 	radar_secret_manager_service "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-radar/preview/2023-05-01/client/secret_manager_service"
 
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
 	hcpConfig "github.com/hashicorp/hcp-sdk-go/config"
 	sdk "github.com/hashicorp/hcp-sdk-go/httpclient"
 )
@@ -100,6 +100,14 @@ type Client struct {
 	RadarSubscriptionService       radar_subscription_service.ClientService
 	RadarResourceService           radar_resource_service.ClientService
 	RadarSecretManagerService      radar_secret_manager_service.ClientService
+
+	// resolution is the state of clients created by NewDeferredClient, it's
+	// nil for clients created by NewClient. It's shared by the clients of
+	// the same configuration.
+	resolution *resolution
+	// located is set once the organization and project of the resolution
+	// have been set on Config, it's guarded by resolution.mu.
+	located bool
 }
 
 // ClientConfig specifies configuration for the client that interacts with HCP
@@ -121,6 +129,21 @@ type ClientConfig struct {
 	WorkloadIdentityToken        string
 	WorkloadIdentityResourceName string
 
+	// Profile (optional) is the name of the HCP CLI profile to use, it's read
+	// from the HCP_PROFILE environment variable if unset. The project of the
+	// profile is used if ProjectID is unset, and the login cached by the CLI
+	// if no other credentials are configured.
+	Profile string
+
+	// TokenCacheDir (optional) is the directory in which the tokens of
+	// service principals and workload identities are cached, such that other
+	// provider processes with the same credentials reuse them instead of
+	// exchanging new ones. The tokens are encrypted with TokenCacheKey. Both
+	// are read from the HCP_TOKEN_CACHE_DIR and HCP_TOKEN_CACHE_KEY
+	// environment variables if unset.
+	TokenCacheDir string
+	TokenCacheKey string
+
 	// OrganizationID (optional) is the organization unique identifier to launch resources in.
 	OrganizationID string
 
@@ -133,10 +156,70 @@ type ClientConfig struct {
 
 	// Geography denotes the geography the HCP client should operate in.
 	Geography string
+
+	// MaxRetries is the maximum number of times a request that failed with a
+	// transient error is retried. Zero disables retries.
+	MaxRetries int
+
+	// RateLimits configures the client-side rate limiting of requests to
+	// the individual HCP services.
+	RateLimits RateLimits
+
+	// HCPConfigOptions (optional) are applied after all other options when
+	// creating the HCP config. Tests use them to send requests to a fake HCP
+	// API, see the hcpfake package.
+	HCPConfigOptions []hcpConfig.HCPConfigOption
+
+	// WrapTransport (optional) wraps the transport that sends requests to the
+	// HCP API, below retries and rate limiting. Tests use it to record and
+	// replay the requests made to the HCP API.
+	WrapTransport func(http.RoundTripper) http.RoundTripper
 }
 
 // NewClient creates a new Client that is capable of making HCP requests
 func NewClient(config ClientConfig) (*Client, error) {
+	client, hcp, err := newClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch a token to verify that we have valid credentials
+	if _, err := hcp.Token(); err != nil {
+		return nil, credentialsError(client.Config, err)
+	}
+
+	return client, nil
+}
+
+// credentialsError returns the error of a client whose credentials couldn't
+// be verified.
+func credentialsError(config ClientConfig, err error) error {
+	if config.Profile != "" {
+		return fmt.Errorf("no valid credentials available, log in with `hcp auth login` to use the HCP CLI profile %q: %w", config.Profile, err)
+	}
+	return fmt.Errorf("no valid credentials available: %w", err)
+}
+
+// NewDeferredClient creates a Client like NewClient, but without making any
+// requests, such that the provider can be configured without network access.
+// The credentials are only verified, and the organization and project of the
+// config resolved, once Resolve is called before the client is first used.
+func NewDeferredClient(config ClientConfig) (*Client, error) {
+	client, hcp, err := newClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	client.resolution = sharedResolution(client.Config, func() error {
+		_, err := hcp.Token()
+		return err
+	})
+
+	return client, nil
+}
+
+// newClient creates a new Client and returns it along with its HCP config.
+func newClient(config ClientConfig) (*Client, hcpConfig.HCPConfig, error) {
 	// Build the HCP Config options
 	opts := []hcpConfig.HCPConfigOption{hcpConfig.FromEnv()}
 	if config.ClientID != "" && config.ClientSecret != "" {
@@ -147,6 +230,25 @@ func NewClient(config ClientConfig) (*Client, error) {
 		opts = append(opts, hcpConfig.WithCredentialFile(cf))
 	}
 
+	if config.Profile == "" {
+		config.Profile = os.Getenv(profileEnvVar)
+	}
+	if config.Profile != "" {
+		p, err := loadProfile(config.Profile)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if config.ProjectID == "" && p.ProjectID != "" {
+			config.OrganizationID = p.OrganizationID
+			config.ProjectID = p.ProjectID
+		}
+
+		// The CLI logs in interactively, the provider only uses the login
+		// the CLI cached.
+		opts = append(opts, hcpConfig.WithoutBrowserLogin())
+	}
+
 	if config.Geography == "" {
 		// If geography is not set, default to the one used by the SDK.
 		// Currently default is us.
@@ -155,59 +257,94 @@ func NewClient(config ClientConfig) (*Client, error) {
 		opts = append(opts, hcpConfig.WithGeography(config.Geography))
 	}
 
+	opts = append(opts, config.HCPConfigOptions...)
+
 	// Create the HCP Config
 	hcp, err := hcpConfig.NewHCPConfig(opts...)
 	if err != nil {
-		return nil, fmt.Errorf("invalid HCP config: %w", err)
+		return nil, nil, fmt.Errorf("invalid HCP config: %w", err)
 	}
 
-	// Fetch a token to verify that we have valid credentials
-	if _, err := hcp.Token(); err != nil {
-		return nil, fmt.Errorf("no valid credentials available: %w", err)
+	hcp, err = withTokenCache(hcp, config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid token cache config: %w", err)
 	}
 
+	// The source channel is stamped by the transport of the client, as it
+	// depends on the module of every request.
 	httpClient, err := sdk.New(sdk.Config{
-		HCPConfig:     hcp,
-		SourceChannel: config.SourceChannel,
+		HCPConfig: hcp,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	transport := newLoggingTransport(newSourceChannelTransport(httpClient.Transport, config.SourceChannel))
+	if config.WrapTransport != nil {
+		transport = config.WrapTransport(transport)
 	}
 
-	httpClient.SetLogger(logger{})
-	if ShouldLog() {
-		httpClient.Debug = true
+	// Every service gets its own runtime, so that requests to the services can
+	// be rate limited independently. The limiters are shared by the clients
+	// of the same configuration. Transient failures are retried on top
+	// of the rate limit, so every attempt counts against it.
+	// The operations of every service are traced, and every attempt to send
+	// their requests within them.
+	runtimes := make(map[string]runtime.ClientTransport)
+	serviceRuntime := func(service string) runtime.ClientTransport {
+		if rt, ok := runtimes[service]; ok {
+			return rt
+		}
+
+		rt := httptransport.New(httpClient.Host, httpClient.BasePath, []string{apiScheme(hcp)})
+		rt.Transport = newRetryTransport(
+			newTracingTransport(newRateLimitTransport(transport, service, sharedServiceLimiters(config, service))),
+			config.MaxRetries,
+		)
+		rt.SetLogger(logger{})
+
+		runtimes[service] = newTracingClientTransport(rt, service)
+		return runtimes[service]
 	}
 
 	client := &Client{
 		Config:                         config,
-		Billing:                        cloud_billing.New(httpClient, nil).BillingAccountService,
-		Boundary:                       cloud_boundary.New(httpClient, nil).BoundaryService,
-		Consul:                         cloud_consul.New(httpClient, nil).ConsulService,
-		IAM:                            cloud_iam.New(httpClient, nil).IamService,
-		Network:                        cloud_network.New(httpClient, nil).NetworkService,
-		Operation:                      cloud_operation.New(httpClient, nil).OperationService,
-		Organization:                   cloud_resource_manager.New(httpClient, nil).OrganizationService,
-		Packer:                         cloud_packer.New(httpClient, nil).PackerService,
-		PackerV2:                       cloud_packer_v2.New(httpClient, nil).PackerService,
-		Project:                        cloud_resource_manager.New(httpClient, nil).ProjectService,
-		ServicePrincipals:              cloud_iam.New(httpClient, nil).ServicePrincipalsService,
-		Groups:                         cloud_iam.New(httpClient, nil).GroupsService,
-		Vault:                          cloud_vault.New(httpClient, nil).VaultService,
-		VaultSecrets:                   cloud_vault_secrets.New(httpClient, nil).SecretService,
-		Waypoint:                       cloud_waypoint.New(httpClient, nil).WaypointService,
-		LogService:                     cloud_log_service.New(httpClient, nil).LogService,
-		LogStreamingService:            cloud_log_service.New(httpClient, nil).StreamingService,
-		Webhook:                        cloud_webhook.New(httpClient, nil).WebhookService,
-		ResourceService:                cloud_resource_manager.New(httpClient, nil).ResourceService,
-		RadarSourceRegistrationService: cloud_vault_radar.New(httpClient, nil).DataSourceRegistrationService,
-		RadarConnectionService:         cloud_vault_radar.New(httpClient, nil).IntegrationConnectionService,
-		RadarSubscriptionService:       cloud_vault_radar.New(httpClient, nil).IntegrationSubscriptionService,
-		RadarResourceService:           cloud_vault_radar.New(httpClient, nil).ResourceService,
-		RadarSecretManagerService:      cloud_vault_radar.New(httpClient, nil).SecretManagerService,
+		Billing:                        cloud_billing.New(serviceRuntime(ServiceBilling), nil).BillingAccountService,
+		Boundary:                       cloud_boundary.New(serviceRuntime(ServiceBoundary), nil).BoundaryService,
+		Consul:                         cloud_consul.New(serviceRuntime(ServiceConsul), nil).ConsulService,
+		IAM:                            cloud_iam.New(serviceRuntime(ServiceIAM), nil).IamService,
+		Network:                        cloud_network.New(serviceRuntime(ServiceNetwork), nil).NetworkService,
+		Operation:                      cloud_operation.New(serviceRuntime(ServiceOperation), nil).OperationService,
+		Organization:                   cloud_resource_manager.New(serviceRuntime(ServiceResourceManager), nil).OrganizationService,
+		Packer:                         cloud_packer.New(serviceRuntime(ServicePacker), nil).PackerService,
+		PackerV2:                       cloud_packer_v2.New(serviceRuntime(ServicePacker), nil).PackerService,
+		Project:                        cloud_resource_manager.New(serviceRuntime(ServiceResourceManager), nil).ProjectService,
+		ServicePrincipals:              cloud_iam.New(serviceRuntime(ServiceIAM), nil).ServicePrincipalsService,
+		Groups:                         cloud_iam.New(serviceRuntime(ServiceIAM), nil).GroupsService,
+		Vault:                          cloud_vault.New(serviceRuntime(ServiceVault), nil).VaultService,
+		VaultSecrets:                   cloud_vault_secrets.New(serviceRuntime(ServiceVaultSecrets), nil).SecretService,
+		Waypoint:                       cloud_waypoint.New(serviceRuntime(ServiceWaypoint), nil).WaypointService,
+		LogService:                     cloud_log_service.New(serviceRuntime(ServiceLogs), nil).LogService,
+		LogStreamingService:            cloud_log_service.New(serviceRuntime(ServiceLogs), nil).StreamingService,
+		Webhook:                        cloud_webhook.New(serviceRuntime(ServiceWebhook), nil).WebhookService,
+		ResourceService:                cloud_resource_manager.New(serviceRuntime(ServiceResourceManager), nil).ResourceService,
+		RadarSourceRegistrationService: cloud_vault_radar.New(serviceRuntime(ServiceVaultRadar), nil).DataSourceRegistrationService,
+		RadarConnectionService:         cloud_vault_radar.New(serviceRuntime(ServiceVaultRadar), nil).IntegrationConnectionService,
+		RadarSubscriptionService:       cloud_vault_radar.New(serviceRuntime(ServiceVaultRadar), nil).IntegrationSubscriptionService,
+		RadarResourceService:           cloud_vault_radar.New(serviceRuntime(ServiceVaultRadar), nil).ResourceService,
+		RadarSecretManagerService:      cloud_vault_radar.New(serviceRuntime(ServiceVaultRadar), nil).SecretManagerService,
 	}
 
-	return client, nil
+	return client, hcp, nil
+}
+
+// apiScheme returns the scheme used to connect to the HCP API, matching the
+// runtime created by the HCP SDK.
+func apiScheme(hcp hcpConfig.HCPConfig) string {
+	if hcp.APITLSConfig() == nil {
+		return "http"
+	}
+	return "https"
 }
 
 // loadCredentialFile loads the credential file from the given config. If the
@@ -262,36 +399,6 @@ func loadCredentialFile(config ClientConfig) *auth.CredentialFile {
 	}
 }
 
-type providerMeta struct {
-	ModuleName string `cty:"module_name"`
-}
-
-// updateSourceChannel updates the SourceChannel of the client
-func (cl *Client) UpdateSourceChannel(d *schema.ResourceData) (*Client, error) {
-
-	// Adds module metadata if any.
-	var m providerMeta
-
-	err := d.GetProviderMeta(&m)
-	if err != nil {
-		return cl, errors.New("failed to get provider meta")
-	}
-
-	if m.ModuleName != "" {
-		sc := cl.Config.SourceChannel
-		sc = strings.Join([]string{sc, fmt.Sprintf("terraform-module/%s", m.ModuleName)}, " ")
-		cl.Config.SourceChannel = sc
-
-		// Return a new client with the updated source channel
-		cl, err = NewClient(cl.Config)
-		if err != nil {
-			log.Printf("failed to create new client with updated source channel: %v", err)
-		}
-	}
-
-	return cl, nil
-}
-
 func (cl *Client) GetOrganizationID() string {
 	if cl == nil {
 		return ""
@@ -4,102 +4,106 @@
 package clients
 
 import (
-	"github.com/cenkalti/backoff/v4"
+	"context"
+
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-iam/stable/2019-12-10/client/groups_service"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-iam/stable/2019-12-10/models"
 )
 
-var groupErrorCodesToRetry = [...]int{502, 503, 504}
-
 // Groups
 
-// CreateGroupRetry wraps the groups client with an exponential backoff retry mechanism.
-func CreateGroupRetry(client *Client, params *groups_service.GroupsServiceCreateGroupParams) (*groups_service.GroupsServiceCreateGroupOK, error) {
-	var res *groups_service.GroupsServiceCreateGroupOK
-	op := func() error {
-		var err error
-		res, err = client.Groups.GroupsServiceCreateGroup(params, nil)
-		if err != nil {
-			return err
-		}
-		if res.Payload.OperationID != "" {
-			loc := &sharedmodels.HashicorpCloudLocationLocation{OrganizationID: client.Config.OrganizationID}
-			return WaitForOperation(params.Context, client, "create group", loc, res.Payload.OperationID)
+// CreateGroup creates a group and waits for the operation to complete, if any.
+func CreateGroup(client *Client, params *groups_service.GroupsServiceCreateGroupParams) (*groups_service.GroupsServiceCreateGroupOK, error) {
+	res, err := client.Groups.GroupsServiceCreateGroup(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res.Payload.OperationID != "" {
+		loc := &sharedmodels.HashicorpCloudLocationLocation{OrganizationID: client.Config.OrganizationID}
+		if err := WaitForOperation(params.Context, client, "create group", loc, res.Payload.OperationID); err != nil {
+			return nil, err
 		}
-		return nil
 	}
 
-	serviceErr := &groups_service.GroupsServiceCreateGroupDefault{}
-	err := backoff.Retry(newBackoffOp(op, serviceErr), newBackoff())
-
-	return res, err
+	return res, nil
 }
 
-// UpdateGroupRetry wraps the groups client with an exponential backoff retry mechanism.
-func UpdateGroupRetry(client *Client, params *groups_service.GroupsServiceUpdateGroup2Params) (*groups_service.GroupsServiceUpdateGroup2OK, error) {
-	var res *groups_service.GroupsServiceUpdateGroup2OK
-	op := func() error {
-		var err error
-		res, err = client.Groups.GroupsServiceUpdateGroup2(params, nil)
-		if err != nil {
-			return err
-		}
-		if res.Payload.OperationID != "" {
-			loc := &sharedmodels.HashicorpCloudLocationLocation{OrganizationID: client.Config.OrganizationID}
-			return WaitForOperation(params.Context, client, "update group", loc, res.Payload.OperationID)
+// UpdateGroup updates a group and waits for the operation to complete, if any.
+func UpdateGroup(client *Client, params *groups_service.GroupsServiceUpdateGroup2Params) (*groups_service.GroupsServiceUpdateGroup2OK, error) {
+	res, err := client.Groups.GroupsServiceUpdateGroup2(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res.Payload.OperationID != "" {
+		loc := &sharedmodels.HashicorpCloudLocationLocation{OrganizationID: client.Config.OrganizationID}
+		if err := WaitForOperation(params.Context, client, "update group", loc, res.Payload.OperationID); err != nil {
+			return nil, err
 		}
-		return nil
 	}
 
-	serviceErr := &groups_service.GroupsServiceUpdateGroup2Default{}
-	err := backoff.Retry(newBackoffOp(op, serviceErr), newBackoff())
-
-	return res, err
+	return res, nil
 }
 
-// DeleteGroupRetry wraps the groups client with an exponential backoff retry mechanism.
-func DeleteGroupRetry(client *Client, params *groups_service.GroupsServiceDeleteGroupParams) (*groups_service.GroupsServiceDeleteGroupOK, error) {
-	var res *groups_service.GroupsServiceDeleteGroupOK
-	op := func() error {
-		var err error
-		res, err = client.Groups.GroupsServiceDeleteGroup(params, nil)
-		if err != nil {
-			return err
-		}
-		if res.Payload.OperationID != "" {
-			loc := &sharedmodels.HashicorpCloudLocationLocation{OrganizationID: client.Config.OrganizationID}
-			return WaitForOperation(params.Context, client, "delete group", loc, res.Payload.OperationID)
+// DeleteGroup deletes a group and waits for the operation to complete, if any.
+func DeleteGroup(client *Client, params *groups_service.GroupsServiceDeleteGroupParams) (*groups_service.GroupsServiceDeleteGroupOK, error) {
+	res, err := client.Groups.GroupsServiceDeleteGroup(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res.Payload.OperationID != "" {
+		loc := &sharedmodels.HashicorpCloudLocationLocation{OrganizationID: client.Config.OrganizationID}
+		if err := WaitForOperation(params.Context, client, "delete group", loc, res.Payload.OperationID); err != nil {
+			return nil, err
 		}
-		return nil
 	}
 
-	serviceErr := &groups_service.GroupsServiceDeleteGroupDefault{}
-	err := backoff.Retry(newBackoffOp(op, serviceErr), newBackoff())
-
-	return res, err
+	return res, nil
 }
 
 // Group Members
 
-// UpdateGroupMembersRetry wraps the groups client with an exponential backoff retry mechanism.
-func UpdateGroupMembersRetry(client *Client, params *groups_service.GroupsServiceUpdateGroupMembersParams) (*groups_service.GroupsServiceUpdateGroupMembersOK, error) {
-	var res *groups_service.GroupsServiceUpdateGroupMembersOK
-	op := func() error {
-		var err error
-		res, err = client.Groups.GroupsServiceUpdateGroupMembers(params, nil)
-		if err != nil {
-			return err
-		}
-		if res.Payload.OperationID != "" {
-			loc := &sharedmodels.HashicorpCloudLocationLocation{OrganizationID: client.Config.OrganizationID}
-			return WaitForOperation(params.Context, client, "update group members", loc, res.Payload.OperationID)
+// UpdateGroupMembers updates the members of a group and waits for the operation to complete, if any.
+func UpdateGroupMembers(client *Client, params *groups_service.GroupsServiceUpdateGroupMembersParams) (*groups_service.GroupsServiceUpdateGroupMembersOK, error) {
+	res, err := client.Groups.GroupsServiceUpdateGroupMembers(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res.Payload.OperationID != "" {
+		loc := &sharedmodels.HashicorpCloudLocationLocation{OrganizationID: client.Config.OrganizationID}
+		if err := WaitForOperation(params.Context, client, "update group members", loc, res.Payload.OperationID); err != nil {
+			return nil, err
 		}
-		return nil
 	}
 
-	serviceErr := &groups_service.GroupsServiceUpdateGroupMembersDefault{}
-	err := backoff.Retry(newBackoffOp(op, serviceErr), newBackoff())
+	return res, nil
+}
+
+// ListGroups lists the groups of an organization, identified by its resource
+// name, paging through all results.
+func ListGroups(ctx context.Context, client *Client, parentResourceName string) ([]*models.HashicorpCloudIamGroup, error) {
+	nextPage := ""
+	var groups []*models.HashicorpCloudIamGroup
+
+	for {
+		params := groups_service.NewGroupsServiceListGroupsParamsWithContext(ctx)
+		params.ParentResourceName = parentResourceName
+		if nextPage != "" {
+			params.PaginationNextPageToken = &nextPage
+		}
 
-	return res, err
+		res, err := client.Groups.GroupsServiceListGroups(params, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, res.Payload.Groups...)
+		pagination := res.Payload.Pagination
+		if pagination == nil || pagination.NextPageToken == "" {
+			return groups, nil
+		}
+
+		nextPage = pagination.NextPageToken
+	}
 }
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// httpLogSubsystem is the tflog subsystem of the requests sent to the HCP
+	// API.
+	httpLogSubsystem = "http"
+
+	// httpLogEnvVar sets the level of the HTTP subsystem, it defaults to the
+	// level of the provider. Bodies are only logged if it's set to TRACE.
+	httpLogEnvVar = "TF_LOG_PROVIDER_HCP_HTTP"
+
+	// requestIDHeader is the header identifying a request to the HCP API,
+	// support requests should reference it.
+	requestIDHeader = "X-Request-Id"
+
+	// redacted replaces the values of the redacted fields of bodies.
+	redacted = "[REDACTED]"
+)
+
+// redactedFieldParts are parts of field names whose values are redacted
+// from bodies. Names are compared without case, underscores and dashes, such
+// that snake_case and camelCase fields match.
+var redactedFieldParts = []string{
+	"secret",
+	"token",
+	"password",
+	"passphrase",
+	"private",
+	"credential",
+	"hmac",
+	"key",
+	"cert",
+	"signature",
+	"jwt",
+	"auth",
+	"cookie",
+	"value",
+}
+
+// allowedFields are fields that are logged although their names contain a
+// redacted part, as they only identify or describe secrets.
+var allowedFields = map[string]bool{
+	"secrets":        true,
+	"secretname":     true,
+	"secretnames":    true,
+	"secrettype":     true,
+	"secretcount":    true,
+	"keyid":          true,
+	"tokentype":      true,
+	"nextpagetoken":  true,
+	"prevpagetoken":  true,
+	"authmethod":     true,
+	"credentialtype": true,
+}
+
+// loggingTransport logs the requests sent to the HCP API to the HTTP
+// subsystem. Requests and responses are logged at the DEBUG level, their
+// JSON bodies at the TRACE level with the values of secret fields redacted.
+// Headers are never logged, as they carry the bearer token.
+type loggingTransport struct {
+	next http.RoundTripper
+}
+
+// newLoggingTransport returns a transport logging the requests sent with
+// next.
+func newLoggingTransport(next http.RoundTripper) http.RoundTripper {
+	return &loggingTransport{next: next}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), httpLogSubsystem, tflog.WithLevelFromEnv(httpLogEnvVar))
+	traceBodies := strings.EqualFold(os.Getenv(httpLogEnvVar), "TRACE")
+
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_path":   req.URL.Path,
+	}
+
+	if traceBodies && req.Body != nil && req.Body != http.NoBody && isJSON(req.Header) {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		// The body was consumed, so the request is cloned to send it again.
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+
+		tflog.SubsystemTrace(ctx, httpLogSubsystem, "HTTP request body", withFields(fields, map[string]interface{}{
+			"http_request_body": redactBody(body),
+		}))
+	}
+
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "Sending HTTP request", fields)
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields = withFields(fields, map[string]interface{}{
+		"http_duration_ms": time.Since(start).Milliseconds(),
+	})
+	if err != nil {
+		tflog.SubsystemDebug(ctx, httpLogSubsystem, "HTTP request failed", withFields(fields, map[string]interface{}{
+			"error": err.Error(),
+		}))
+		return nil, err
+	}
+
+	fields = withFields(fields, map[string]interface{}{
+		"http_status_code": resp.StatusCode,
+		"http_request_id":  resp.Header.Get(requestIDHeader),
+	})
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "Received HTTP response", fields)
+
+	if traceBodies && resp.Body != nil && isJSON(resp.Header) {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		tflog.SubsystemTrace(ctx, httpLogSubsystem, "HTTP response body", withFields(fields, map[string]interface{}{
+			"http_response_body": redactBody(body),
+		}))
+	}
+
+	return resp, nil
+}
+
+// withFields returns a copy of fields with the additional fields set.
+func withFields(fields, additional map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(fields)+len(additional))
+	for k, v := range fields {
+		merged[k] = v
+	}
+	for k, v := range additional {
+		merged[k] = v
+	}
+	return merged
+}
+
+// isJSON returns whether the content type of headers is JSON.
+func isJSON(header http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// redactBody returns the JSON body with the values of secret fields
+// redacted. Bodies that aren't valid JSON are redacted entirely, as their
+// secrets can't be told apart.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return redacted
+	}
+
+	redactedBody, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return redacted
+	}
+	return string(redactedBody)
+}
+
+// redactValue redacts the secret fields of the objects within value.
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for field, fieldValue := range v {
+			if isRedactedField(field) {
+				v[field] = redacted
+				continue
+			}
+			v[field] = redactValue(fieldValue)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// isRedactedField returns whether the value of the field is redacted.
+func isRedactedField(field string) bool {
+	name := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(field))
+	if allowedFields[name] {
+		return false
+	}
+
+	for _, part := range redactedFieldParts {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_redactBody(t *testing.T) {
+	for name, tc := range map[string]struct {
+		body     string
+		expected string
+	}{
+		"secret fields": {
+			body:     `{"name":"db","secret_value":"hunter2","hmacKey":"k","integration":{"aws_access_keys":{"access_key_id":"AKIA"}}}`,
+			expected: `{"hmacKey":"[REDACTED]","integration":{"aws_access_keys":"[REDACTED]"},"name":"db","secret_value":"[REDACTED]"}`,
+		},
+		"nested lists": {
+			body:     `{"secrets":[{"name":"a","static_version":{"value":"s3cr3t","version":1}}],"pagination":{"next_page_token":"abc"}}`,
+			expected: `{"pagination":{"next_page_token":"abc"},"secrets":[{"name":"a","static_version":{"value":"[REDACTED]","version":1}}]}`,
+		},
+		"access token": {
+			body:     `{"access_token":"eyJ","token_type":"Bearer","expires_in":3600}`,
+			expected: `{"access_token":"[REDACTED]","expires_in":3600,"token_type":"Bearer"}`,
+		},
+		"invalid": {
+			body:     `password=hunter2`,
+			expected: redacted,
+		},
+		"empty": {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, redactBody([]byte(tc.body)))
+		})
+	}
+}
+
+func Test_loggingTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(requestIDHeader, "req-1")
+		_, _ = w.Write(body)
+	}))
+	defer srv.Close()
+
+	send := func(t *testing.T) (string, string) {
+		var logs bytes.Buffer
+		ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/secrets/2023-11-28/apps?page=1", strings.NewReader(`{"name":"db","value":"hunter2"}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer token")
+
+		resp, err := newLoggingTransport(http.DefaultTransport).RoundTrip(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return logs.String(), string(body)
+	}
+
+	t.Run("debug", func(t *testing.T) {
+		t.Setenv(httpLogEnvVar, "")
+
+		logs, body := send(t)
+		require.Equal(t, `{"name":"db","value":"hunter2"}`, body)
+
+		entries, err := tflogtest.MultilineJSONDecode(strings.NewReader(logs))
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, "Received HTTP response", entries[1]["@message"])
+		require.Equal(t, "/secrets/2023-11-28/apps", entries[1]["http_path"])
+		require.EqualValues(t, 200, entries[1]["http_status_code"])
+		require.Equal(t, "req-1", entries[1]["http_request_id"])
+		require.Contains(t, entries[1], "http_duration_ms")
+		require.NotContains(t, logs, "hunter2")
+		require.NotContains(t, logs, "Bearer")
+	})
+
+	t.Run("trace", func(t *testing.T) {
+		t.Setenv(httpLogEnvVar, "TRACE")
+
+		logs, body := send(t)
+		require.Equal(t, `{"name":"db","value":"hunter2"}`, body, "bodies must be passed on unchanged")
+		require.Contains(t, logs, "HTTP request body")
+		require.Contains(t, logs, "HTTP response body")
+		require.Contains(t, logs, `\"value\":\"[REDACTED]\"`)
+		require.NotContains(t, logs, "hunter2")
+		require.NotContains(t, logs, "Bearer")
+	})
+}
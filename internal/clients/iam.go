@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	iam "github.com/hashicorp/hcp-sdk-go/clients/cloud-iam/stable/2019-12-10/client/iam_service"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-iam/stable/2019-12-10/client/service_principals_service"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-iam/stable/2019-12-10/models"
 	rmModels "github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
 )
@@ -59,3 +60,31 @@ func IamPrincipalTypeToBindingType(p *models.HashicorpCloudIamPrincipal) (*rmMod
 		return nil, fmt.Errorf("unsupported principal type (%s) for IAM Policy", *p.Type)
 	}
 }
+
+// ListServicePrincipals lists the service principals of an organization or a
+// project, identified by its resource name, paging through all results.
+func ListServicePrincipals(ctx context.Context, client *Client, parentResourceName string) ([]*models.HashicorpCloudIamServicePrincipal, error) {
+	nextPage := ""
+	var servicePrincipals []*models.HashicorpCloudIamServicePrincipal
+
+	for {
+		params := service_principals_service.NewServicePrincipalsServiceListServicePrincipalsParamsWithContext(ctx)
+		params.ParentResourceName = parentResourceName
+		if nextPage != "" {
+			params.PaginationNextPageToken = &nextPage
+		}
+
+		res, err := client.ServicePrincipals.ServicePrincipalsServiceListServicePrincipals(params, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		servicePrincipals = append(servicePrincipals, res.Payload.ServicePrincipals...)
+		pagination := res.Payload.Pagination
+		if pagination == nil || pagination.NextPageToken == "" {
+			return servicePrincipals, nil
+		}
+
+		nextPage = pagination.NextPageToken
+	}
+}
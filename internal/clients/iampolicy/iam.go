@@ -10,7 +10,9 @@ import (
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"golang.org/x/exp/maps"
 )
 
@@ -41,6 +43,42 @@ type TerraformResourceData interface {
 // Factory for generating ResourceIamUpdater for given ResourceData resource
 type NewResourceIamUpdaterFunc func(ctx context.Context, d TerraformResourceData, clients *clients.Client) (ResourceIamUpdater, diag.Diagnostics)
 
+// parentIdentityAttributes returns the identity attributes of the resource
+// IAM policies and bindings apply to, which are the attributes of the parent
+// schema. Policies of resources without them, such as organizations, are
+// identified by the organization of the provider.
+//
+// The Schema of policies and bindings adds their attributes to the parent
+// schema, they are skipped.
+func parentIdentityAttributes(parentSchema schema.Schema) []identity.Attribute {
+	var names []string
+	for name := range parentSchema.Attributes {
+		_, policyAttr := basePolicySchema[name]
+		_, bindingAttr := baseBindingSchema[name]
+		if !policyAttr && !bindingAttr {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return []identity.Attribute{identity.OrganizationID()}
+	}
+	slices.Sort(names)
+
+	attrs := make([]identity.Attribute, 0, len(names))
+	for _, name := range names {
+		if name == "project_id" {
+			attrs = append(attrs, identity.ProjectID())
+			continue
+		}
+
+		attrs = append(attrs, identity.Attribute{
+			Name:        name,
+			Description: parentSchema.Attributes[name].GetDescription(),
+		})
+	}
+	return attrs
+}
+
 // Equal returns if the passed Policies are equal.
 func Equal(p1, p2 *models.HashicorpCloudResourcemanagerPolicy) bool {
 	if p1 == nil && p2 == nil {
@@ -9,6 +9,7 @@ import (
 	"regexp"
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 var (
@@ -98,10 +100,13 @@ func (r *resourceBinding) Configure(_ context.Context, req resource.ConfigureReq
 	r.client = client
 }
 
-func (r *resourceBinding) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceBinding) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: r.parentSchema.MarkdownDescription,
 		Attributes:          r.parentSchema.Attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 
 	if len(resp.Schema.Attributes) == 0 {
@@ -113,6 +118,23 @@ func (r *resourceBinding) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
+// Identity identifies the binding by the resource it applies to, its
+// principal and its role. Both can be updated in place.
+func (r *resourceBinding) Identity() identity.Schema {
+	attrs := parentIdentityAttributes(r.parentSchema)
+	for _, name := range []string{"principal_id", "role"} {
+		attrs = append(attrs, identity.Attribute{
+			Name:        name,
+			Description: baseBindingSchema[name].GetDescription(),
+		})
+	}
+
+	return identity.Schema{
+		Attributes: attrs,
+		Mutable:    true,
+	}
+}
+
 func getBinding(ctx context.Context, d TerraformResourceData) (*models.HashicorpCloudResourcemanagerPolicyBinding, diag.Diagnostics) {
 	var p, role types.String
 	diags := d.GetAttribute(ctx, path.Root("principal_id"), &p)
@@ -133,6 +155,13 @@ func getBinding(ctx context.Context, d TerraformResourceData) (*models.Hashicorp
 }
 
 func (r *resourceBinding) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := withTimeout(ctx, &req.Plan, timeouts.Value.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	updater, diags := r.updaterFunc(ctx, &req.Plan, r.client)
 	if diags.HasError() {
 		resp.Diagnostics.AddError(
@@ -163,6 +192,13 @@ func (r *resourceBinding) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *resourceBinding) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := withTimeout(ctx, &req.State, timeouts.Value.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	updater, diags := r.updaterFunc(ctx, &req.State, r.client)
 	if diags.HasError() {
 		resp.Diagnostics.AddError(
@@ -202,6 +238,13 @@ func (r *resourceBinding) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *resourceBinding) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := withTimeout(ctx, &req.Plan, timeouts.Value.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	updater, diags := r.updaterFunc(ctx, &req.Plan, r.client)
 	if diags.HasError() {
 		resp.Diagnostics.AddError(
@@ -240,6 +283,13 @@ func (r *resourceBinding) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *resourceBinding) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := withTimeout(ctx, &req.State, timeouts.Value.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	updater, diags := r.updaterFunc(ctx, &req.State, r.client)
 	if diags.HasError() {
 		resp.Diagnostics.AddError(
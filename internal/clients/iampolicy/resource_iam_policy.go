@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 var (
@@ -105,10 +107,13 @@ func (r *resourcePolicy) ValidateConfig(ctx context.Context, req resource.Valida
 	}
 }
 
-func (r *resourcePolicy) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourcePolicy) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: r.parentSchema.MarkdownDescription,
 		Attributes:          r.parentSchema.Attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 
 	if len(resp.Schema.Attributes) == 0 {
@@ -120,7 +125,21 @@ func (r *resourcePolicy) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+// Identity identifies the policy by the resource it applies to.
+func (r *resourcePolicy) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: parentIdentityAttributes(r.parentSchema),
+	}
+}
+
 func (r *resourcePolicy) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := withTimeout(ctx, &req.Plan, timeouts.Value.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	updater, diags := r.updaterFunc(ctx, &req.Plan, r.client)
 	if diags.HasError() {
 		resp.Diagnostics.AddError(
@@ -137,6 +156,13 @@ func (r *resourcePolicy) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *resourcePolicy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := withTimeout(ctx, &req.State, timeouts.Value.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	updater, diags := r.updaterFunc(ctx, &req.State, r.client)
 	if diags.HasError() {
 		resp.Diagnostics.AddError(
@@ -155,6 +181,13 @@ func (r *resourcePolicy) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *resourcePolicy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := withTimeout(ctx, &req.Plan, timeouts.Value.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	updater, diags := r.updaterFunc(ctx, &req.Plan, r.client)
 	if diags.HasError() {
 		resp.Diagnostics.AddError(
@@ -171,6 +204,13 @@ func (r *resourcePolicy) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *resourcePolicy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := withTimeout(ctx, &req.State, timeouts.Value.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	updater, diags := r.updaterFunc(ctx, &req.State, r.client)
 	if diags.HasError() {
 		resp.Diagnostics.AddError(
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -52,6 +53,9 @@ func fakeUpdaterFuncWithMock(mockUpdater *mockResourceIamUpdater) NewResourceIam
 func createIamPolicySchema() schema.Schema {
 	return schema.Schema{
 		Attributes: basePolicySchema,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(context.Background()),
+		},
 	}
 }
 
@@ -89,17 +93,20 @@ func createRmPolicy(rmOptions ...rmPolicyOption) *models.HashicorpCloudResourcem
 
 func createIamPolicyValue(rmPolicy *models.HashicorpCloudResourcemanagerPolicy, etag string) tftypes.Value {
 	policyData, _ := rmPolicy.MarshalBinary()
+	timeoutsType := timeouts.BlockAll(context.Background()).Type().TerraformType(context.Background())
 
 	return tftypes.NewValue(
 		tftypes.Object{
 			AttributeTypes: map[string]tftypes.Type{
 				"policy_data": PolicyDataType{}.TerraformType(context.Background()),
 				"etag":        tftypes.String,
+				"timeouts":    timeoutsType,
 			},
 		},
 		map[string]tftypes.Value{
 			"policy_data": tftypes.NewValue(PolicyDataType{}.TerraformType(context.Background()), string(policyData)),
 			"etag":        tftypes.NewValue(tftypes.String, etag),
+			"timeouts":    tftypes.NewValue(timeoutsType, nil),
 		},
 	)
 }
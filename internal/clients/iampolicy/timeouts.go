@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+// defaultTimeout is the timeout of operations on IAM policies and bindings if
+// the configuration doesn't set one.
+var defaultTimeout = time.Minute * 5
+
+// withTimeout returns a context that is canceled once the timeout the
+// operation has in the timeouts block of d expires.
+func withTimeout(
+	ctx context.Context,
+	d TerraformResourceData,
+	operation func(timeouts.Value, context.Context, time.Duration) (time.Duration, diag.Diagnostics),
+) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	return clients.WithOperationTimeout(ctx, d, operation, defaultTimeout)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+)
+
+var linkURLRegex = regexp.MustCompile("^/project/[^/]+/[^/]+/[^/]+$")
+
+// LinkURL generates a URL from the passed link. If the link is invalid, an
+// error is returned. The Link URL is a globally unique, human readable string
+// identifying a resource.
+// The URL includes the project ID, but not the organization, provider or
+// region of the link's location.
+func LinkURL(l *sharedmodels.HashicorpCloudLocationLink) (string, error) {
+	if l == nil {
+		return "", errors.New("nil link")
+	}
+
+	if l.Location == nil {
+		return "", errors.New("link missing Location")
+	}
+
+	// Validate that the link contains the necessary information
+	if l.Location.ProjectID == "" {
+		return "", errors.New("link missing project ID")
+	} else if l.Type == "" {
+		return "", errors.New("link missing resource type")
+	}
+
+	// Determine the ID of the resource
+	id := l.ID
+	if id == "" {
+		return "", errors.New("link missing resource ID")
+	}
+
+	// Generate the URL
+	urn := fmt.Sprintf("/project/%s/%s/%s",
+		l.Location.ProjectID,
+		l.Type,
+		id)
+
+	return urn, nil
+}
+
+// ParseLinkURL parses a link URL into a link. If the URL is malformed, an
+// error is returned.
+//
+// If `expectedType` is provided it will be matched against the resource from
+// the URL and if they don't match the function returns an error. If `expectedType`
+// is an empty string then the resource type just will be inferred from the URL
+// as is.
+//
+// The resulting link location does not include an organization.
+func ParseLinkURL(urn string, expectedType string) (*sharedmodels.HashicorpCloudLocationLink, error) {
+	if !linkURLRegex.MatchString(urn) {
+		return nil, fmt.Errorf("url %q is not in the correct format: /project/{project_id}/{resource_type}/{id}", urn)
+	}
+
+	components := strings.Split(urn, "/")
+
+	if expectedType != "" && expectedType != components[3] {
+		return nil, fmt.Errorf("url %q is not in the correct format: /project/{project_id}/%s/{id}", urn, expectedType)
+	}
+
+	return &sharedmodels.HashicorpCloudLocationLink{
+		Type: components[3],
+		ID:   components[4],
+		Location: &sharedmodels.HashicorpCloudLocationLocation{
+			ProjectID: components[2],
+		},
+	}, nil
+}
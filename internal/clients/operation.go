@@ -5,67 +5,154 @@ package clients
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-operation/stable/2020-05-05/client/operation_service"
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// maxConsecutiveWaitErrors is the maximum number of consecutive http response errors
-// from the operation wait endpoint.
-const maxConsecutiveWaitErrors = 4
+const (
+	// operationMinPollInterval is how often an operation is polled while it
+	// is young. Most operations complete within a few polls.
+	operationMinPollInterval = time.Second * 5
 
-const operationWaitTimeout = time.Second * 5
+	// operationMaxPollInterval is how often long running operations, such as
+	// the creation of a Vault or Consul cluster, are polled at most.
+	operationMaxPollInterval = time.Second * 30
+
+	// operationWaitRequestTimeout is the HTTP timeout of the requests to the
+	// operation wait endpoint. The endpoint holds a request for up to the
+	// poll interval, so the timeout leaves a margin above the longest
+	// interval for the response to arrive.
+	operationWaitRequestTimeout = operationMaxPollInterval + time.Second*15
+)
+
+// operationPollInterval returns how long to wait for an operation that has
+// been waited on for elapsed. The interval grows with the age of the
+// operation, to roughly a tenth of it, such that long running operations
+// don't issue hundreds of requests.
+func operationPollInterval(elapsed time.Duration) time.Duration {
+	interval := elapsed / 10
+	if interval < operationMinPollInterval {
+		return operationMinPollInterval
+	}
+	if interval > operationMaxPollInterval {
+		return operationMaxPollInterval
+	}
+	return interval
+}
+
+// operationErrorBudget returns the number of consecutive errors from the
+// operation wait endpoint after which waiting is given up. Every request is
+// already retried by the transport, so the budget follows the retry policy
+// of the client, but always allows for at least one attempt.
+func operationErrorBudget(client *Client) int {
+	if client.Config.MaxRetries < 1 {
+		return 1
+	}
+	return client.Config.MaxRetries
+}
 
 // WaitForOperation will poll the operation wait endpoint until an operation
-// is DONE, ctx is canceled, or consecutive errors occur waiting for operation to complete.
-func WaitForOperation(ctx context.Context, client *Client, operationName string, loc *sharedmodels.HashicorpCloudLocationLocation, operationID string) error {
+// is DONE, ctx is canceled or its deadline expires, or consecutive errors occur
+// waiting for operation to complete.
+func WaitForOperation(ctx context.Context, client *Client, operationName string, loc *sharedmodels.HashicorpCloudLocationLocation, operationID string) (err error) {
+	// The wait is traced, as it's where most of the time of an apply is
+	// spent, and the requests polling the operation are traced within it.
+	ctx, span := tracer.Start(ctx, "WaitForOperation "+operationName, trace.WithAttributes(
+		attribute.String("hcp.operation.name", operationName),
+		attribute.String("hcp.operation.id", operationID),
+	))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	// Construct operation wait params.
-	waitTimeout := operationWaitTimeout.String()
-	waitParams := operation_service.NewWaitParams()
+	waitParams := operation_service.NewWaitParamsWithTimeout(operationWaitRequestTimeout)
 	waitParams.Context = ctx
 	waitParams.ID = operationID
-	waitParams.Timeout = &waitTimeout
 	waitParams.LocationOrganizationID = loc.OrganizationID
 	waitParams.LocationProjectID = "-"
 	if loc.ProjectID != "" {
 		waitParams.LocationProjectID = loc.ProjectID
 	}
 
+	ctx = tflog.SetField(ctx, "operation_name", operationName)
+	ctx = tflog.SetField(ctx, "operation_id", operationID)
+
+	start := time.Now()
+	budget := operationErrorBudget(client)
+
+	// The last state of the operation that was received, it links to the
+	// resource the operation belongs to.
+	var operation *sharedmodels.HashicorpCloudOperationOperation
+
 	// Start with no consecutive errors.
 	consecutiveErrors := 0
 
 	for {
+		elapsed := time.Since(start)
+		interval := operationPollInterval(elapsed)
+
 		// Use the function to improve break logic of for loop and case statements.
 		shouldBreak, err := func() (bool, error) {
-			// Prevent the loop from running faster than our timeout, in the case where an error causes the api to respond early.
-			notSoonerThan, cancel := context.WithTimeout(context.Background(), operationWaitTimeout)
+			// Prevent the loop from running faster than the interval, in the case where an error causes the api to respond early.
+			notSoonerThan, cancel := context.WithTimeout(context.Background(), interval)
 			defer cancel()
 
-			log.Printf("[INFO] Waiting for %s operation (%s)", operationName, operationID)
+			waitTimeout := interval.String()
+			waitParams.Timeout = &waitTimeout
+
+			tflog.Debug(ctx, "waiting for HCP operation", map[string]interface{}{
+				"elapsed":  elapsed.Round(time.Second).String(),
+				"interval": interval.String(),
+			})
 			waitResponse, err := client.Operation.Wait(waitParams, nil)
 			if err != nil {
+				if ctx.Err() != nil {
+					return true, waitCanceledError(ctx, operationName, operationID, operation)
+				}
+
 				// Increment consecutive errors - intermittent network errors shouldn't
 				// cause a all-out failure when waiting for an operation to complete.
 				consecutiveErrors++
 
-				// Terminate wait if the number of consecutive errors has exceeded the threshold.
-				if consecutiveErrors >= maxConsecutiveWaitErrors {
+				// Terminate wait if the number of consecutive errors has exceeded the budget.
+				if consecutiveErrors >= budget {
 					return true, err
 				}
 
-				log.Printf("[WARN] Error waiting for %s operation (%s), will retry if possible: %s", operationName, operationID, err.Error())
+				tflog.Warn(ctx, "error waiting for HCP operation, will retry", map[string]interface{}{
+					"error":              err.Error(),
+					"consecutive_errors": consecutiveErrors,
+					"error_budget":       budget,
+				})
 			} else {
 				// Reset consecutive errors after a successful response.
 				consecutiveErrors = 0
 
-				log.Printf("[INFO] Received state of %s operation (%s): %s", operationName, operationID, *waitResponse.Payload.Operation.State)
-				if *waitResponse.Payload.Operation.State == sharedmodels.HashicorpCloudOperationOperationStateDONE {
-					if waitResponse.Payload.Operation.Error != nil {
+				operation = waitResponse.Payload.Operation
+				span.AddEvent("received operation state", trace.WithAttributes(
+					attribute.String("hcp.operation.state", string(*operation.State)),
+				))
+				tflog.Info(ctx, "received state of HCP operation", map[string]interface{}{
+					"state":   string(*operation.State),
+					"elapsed": time.Since(start).Round(time.Second).String(),
+				})
+				if *operation.State == sharedmodels.HashicorpCloudOperationOperationStateDONE {
+					if operation.Error != nil {
 						err := fmt.Errorf("%s operation (%s) failed [code=%d, message=%s]",
-							operationName, waitResponse.Payload.Operation.ID, waitResponse.Payload.Operation.Error.Code, waitResponse.Payload.Operation.Error.Message)
+							operationName, operation.ID, operation.Error.Code, operation.Error.Message)
 						return true, err
 					}
 
@@ -73,10 +160,10 @@ func WaitForOperation(ctx context.Context, client *Client, operationName string,
 				}
 			}
 
-			// Ensure we don't retry fast if the api responds faster than timeout.
+			// Ensure we don't retry fast if the api responds faster than the interval.
 			select {
 			case <-ctx.Done():
-				return true, fmt.Errorf("context canceled waiting for %s operation (%s) to complete", operationName, operationID)
+				return true, waitCanceledError(ctx, operationName, operationID, operation)
 			case <-notSoonerThan.Done():
 				return false, nil
 			}
@@ -93,3 +180,23 @@ func WaitForOperation(ctx context.Context, client *Client, operationName string,
 
 	return nil
 }
+
+// waitCanceledError returns the error of a wait for an operation that was
+// stopped because ctx is done. The error identifies the operation and, if
+// known, the resource it belongs to, as the operation continues in HCP.
+func waitCanceledError(ctx context.Context, operationName, operationID string, operation *sharedmodels.HashicorpCloudOperationOperation) error {
+	reason := "context canceled"
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		reason = "timeout expired"
+	}
+
+	if operation != nil {
+		if link, err := LinkURL(operation.Link); err == nil {
+			return fmt.Errorf("%s waiting for %s operation (%s) on %s to complete, the operation may still be running in HCP",
+				reason, operationName, operationID, link)
+		}
+	}
+
+	return fmt.Errorf("%s waiting for %s operation (%s) to complete, the operation may still be running in HCP",
+		reason, operationName, operationID)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-operation/stable/2020-05-05/client/operation_service"
+	operationmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-operation/stable/2020-05-05/models"
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOperationService responds to waits with the results of wait.
+type fakeOperationService struct {
+	operation_service.ClientService
+	wait func() (*sharedmodels.HashicorpCloudOperationOperation, error)
+}
+
+func (f *fakeOperationService) Wait(params *operation_service.WaitParams, _ runtime.ClientAuthInfoWriter, _ ...operation_service.ClientOption) (*operation_service.WaitOK, error) {
+	op, err := f.wait()
+	if err != nil {
+		return nil, err
+	}
+	return &operation_service.WaitOK{Payload: &operationmodels.HashicorpCloudOperationWaitResponse{Operation: op}}, nil
+}
+
+func TestOperationPollInterval(t *testing.T) {
+	require.Equal(t, operationMinPollInterval, operationPollInterval(0))
+	require.Equal(t, operationMinPollInterval, operationPollInterval(30*time.Second))
+	require.Equal(t, 12*time.Second, operationPollInterval(2*time.Minute))
+	require.Equal(t, operationMaxPollInterval, operationPollInterval(30*time.Minute))
+}
+
+func TestWaitForOperation(t *testing.T) {
+	loc := &sharedmodels.HashicorpCloudLocationLocation{OrganizationID: "org", ProjectID: "project"}
+	operation := func(state sharedmodels.HashicorpCloudOperationOperationState) *sharedmodels.HashicorpCloudOperationOperation {
+		return &sharedmodels.HashicorpCloudOperationOperation{
+			ID:    "op-1",
+			State: state.Pointer(),
+			Link: &sharedmodels.HashicorpCloudLocationLink{
+				ID:       "cluster",
+				Type:     "hashicorp.vault.cluster",
+				Location: loc,
+			},
+		}
+	}
+	newClient := func(maxRetries int, wait func() (*sharedmodels.HashicorpCloudOperationOperation, error)) *Client {
+		return &Client{
+			Config:    ClientConfig{MaxRetries: maxRetries},
+			Operation: &fakeOperationService{wait: wait},
+		}
+	}
+
+	t.Run("done", func(t *testing.T) {
+		client := newClient(1, func() (*sharedmodels.HashicorpCloudOperationOperation, error) {
+			return operation(sharedmodels.HashicorpCloudOperationOperationStateDONE), nil
+		})
+		require.NoError(t, WaitForOperation(context.Background(), client, "create", loc, "op-1"))
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		client := newClient(1, func() (*sharedmodels.HashicorpCloudOperationOperation, error) {
+			op := operation(sharedmodels.HashicorpCloudOperationOperationStateDONE)
+			op.Error = &sharedmodels.GoogleRPCStatus{Code: 13, Message: "boom"}
+			return op, nil
+		})
+		err := WaitForOperation(context.Background(), client, "create", loc, "op-1")
+		require.EqualError(t, err, "create operation (op-1) failed [code=13, message=boom]")
+	})
+
+	t.Run("error budget exhausted", func(t *testing.T) {
+		calls := 0
+		client := newClient(0, func() (*sharedmodels.HashicorpCloudOperationOperation, error) {
+			calls++
+			return nil, errors.New("unavailable")
+		})
+		err := WaitForOperation(context.Background(), client, "create", loc, "op-1")
+		require.EqualError(t, err, "unavailable")
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("deadline includes operation and link", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		client := newClient(1, func() (*sharedmodels.HashicorpCloudOperationOperation, error) {
+			return operation(sharedmodels.HashicorpCloudOperationOperationStateRUNNING), nil
+		})
+		err := WaitForOperation(ctx, client, "create", loc, "op-1")
+		require.EqualError(t, err, "timeout expired waiting for create operation (op-1) on "+
+			"/project/project/hashicorp.vault.cluster/cluster to complete, the operation may still be running in HCP")
+	})
+
+	t.Run("canceled before the first response", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		client := newClient(5, func() (*sharedmodels.HashicorpCloudOperationOperation, error) {
+			cancel()
+			return nil, context.Canceled
+		})
+		err := WaitForOperation(ctx, client, "create", loc, "op-1")
+		require.EqualError(t, err, "context canceled waiting for create operation (op-1) to complete, the operation may still be running in HCP")
+	})
+}
+
+// deadlineRecorder records the time left until the deadline of the requests
+// it sends.
+type deadlineRecorder struct {
+	mu        sync.Mutex
+	remaining []time.Duration
+}
+
+func (d *deadlineRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if deadline, ok := req.Context().Deadline(); ok {
+		d.mu.Lock()
+		d.remaining = append(d.remaining, time.Until(deadline))
+		d.mu.Unlock()
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestWaitForOperation_SlowWaitEndpoint(t *testing.T) {
+	// The wait endpoint holds every request for the requested timeout before
+	// responding, like the HCP API does for operations that are running.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		wait, err := time.ParseDuration(r.URL.Query().Get("timeout"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-r.Context().Done():
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"operation":{"id":"op-1","state":"DONE"}}`))
+	}))
+	defer server.Close()
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	recorder := &deadlineRecorder{}
+	transport := httptransport.NewWithClient(u.Host, "/", []string{"http"}, &http.Client{Transport: recorder})
+	client := &Client{
+		Config:    ClientConfig{MaxRetries: 1},
+		Operation: operation_service.New(transport, strfmt.Default),
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{OrganizationID: "org", ProjectID: "project"}
+	require.NoError(t, WaitForOperation(context.Background(), client, "create", loc, "op-1"))
+
+	// The HTTP timeout of the requests outlasts the longest wait the endpoint
+	// can be asked for, so that long running operations aren't given up on
+	// because of client timeouts.
+	require.Len(t, recorder.remaining, 1)
+	require.Greater(t, recorder.remaining[0], operationMaxPollInterval)
+}
@@ -23,7 +23,7 @@ func ListBuckets(ctx context.Context, client *clients.Client, loc *sharedmodels.
 	var buckets []*Bucket
 
 	for {
-		params := packerservice.NewPackerServiceListBucketsParams()
+		params := packerservice.NewPackerServiceListBucketsParamsWithContext(ctx)
 		params.LocationOrganizationID = loc.OrganizationID
 		params.LocationProjectID = loc.ProjectID
 		// Sort order is needed for acceptance tests.
@@ -51,7 +51,7 @@ func ListBuckets(ctx context.Context, client *clients.Client, loc *sharedmodels.
 }
 
 func CreateBucket(ctx context.Context, client *clients.Client, loc *sharedmodels.HashicorpCloudLocationLocation, name string) (*Bucket, error) {
-	params := packerservice.NewPackerServiceCreateBucketParams()
+	params := packerservice.NewPackerServiceCreateBucketParamsWithContext(ctx)
 	params.SetLocationOrganizationID(loc.OrganizationID)
 	params.SetLocationProjectID(loc.ProjectID)
 	params.Body = &packermodels.HashicorpCloudPacker20230101CreateBucketBody{
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsimple"
+	"github.com/hashicorp/hcp-sdk-go/config/files"
+)
+
+const (
+	// profileEnvVar selects the HCP CLI profile if the provider
+	// configuration doesn't.
+	profileEnvVar = "HCP_PROFILE"
+
+	// profilesDirectory is the directory within the HCP configuration
+	// directory in which the HCP CLI stores its profiles.
+	profilesDirectory = "profiles"
+)
+
+// profileNameRegexp matches the names the HCP CLI allows for profiles.
+var profileNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_\-]*$`)
+
+// profile is a profile of the HCP CLI, as stored in the profiles directory.
+// Only the organization and project defaults are used by the provider, the
+// configuration of the CLI itself is ignored.
+type profile struct {
+	Name           string `hcl:"name"`
+	OrganizationID string `hcl:"organization_id,optional"`
+	ProjectID      string `hcl:"project_id,optional"`
+
+	Remain hcl.Body `hcl:",remain"`
+}
+
+// loadProfile loads the HCP CLI profile with the passed name.
+func loadProfile(name string) (*profile, error) {
+	if !profileNameRegexp.MatchString(name) {
+		return nil, fmt.Errorf("invalid HCP CLI profile name %q", name)
+	}
+
+	userHome, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve user's home directory path: %w", err)
+	}
+
+	path := filepath.Join(userHome, files.DefaultDirectory, profilesDirectory, name+".hcl")
+	src, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("HCP CLI profile %q doesn't exist, create it with `hcp profile init`", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read HCP CLI profile %q: %w", name, err)
+	}
+
+	var p profile
+	if err := hclsimple.Decode(path, src, nil, &p); err != nil {
+		return nil, fmt.Errorf("failed to parse HCP CLI profile %q: %w", name, err)
+	}
+
+	return &p, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcp-sdk-go/config/files"
+	"github.com/stretchr/testify/require"
+)
+
+// writeProfile writes an HCP CLI profile to the home directory of the test.
+func writeProfile(t *testing.T, name, src string) {
+	t.Helper()
+
+	dir := filepath.Join(os.Getenv("HOME"), files.DefaultDirectory, profilesDirectory)
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".hcl"), []byte(src), 0o600))
+}
+
+func Test_loadProfile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	writeProfile(t, "dev", `
+name            = "dev"
+organization_id = "org"
+project_id      = "proj"
+
+core {
+  no_color = true
+}
+
+vault-secrets {
+  app = "my-app"
+}
+`)
+	writeProfile(t, "broken", `name = `)
+
+	p, err := loadProfile("dev")
+	require.NoError(t, err)
+	require.Equal(t, "dev", p.Name)
+	require.Equal(t, "org", p.OrganizationID)
+	require.Equal(t, "proj", p.ProjectID)
+
+	_, err = loadProfile("missing")
+	require.ErrorContains(t, err, `HCP CLI profile "missing" doesn't exist`)
+
+	_, err = loadProfile("broken")
+	require.ErrorContains(t, err, `failed to parse HCP CLI profile "broken"`)
+
+	_, err = loadProfile("../dev")
+	require.ErrorContains(t, err, "invalid HCP CLI profile name")
+}
+
+func TestNewDeferredClient_Profile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("HCP_CLIENT_ID", "")
+	t.Setenv("HCP_CLIENT_SECRET", "")
+
+	writeProfile(t, "dev", `
+name            = "dev"
+organization_id = "org"
+project_id      = "proj"
+`)
+
+	t.Run("default project", func(t *testing.T) {
+		client, err := NewDeferredClient(ClientConfig{Profile: "dev"})
+		require.NoError(t, err)
+		require.Equal(t, "org", client.Config.OrganizationID)
+		require.Equal(t, "proj", client.Config.ProjectID)
+	})
+
+	t.Run("environment", func(t *testing.T) {
+		t.Setenv(profileEnvVar, "dev")
+
+		client, err := NewDeferredClient(ClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, "proj", client.Config.ProjectID)
+	})
+
+	t.Run("configured project", func(t *testing.T) {
+		client, err := NewDeferredClient(ClientConfig{Profile: "dev", ProjectID: "other"})
+		require.NoError(t, err)
+		require.Equal(t, "other", client.Config.ProjectID)
+	})
+
+	t.Run("no cached login", func(t *testing.T) {
+		client, err := NewDeferredClient(ClientConfig{Profile: "dev"})
+		require.NoError(t, err)
+
+		err = client.resolution.token()
+		require.Error(t, err)
+		require.ErrorContains(t, credentialsError(client.Config, err), "hcp auth login")
+	})
+}
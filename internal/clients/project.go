@@ -7,8 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/cenkalti/backoff/v4"
-
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/project_service"
 	resourcemodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
@@ -37,6 +35,36 @@ func GetParentOrganizationIDByProjectID(ctx context.Context, client *Client, pro
 	return project.Parent.ID, nil
 }
 
+// ListProjects lists the projects of an organization, paging through all
+// results.
+func ListProjects(ctx context.Context, client *Client, organizationID string) ([]*resourcemodels.HashicorpCloudResourcemanagerProject, error) {
+	scopeType := string(resourcemodels.HashicorpCloudResourcemanagerResourceIDResourceTypeORGANIZATION)
+	nextPage := ""
+	var projects []*resourcemodels.HashicorpCloudResourcemanagerProject
+
+	for {
+		params := project_service.NewProjectServiceListParamsWithContext(ctx)
+		params.ScopeID = &organizationID
+		params.ScopeType = &scopeType
+		if nextPage != "" {
+			params.PaginationNextPageToken = &nextPage
+		}
+
+		res, err := client.Project.ProjectServiceList(params, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		projects = append(projects, res.Payload.Projects...)
+		pagination := res.Payload.Pagination
+		if pagination == nil || pagination.NextPageToken == "" {
+			return projects, nil
+		}
+
+		nextPage = pagination.NextPageToken
+	}
+}
+
 func CreateProject(ctx context.Context, client *Client, name, organizationID string) (*resourcemodels.HashicorpCloudResourcemanagerProject, error) {
 	projectOrg := &resourcemodels.HashicorpCloudResourcemanagerResourceID{
 		ID:   organizationID,
@@ -56,94 +84,70 @@ func CreateProject(ctx context.Context, client *Client, name, organizationID str
 	return createProjectResp.Payload.Project, nil
 }
 
-// CreateProjectWithRetry wraps the projects service client with an exponential backoff retry mechanism.
-func CreateProjectWithRetry(client *Client, params *project_service.ProjectServiceCreateParams) (*project_service.ProjectServiceCreateOK, error) {
-	var res *project_service.ProjectServiceCreateOK
-	op := func() error {
-		var err error
-		res, err = client.Project.ProjectServiceCreate(params, nil)
-		if err != nil {
-			return err
-		}
-		// Wait for the project to be created, if an operation ID is returned.
-		if res.Payload.OperationID != "" {
-			return waitForProjectOperation(params.Context, client, "create project", res.Payload.Project.ID, res.Payload.OperationID)
+// CreateProjectAndWait creates a project and waits for the operation to complete, if any.
+func CreateProjectAndWait(client *Client, params *project_service.ProjectServiceCreateParams) (*project_service.ProjectServiceCreateOK, error) {
+	res, err := client.Project.ProjectServiceCreate(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Wait for the project to be created, if an operation ID is returned.
+	if res.Payload.OperationID != "" {
+		if err := waitForProjectOperation(params.Context, client, "create project", res.Payload.Project.ID, res.Payload.OperationID); err != nil {
+			return nil, err
 		}
-		return nil
 	}
 
-	serviceErr := &project_service.ProjectServiceCreateDefault{}
-	err := backoff.Retry(newBackoffOp(op, serviceErr), newBackoff())
-
-	return res, err
+	return res, nil
 }
 
-// SetProjectNameWithRetry wraps the projects service client with an exponential backoff retry mechanism.
-func SetProjectNameWithRetry(client *Client, params *project_service.ProjectServiceSetNameParams) (*project_service.ProjectServiceSetNameOK, error) {
-	var res *project_service.ProjectServiceSetNameOK
-	op := func() error {
-		var err error
-		res, err = client.Project.ProjectServiceSetName(params, nil)
-		if err != nil {
-			return err
-		}
-		// Wait for the project name to be set, if an operation ID is returned.
-		if res.Payload.OperationID != "" {
-			return waitForProjectOperation(params.Context, client, "set project name", params.ID, res.Payload.OperationID)
+// SetProjectName sets the name of a project and waits for the operation to complete, if any.
+func SetProjectName(client *Client, params *project_service.ProjectServiceSetNameParams) (*project_service.ProjectServiceSetNameOK, error) {
+	res, err := client.Project.ProjectServiceSetName(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Wait for the project name to be set, if an operation ID is returned.
+	if res.Payload.OperationID != "" {
+		if err := waitForProjectOperation(params.Context, client, "set project name", params.ID, res.Payload.OperationID); err != nil {
+			return nil, err
 		}
-		return nil
 	}
 
-	serviceErr := &project_service.ProjectServiceSetNameDefault{}
-	err := backoff.Retry(newBackoffOp(op, serviceErr), newBackoff())
-
-	return res, err
+	return res, nil
 }
 
-// SetProjectDescriptionWithRetry wraps the projects service client with an exponential backoff retry mechanism.
-func SetProjectDescriptionWithRetry(client *Client, params *project_service.ProjectServiceSetDescriptionParams) (*project_service.ProjectServiceSetDescriptionOK, error) {
-	var res *project_service.ProjectServiceSetDescriptionOK
-	op := func() error {
-		var err error
-		res, err = client.Project.ProjectServiceSetDescription(params, nil)
-		if err != nil {
-			return err
-		}
-		// Wait for the project description to be set, if an operation ID is returned.
-		if res.Payload.OperationID != "" {
-			return waitForProjectOperation(params.Context, client, "set project description", params.ID, res.Payload.OperationID)
+// SetProjectDescription sets the description of a project and waits for the operation to complete, if any.
+func SetProjectDescription(client *Client, params *project_service.ProjectServiceSetDescriptionParams) (*project_service.ProjectServiceSetDescriptionOK, error) {
+	res, err := client.Project.ProjectServiceSetDescription(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Wait for the project description to be set, if an operation ID is returned.
+	if res.Payload.OperationID != "" {
+		if err := waitForProjectOperation(params.Context, client, "set project description", params.ID, res.Payload.OperationID); err != nil {
+			return nil, err
 		}
-		return nil
 	}
 
-	serviceErr := &project_service.ProjectServiceSetDescriptionDefault{}
-	err := backoff.Retry(newBackoffOp(op, serviceErr), newBackoff())
-
-	return res, err
+	return res, nil
 }
 
-// DeleteProjectWithRetry wraps the projects service client with an exponential backoff retry mechanism.
-func DeleteProjectWithRetry(client *Client, params *project_service.ProjectServiceDeleteParams) (*project_service.ProjectServiceDeleteOK, error) {
-	var res *project_service.ProjectServiceDeleteOK
-	op := func() error {
-		var err error
-		res, err = client.Project.ProjectServiceDelete(params, nil)
-		if err != nil {
-			return err
-		}
-		// Wait for the project to be deleted, if an operation ID is returned.
-		if res.Payload.Operation.ID != "" {
-			// For delete operations, the operation is scoped at the organization level
-			projectID := ""
-			return waitForProjectOperation(params.Context, client, "delete project", projectID, res.Payload.Operation.ID)
+// DeleteProject deletes a project and waits for the operation to complete, if any.
+func DeleteProject(client *Client, params *project_service.ProjectServiceDeleteParams) (*project_service.ProjectServiceDeleteOK, error) {
+	res, err := client.Project.ProjectServiceDelete(params, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Wait for the project to be deleted, if an operation ID is returned.
+	if res.Payload.Operation.ID != "" {
+		// For delete operations, the operation is scoped at the organization level
+		projectID := ""
+		if err := waitForProjectOperation(params.Context, client, "delete project", projectID, res.Payload.Operation.ID); err != nil {
+			return nil, err
 		}
-		return nil
 	}
 
-	serviceErr := &project_service.ProjectServiceDeleteDefault{}
-	err := backoff.Retry(newBackoffOp(op, serviceErr), newBackoff())
-
-	return res, err
+	return res, nil
 }
 
 func waitForProjectOperation(ctx context.Context, client *Client, operationName, projectID string, operationID string) error {
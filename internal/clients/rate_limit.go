@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
+)
+
+// Names of the HCP services whose requests are rate limited independently.
+const (
+	ServiceBilling         = "billing"
+	ServiceBoundary        = "boundary"
+	ServiceConsul          = "consul"
+	ServiceIAM             = "iam"
+	ServiceLogs            = "logs"
+	ServiceNetwork         = "network"
+	ServiceOperation       = "operation"
+	ServicePacker          = "packer"
+	ServiceResourceManager = "resource_manager"
+	ServiceVault           = "vault"
+	ServiceVaultRadar      = "vault_radar"
+	ServiceVaultSecrets    = "vault_secrets"
+	ServiceWaypoint        = "waypoint"
+	ServiceWebhook         = "webhook"
+)
+
+// RateLimitServices lists the names of all services that can be configured in
+// RateLimits.Services.
+var RateLimitServices = []string{
+	ServiceBilling,
+	ServiceBoundary,
+	ServiceConsul,
+	ServiceIAM,
+	ServiceLogs,
+	ServiceNetwork,
+	ServiceOperation,
+	ServicePacker,
+	ServiceResourceManager,
+	ServiceVault,
+	ServiceVaultRadar,
+	ServiceVaultSecrets,
+	ServiceWaypoint,
+	ServiceWebhook,
+}
+
+// RateLimitServicesMarkdown returns the names of RateLimitServices, formatted
+// for the descriptions of the provider arguments.
+func RateLimitServicesMarkdown() string {
+	quoted := make([]string, len(RateLimitServices))
+	for i, s := range RateLimitServices {
+		quoted[i] = "`" + s + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// RateLimit configures the client-side limits for requests to a single HCP
+// service. The zero value does not limit requests.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained number of requests per second that
+	// may be sent to the service. Zero disables rate limiting.
+	RequestsPerSecond float64
+
+	// Burst is the number of requests that may be sent at once before the
+	// rate limit applies. It defaults to one request if unset.
+	Burst int
+
+	// MaxConcurrentRequests is the maximum number of requests to the service
+	// that may be in flight at once. Zero does not limit concurrency.
+	MaxConcurrentRequests int
+}
+
+// RateLimits configures the client-side limits for requests to HCP.
+type RateLimits struct {
+	// Default applies to every service that is not configured in Services.
+	Default RateLimit
+
+	// Services overrides the limits of individual services, keyed by one of
+	// RateLimitServices.
+	Services map[string]RateLimit
+}
+
+// RateLimitConfig holds the limits configured in the rate_limits block of the
+// provider, or in one of its service blocks. Nil fields are not configured.
+type RateLimitConfig struct {
+	RequestsPerSecond     *float64
+	Burst                 *int64
+	MaxConcurrentRequests *int64
+}
+
+// ServiceRateLimitConfig holds the limits configured for a single service.
+type ServiceRateLimitConfig struct {
+	Name string
+	RateLimitConfig
+}
+
+// apply overrides the passed limit with the configured ones.
+func (c RateLimitConfig) apply(limit RateLimit) RateLimit {
+	if c.RequestsPerSecond != nil {
+		limit.RequestsPerSecond = *c.RequestsPerSecond
+	}
+	if c.Burst != nil {
+		limit.Burst = int(*c.Burst)
+	}
+	if c.MaxConcurrentRequests != nil {
+		limit.MaxConcurrentRequests = int(*c.MaxConcurrentRequests)
+	}
+	return limit
+}
+
+// NewRateLimits returns the RateLimits of the rate_limits block of the
+// provider. The limits that are not set for a service are inherited from the
+// defaults. An error is returned if a service is configured more than once.
+func NewRateLimits(defaults RateLimitConfig, services []ServiceRateLimitConfig) (RateLimits, error) {
+	limits := RateLimits{
+		Default:  defaults.apply(RateLimit{}),
+		Services: make(map[string]RateLimit, len(services)),
+	}
+
+	for _, service := range services {
+		if _, ok := limits.Services[service.Name]; ok {
+			return limits, fmt.Errorf("the limits for service %q are configured more than once", service.Name)
+		}
+		limits.Services[service.Name] = service.apply(limits.Default)
+	}
+
+	return limits, nil
+}
+
+// forService returns the limits that apply to the passed service.
+func (r RateLimits) forService(service string) RateLimit {
+	if l, ok := r.Services[service]; ok {
+		return l
+	}
+	return r.Default
+}
+
+// serviceLimiters enforce the limits of a single HCP service. They are shared
+// by all clients with the same configuration.
+type serviceLimiters struct {
+	limit RateLimit
+
+	// limiter is nil if the request rate is not limited.
+	limiter *rate.Limiter
+
+	// sem is nil if the concurrency is not limited.
+	sem *semaphore.Weighted
+}
+
+// newServiceLimiters returns the limiters enforcing the passed limit, or nil
+// if it does not limit requests.
+func newServiceLimiters(limit RateLimit) *serviceLimiters {
+	if limit.RequestsPerSecond <= 0 && limit.MaxConcurrentRequests <= 0 {
+		return nil
+	}
+
+	l := &serviceLimiters{limit: limit}
+
+	if limit.RequestsPerSecond > 0 {
+		burst := limit.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		l.limiter = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+	}
+
+	if limit.MaxConcurrentRequests > 0 {
+		l.sem = semaphore.NewWeighted(int64(limit.MaxConcurrentRequests))
+	}
+
+	return l
+}
+
+// configKey identifies a provider configuration, such that the plugin
+// framework and SDKv2 providers, which are configured with the same provider
+// block, can share state.
+type configKey struct {
+	clientID                     string
+	credentialFile               string
+	workloadIdentityResourceName string
+	profile                      string
+	organizationID               string
+	projectID                    string
+	geography                    string
+}
+
+// newConfigKey returns the key of the passed configuration.
+func newConfigKey(config ClientConfig) configKey {
+	return configKey{
+		clientID:                     config.ClientID,
+		credentialFile:               config.CredentialFile,
+		workloadIdentityResourceName: config.WorkloadIdentityResourceName,
+		profile:                      config.Profile,
+		organizationID:               config.OrganizationID,
+		projectID:                    config.ProjectID,
+		geography:                    config.Geography,
+	}
+}
+
+// rateLimiterKey identifies the limiters of a service for a provider
+// configuration.
+type rateLimiterKey struct {
+	configKey
+
+	service string
+	limit   RateLimit
+}
+
+// rateLimiters holds the limiters of every configuration and service, so
+// that the plugin framework and SDKv2 providers, which are configured with
+// the same provider block, share the limits rather than each getting their
+// own.
+var rateLimiters = struct {
+	sync.Mutex
+	m map[rateLimiterKey]*serviceLimiters
+}{m: make(map[rateLimiterKey]*serviceLimiters)}
+
+// sharedServiceLimiters returns the limiters of the service for the passed
+// configuration, or nil if requests to the service are not limited.
+func sharedServiceLimiters(config ClientConfig, service string) *serviceLimiters {
+	key := rateLimiterKey{
+		configKey: newConfigKey(config),
+		service:   service,
+		limit:     config.RateLimits.forService(service),
+	}
+
+	rateLimiters.Lock()
+	defer rateLimiters.Unlock()
+
+	l, ok := rateLimiters.m[key]
+	if !ok {
+		l = newServiceLimiters(key.limit)
+		rateLimiters.m[key] = l
+	}
+	return l
+}
+
+// rateLimitTransport is an http.RoundTripper that limits the rate and the
+// concurrency of requests to a single HCP service. Requests that have to wait
+// are logged, so that throttling is visible in the Terraform logs.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	service string
+
+	*serviceLimiters
+}
+
+// newRateLimitTransport wraps the passed http.RoundTripper such that requests
+// to the service are limited by the passed limiters. The transport is
+// returned unchanged if the limiters are nil.
+func newRateLimitTransport(next http.RoundTripper, service string, limiters *serviceLimiters) http.RoundTripper {
+	if limiters == nil {
+		return next
+	}
+
+	return &rateLimitTransport{
+		next:            next,
+		service:         service,
+		serviceLimiters: limiters,
+	}
+}
+
+// RoundTrip implements http.RoundTripper. A concurrent request holds its slot
+// until the body of its response is closed, or until it fails.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	release := func() {}
+	if t.sem != nil {
+		if !t.sem.TryAcquire(1) {
+			tflog.Debug(ctx, "waiting for in-flight HCP API requests to complete", map[string]interface{}{
+				"service":                 t.service,
+				"max_concurrent_requests": t.limit.MaxConcurrentRequests,
+			})
+
+			if err := t.sem.Acquire(ctx, 1); err != nil {
+				closeRequestBody(req)
+				return nil, err
+			}
+		}
+		release = sync.OnceFunc(func() { t.sem.Release(1) })
+	}
+
+	if t.limiter != nil {
+		r := t.limiter.Reserve()
+		if delay := r.Delay(); delay > 0 {
+			tflog.Debug(ctx, "throttling HCP API request", map[string]interface{}{
+				"service":             t.service,
+				"requests_per_second": t.limit.RequestsPerSecond,
+				"delay":               delay.String(),
+			})
+
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				r.Cancel()
+				release()
+				closeRequestBody(req)
+				return nil, ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releasingBody is the body of a response that releases the concurrency slot
+// of its request once closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+// Close implements io.Closer.
+func (b *releasingBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
+
+// closeRequestBody closes the body of a request that is not sent, as required
+// of an http.RoundTripper.
+func closeRequestBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimits_forService(t *testing.T) {
+	limits := RateLimits{
+		Default: RateLimit{RequestsPerSecond: 10},
+		Services: map[string]RateLimit{
+			ServiceVaultSecrets: {RequestsPerSecond: 2, MaxConcurrentRequests: 1},
+		},
+	}
+
+	require.Equal(t, RateLimit{RequestsPerSecond: 2, MaxConcurrentRequests: 1}, limits.forService(ServiceVaultSecrets))
+	require.Equal(t, RateLimit{RequestsPerSecond: 10}, limits.forService(ServiceIAM))
+}
+
+func TestNewRateLimits(t *testing.T) {
+	rps, burst, concurrency := 10.0, int64(5), int64(2)
+
+	limits, err := NewRateLimits(
+		RateLimitConfig{RequestsPerSecond: &rps, Burst: &burst},
+		[]ServiceRateLimitConfig{
+			{Name: ServiceVaultSecrets, RateLimitConfig: RateLimitConfig{MaxConcurrentRequests: &concurrency}},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, RateLimit{RequestsPerSecond: 10, Burst: 5}, limits.Default)
+	require.Equal(t, RateLimit{RequestsPerSecond: 10, Burst: 5, MaxConcurrentRequests: 2}, limits.forService(ServiceVaultSecrets))
+
+	_, err = NewRateLimits(RateLimitConfig{}, []ServiceRateLimitConfig{{Name: ServiceIAM}, {Name: ServiceIAM}})
+	require.EqualError(t, err, `the limits for service "iam" are configured more than once`)
+}
+
+func TestSharedServiceLimiters(t *testing.T) {
+	config := ClientConfig{
+		OrganizationID: "shared-limiters-org",
+		RateLimits:     RateLimits{Default: RateLimit{RequestsPerSecond: 1}},
+	}
+
+	// Clients of the same configuration share the limiters of a service.
+	limiters := sharedServiceLimiters(config, ServiceIAM)
+	require.NotNil(t, limiters)
+	require.Same(t, limiters, sharedServiceLimiters(config, ServiceIAM))
+	require.NotSame(t, limiters, sharedServiceLimiters(config, ServiceVault))
+
+	other := config
+	other.OrganizationID = "other-org"
+	require.NotSame(t, limiters, sharedServiceLimiters(other, ServiceIAM))
+
+	other = config
+	other.RateLimits = RateLimits{Default: RateLimit{RequestsPerSecond: 2}}
+	require.NotSame(t, limiters, sharedServiceLimiters(other, ServiceIAM))
+
+	require.Nil(t, sharedServiceLimiters(ClientConfig{}, ServiceIAM))
+}
+
+func TestRateLimitTransport_Unlimited(t *testing.T) {
+	next := http.DefaultTransport
+	require.Equal(t, next, newRateLimitTransport(next, ServiceIAM, newServiceLimiters(RateLimit{})))
+}
+
+func TestRateLimitTransport_MaxConcurrentRequests(t *testing.T) {
+	r := require.New(t)
+
+	var inFlight, maxInFlight int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+	}))
+	defer srv.Close()
+
+	transport := newRateLimitTransport(http.DefaultTransport, ServiceIAM, newServiceLimiters(RateLimit{MaxConcurrentRequests: 2}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+			r.NoError(err)
+
+			resp, err := transport.RoundTrip(req)
+			r.NoError(err)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	r.LessOrEqual(atomic.LoadInt32(&maxInFlight), int32(2))
+}
+
+func TestRateLimitTransport_MaxConcurrentRequestsUntilBodyClosed(t *testing.T) {
+	r := require.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}))
+	defer srv.Close()
+
+	transport := newRateLimitTransport(http.DefaultTransport, ServiceVaultSecrets, newServiceLimiters(RateLimit{MaxConcurrentRequests: 1}))
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	r.NoError(err)
+	resp, err := transport.RoundTrip(req)
+	r.NoError(err)
+
+	// The body of the first response isn't closed yet, so the request still
+	// holds the only slot.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	r.NoError(err)
+	_, err = transport.RoundTrip(req)
+	r.ErrorIs(err, context.DeadlineExceeded)
+
+	// Closing the body releases the slot, closing it again doesn't release
+	// another one.
+	r.NoError(resp.Body.Close())
+	resp.Body.Close()
+
+	req, err = http.NewRequest(http.MethodGet, srv.URL, nil)
+	r.NoError(err)
+	resp, err = transport.RoundTrip(req)
+	r.NoError(err)
+	defer resp.Body.Close()
+
+	r.False(transport.(*rateLimitTransport).sem.TryAcquire(1))
+}
+
+func TestRateLimitTransport_RequestsPerSecond(t *testing.T) {
+	r := require.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}))
+	defer srv.Close()
+
+	transport := newRateLimitTransport(http.DefaultTransport, ServiceVaultSecrets, newServiceLimiters(RateLimit{RequestsPerSecond: 20, Burst: 1}))
+
+	// The first request uses the burst, the following ones are spaced out by
+	// the rate limit.
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+		r.NoError(err)
+
+		resp, err := transport.RoundTrip(req)
+		r.NoError(err)
+		resp.Body.Close()
+	}
+	r.GreaterOrEqual(time.Since(start), 90*time.Millisecond)
+}
+
+func TestRateLimitTransport_ContextCanceled(t *testing.T) {
+	r := require.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}))
+	defer srv.Close()
+
+	transport := newRateLimitTransport(http.DefaultTransport, ServiceVaultSecrets, newServiceLimiters(RateLimit{RequestsPerSecond: 0.1, Burst: 1}))
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	r.NoError(err)
+	resp, err := transport.RoundTrip(req)
+	r.NoError(err)
+	resp.Body.Close()
+
+	// The next token is only available in ten seconds, so the request is
+	// abandoned once its context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	r.NoError(err)
+	_, err = transport.RoundTrip(req)
+	r.ErrorIs(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/organization_service"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/project_service"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	multipleProjectsSummary = "There is more than one project associated with the organization of the configured credentials."
+	multipleProjectsDetail  = "The oldest project has been selected as the default. To configure which project is used as default, set a project in the HCP provider config block. Resources may also be configured with different projects."
+)
+
+// resolution is the state of a deferred client, see NewDeferredClient.
+type resolution struct {
+	// token fetches a token to verify the credentials of the client.
+	token func() error
+
+	// mu guards the fields below, and is held while resolving, such that
+	// concurrent operations wait for the first resolution instead of
+	// repeating it.
+	mu             sync.Mutex
+	resolved       bool
+	organizationID string
+	projectID      string
+	warning        *resolveWarning
+	err            error
+
+	// warned is set once the warning of the resolution has been returned,
+	// such that it's only reported by the first operation.
+	warned bool
+}
+
+// resolutions holds the resolution of every configuration, so that the
+// plugin framework and SDKv2 providers, which are configured with the same
+// provider block, resolve it once and only report its warning once.
+var resolutions = struct {
+	sync.Mutex
+	m map[configKey]*resolution
+}{m: make(map[configKey]*resolution)}
+
+// sharedResolution returns the resolution of the passed configuration,
+// verifying the credentials with token unless it's already been created.
+func sharedResolution(config ClientConfig, token func() error) *resolution {
+	key := newConfigKey(config)
+
+	resolutions.Lock()
+	defer resolutions.Unlock()
+
+	r, ok := resolutions.m[key]
+	if !ok {
+		r = &resolution{token: token}
+		resolutions.m[key] = r
+	}
+	return r
+}
+
+// resolveWarning is a warning raised while resolving a client.
+type resolveWarning struct {
+	summary string
+	detail  string
+}
+
+// Resolve verifies the credentials of a deferred client, and resolves the
+// organization and project it launches resources in, see NewDeferredClient.
+// The result is memoized and shared by the clients of the same configuration,
+// so only the first call makes requests, unless it failed because ctx is done. Resolve is safe for concurrent use, and returns
+// immediately for clients created by NewClient.
+func (cl *Client) Resolve(ctx context.Context) error {
+	_, err := cl.resolve(ctx)
+	return err
+}
+
+// resolve is Resolve, but additionally returns the warning raised while
+// resolving, the first time it's called after a successful resolution by any
+// client of the configuration.
+func (cl *Client) resolve(ctx context.Context) (*resolveWarning, error) {
+	r := cl.resolution
+	if r == nil {
+		return nil, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.resolved {
+		organizationID, projectID, warning, err := cl.resolveLocation(ctx, r)
+		if err != nil && ctx.Err() != nil {
+			// The resolution was interrupted, leave it to the next operation.
+			return nil, err
+		}
+
+		r.resolved = true
+		r.organizationID, r.projectID = organizationID, projectID
+		r.warning, r.err = warning, err
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	// The resolution may have been made by another client of the same
+	// configuration.
+	if !cl.located {
+		cl.Config.OrganizationID = r.organizationID
+		cl.Config.ProjectID = r.projectID
+		cl.located = true
+	}
+
+	if r.warned {
+		return nil, nil
+	}
+
+	r.warned = true
+	return r.warning, nil
+}
+
+// resolveLocation verifies the credentials of the client and returns the
+// organization and project of its config.
+func (cl *Client) resolveLocation(ctx context.Context, r *resolution) (string, string, *resolveWarning, error) {
+	tflog.Debug(ctx, "resolving HCP client", map[string]interface{}{
+		"project_id": cl.Config.ProjectID,
+	})
+
+	// Fetch a token to verify that we have valid credentials
+	if err := r.token(); err != nil {
+		return "", "", nil, credentialsError(cl.Config, err)
+	}
+
+	if cl.Config.ProjectID != "" {
+		getProjParams := project_service.NewProjectServiceGetParams()
+		getProjParams.Context = ctx
+		getProjParams.ID = cl.Config.ProjectID
+		project, err := cl.Project.ProjectServiceGet(getProjParams, nil)
+		if err != nil {
+			return "", "", nil, fmt.Errorf("unable to fetch project %q: %w", cl.Config.ProjectID, err)
+		}
+
+		return project.Payload.Project.Parent.ID, project.Payload.Project.ID, nil, nil
+	}
+
+	// For the initial release of the HCP TFP, since only one project was allowed per organization at the time,
+	// the provider handled used the single organization's single project by default, instead of requiring the
+	// user to set it. Once multiple projects are available, this helper issues a warning: when multiple projects exist within the org,
+	// a project ID should be set on the provider or on each resource. Otherwise, the oldest project will be used by default.
+	// This helper will eventually be deprecated after a migration period.
+	project, warning, err := getProjectFromCredentials(ctx, cl)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("unable to get project from credentials: %w", err)
+	}
+
+	return project.Parent.ID, project.ID, warning, nil
+}
+
+// getProjectFromCredentials uses the configured client credentials to
+// fetch the associated organization and returns that organization's
+// single project, or its oldest project along with a warning.
+func getProjectFromCredentials(ctx context.Context, client *Client) (*models.HashicorpCloudResourcemanagerProject, *resolveWarning, error) {
+	// Get the organization ID.
+	listOrgParams := organization_service.NewOrganizationServiceListParams()
+	listOrgParams.Context = ctx
+	listOrgResp, err := client.Organization.OrganizationServiceList(listOrgParams, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch organization list: %w", err)
+	}
+	orgLen := len(listOrgResp.Payload.Organizations)
+	if orgLen == 0 {
+		return nil, nil, errors.New("the configured credentials do not have access to any organization, please assign at least one organization to the configured credentials to use this provider")
+	}
+	if orgLen > 1 {
+		return nil, nil, errors.New("there is more than one organization associated with the configured credentials, please configure a specific project in the HCP provider config block")
+	}
+
+	orgID := listOrgResp.Payload.Organizations[0].ID
+
+	// Get the project using the organization ID.
+	listProjParams := project_service.NewProjectServiceListParams()
+	listProjParams.Context = ctx
+	listProjParams.ScopeID = &orgID
+	scopeType := string(models.HashicorpCloudResourcemanagerResourceIDResourceTypeORGANIZATION)
+	listProjParams.ScopeType = &scopeType
+	listProjResp, err := client.Project.ProjectServiceList(listProjParams, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch project id: %w", err)
+	}
+	if len(listProjResp.Payload.Projects) == 0 {
+		return nil, nil, errors.New("the configured credentials do not have access to any project, please assign at least one project to the configured credentials to use this provider")
+	}
+	if len(listProjResp.Payload.Projects) > 1 {
+		warning := &resolveWarning{
+			summary: multipleProjectsSummary,
+			detail:  multipleProjectsDetail,
+		}
+		return getOldestProject(listProjResp.Payload.Projects), warning, nil
+	}
+	return listProjResp.Payload.Projects[0], nil, nil
+}
+
+// getOldestProject retrieves the oldest project from a list based on its created_at time.
+func getOldestProject(projects []*models.HashicorpCloudResourcemanagerProject) (oldestProj *models.HashicorpCloudResourcemanagerProject) {
+	oldestTime := time.Now()
+
+	for _, proj := range projects {
+		projTime := time.Time(proj.CreatedAt)
+		if projTime.Before(oldestTime) {
+			oldestProj = proj
+			oldestTime = projTime
+		}
+	}
+	return oldestProj
+}
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/organization_service"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/project_service"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProjectService serves the projects of a single organization.
+type fakeProjectService struct {
+	project_service.ClientService
+	projects []*models.HashicorpCloudResourcemanagerProject
+	err      error
+	requests atomic.Int32
+}
+
+func (f *fakeProjectService) ProjectServiceGet(params *project_service.ProjectServiceGetParams, _ runtime.ClientAuthInfoWriter, _ ...project_service.ClientOption) (*project_service.ProjectServiceGetOK, error) {
+	f.requests.Add(1)
+	if f.err != nil {
+		return nil, f.err
+	}
+	for _, p := range f.projects {
+		if p.ID == params.ID {
+			return &project_service.ProjectServiceGetOK{Payload: &models.HashicorpCloudResourcemanagerProjectGetResponse{Project: p}}, nil
+		}
+	}
+	return nil, errors.New("project not found")
+}
+
+func (f *fakeProjectService) ProjectServiceList(_ *project_service.ProjectServiceListParams, _ runtime.ClientAuthInfoWriter, _ ...project_service.ClientOption) (*project_service.ProjectServiceListOK, error) {
+	f.requests.Add(1)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &project_service.ProjectServiceListOK{Payload: &models.HashicorpCloudResourcemanagerProjectListResponse{Projects: f.projects}}, nil
+}
+
+// fakeOrganizationService serves a list of organizations.
+type fakeOrganizationService struct {
+	organization_service.ClientService
+	organizations []*models.HashicorpCloudResourcemanagerOrganization
+}
+
+func (f *fakeOrganizationService) OrganizationServiceList(_ *organization_service.OrganizationServiceListParams, _ runtime.ClientAuthInfoWriter, _ ...organization_service.ClientOption) (*organization_service.OrganizationServiceListOK, error) {
+	return &organization_service.OrganizationServiceListOK{Payload: &models.HashicorpCloudResourcemanagerOrganizationListResponse{Organizations: f.organizations}}, nil
+}
+
+func testProject(id string, createdAt time.Time) *models.HashicorpCloudResourcemanagerProject {
+	return &models.HashicorpCloudResourcemanagerProject{
+		ID:        id,
+		CreatedAt: strfmt.DateTime(createdAt),
+		Parent:    &models.HashicorpCloudResourcemanagerResourceID{ID: "org"},
+	}
+}
+
+func TestClientResolve(t *testing.T) {
+	newClient := func(projectID string, token error, projects *fakeProjectService) *Client {
+		return &Client{
+			Config:  ClientConfig{ProjectID: projectID},
+			Project: projects,
+			Organization: &fakeOrganizationService{
+				organizations: []*models.HashicorpCloudResourcemanagerOrganization{{ID: "org"}},
+			},
+			resolution: &resolution{
+				token: func() error { return token },
+			},
+		}
+	}
+
+	t.Run("not deferred", func(t *testing.T) {
+		client := &Client{}
+		require.NoError(t, client.Resolve(context.Background()))
+	})
+
+	t.Run("project", func(t *testing.T) {
+		projects := &fakeProjectService{projects: []*models.HashicorpCloudResourcemanagerProject{
+			testProject("proj1", time.Now()),
+		}}
+		client := newClient("proj1", nil, projects)
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, client.Resolve(context.Background()))
+			}()
+		}
+		wg.Wait()
+
+		require.Equal(t, "org", client.Config.OrganizationID)
+		require.Equal(t, "proj1", client.Config.ProjectID)
+		require.EqualValues(t, 1, projects.requests.Load())
+	})
+
+	t.Run("oldest project", func(t *testing.T) {
+		now := time.Now()
+		projects := &fakeProjectService{projects: []*models.HashicorpCloudResourcemanagerProject{
+			testProject("proj1", now.Add(-time.Hour)),
+			testProject("proj2", now.Add(-2*time.Hour)),
+		}}
+		client := newClient("", nil, projects)
+
+		warning, err := client.resolve(context.Background())
+		require.NoError(t, err)
+		require.NotNil(t, warning)
+		require.Equal(t, multipleProjectsSummary, warning.summary)
+		require.Equal(t, "proj2", client.Config.ProjectID)
+
+		// The warning is only returned once.
+		warning, err = client.resolve(context.Background())
+		require.NoError(t, err)
+		require.Nil(t, warning)
+	})
+
+	t.Run("shared", func(t *testing.T) {
+		now := time.Now()
+		projects := &fakeProjectService{projects: []*models.HashicorpCloudResourcemanagerProject{
+			testProject("proj1", now.Add(-time.Hour)),
+			testProject("proj2", now.Add(-2*time.Hour)),
+		}}
+		first := newClient("", nil, projects)
+		second := newClient("", nil, projects)
+		second.resolution = first.resolution
+
+		warning, err := first.resolve(context.Background())
+		require.NoError(t, err)
+		require.NotNil(t, warning)
+
+		// The second client uses the resolution of the first, without
+		// repeating its requests or its warning.
+		warning, err = second.resolve(context.Background())
+		require.NoError(t, err)
+		require.Nil(t, warning)
+		require.Equal(t, "org", second.Config.OrganizationID)
+		require.Equal(t, "proj2", second.Config.ProjectID)
+		require.EqualValues(t, 1, projects.requests.Load())
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		projects := &fakeProjectService{}
+		client := newClient("proj1", errors.New("invalid client secret"), projects)
+
+		err := client.Resolve(context.Background())
+		require.ErrorContains(t, err, "no valid credentials available: invalid client secret")
+
+		// The error is memoized.
+		require.Equal(t, err, client.Resolve(context.Background()))
+		require.Zero(t, projects.requests.Load())
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		projects := &fakeProjectService{err: errors.New("context canceled")}
+		client := newClient("proj1", nil, projects)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.Error(t, client.Resolve(ctx))
+
+		// The interrupted resolution is repeated by the next call.
+		projects.err = nil
+		projects.projects = []*models.HashicorpCloudResourcemanagerProject{testProject("proj1", time.Now())}
+		require.NoError(t, client.Resolve(context.Background()))
+		require.Equal(t, "org", client.Config.OrganizationID)
+	})
+}
+
+func TestSharedResolution(t *testing.T) {
+	token := func() error { return nil }
+	config := ClientConfig{ClientID: "shared-resolution", ProjectID: "proj1"}
+
+	r := sharedResolution(config, token)
+	require.Same(t, r, sharedResolution(config, token))
+
+	config.ProjectID = "proj2"
+	require.NotSame(t, r, sharedResolution(config, token))
+}
+
+// If project ID is not defined on the provider or resource config, the provider
+// project ID becomes the organization's oldest existing project
+func TestDetermineOldestProject(t *testing.T) {
+
+	testCases := []struct {
+		name           string
+		projArray      []*models.HashicorpCloudResourcemanagerProject
+		expectedProjID string
+	}{
+		{
+			name: "One Project",
+			projArray: []*models.HashicorpCloudResourcemanagerProject{
+				{
+					CreatedAt: strfmt.DateTime(time.Date(2010, time.November, 10, 23, 0, 0, 0, time.UTC)),
+					ID:        "proj1",
+				},
+			},
+			expectedProjID: "proj1",
+		},
+		{
+			name: "Two Projects",
+			projArray: []*models.HashicorpCloudResourcemanagerProject{
+				{
+					ID:        "proj1",
+					CreatedAt: strfmt.DateTime(time.Date(2010, time.November, 10, 23, 0, 0, 0, time.UTC)),
+				},
+				{
+					ID:        "proj2",
+					CreatedAt: strfmt.DateTime(time.Date(2009, time.November, 10, 23, 0, 0, 0, time.UTC)),
+				},
+			},
+			expectedProjID: "proj2",
+		},
+		{
+			name: "Three Projects",
+			projArray: []*models.HashicorpCloudResourcemanagerProject{
+				{
+					ID:        "proj1",
+					CreatedAt: strfmt.DateTime(time.Date(2010, time.November, 10, 23, 0, 0, 0, time.UTC)),
+				},
+				{
+					ID:        "proj2",
+					CreatedAt: strfmt.DateTime(time.Date(2007, time.November, 10, 23, 0, 0, 0, time.UTC)),
+				},
+				{
+					ID:        "proj3",
+					CreatedAt: strfmt.DateTime(time.Date(2009, time.November, 10, 23, 0, 0, 0, time.UTC)),
+				},
+			},
+			expectedProjID: "proj2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			oldestProject := getOldestProject(testCase.projArray)
+			assert.Equal(t, testCase.expectedProjID, oldestProject.ID)
+
+		})
+
+	}
+}
@@ -4,23 +4,47 @@
 package clients
 
 import (
+	"bytes"
 	"errors"
-	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"syscall"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
-
-	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/organization_service"
-	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/project_service"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
-	retryCount   = 10
-	retryDelay   = 10
-	counterStart = 1
+	// DefaultMaxRetries is the number of times a request to the HCP API is
+	// retried if the provider configuration does not specify `max_retries`.
+	DefaultMaxRetries = 5
+
+	retryInitialInterval = 1 * time.Second
+	retryMaxInterval     = 30 * time.Second
+
+	// rateLimitDefaultWait is how long to wait before retrying a rate limited
+	// request if the response does not specify when to try again.
+	rateLimitDefaultWait = 60 * time.Second
+
+	// maxRetryBodySize is the maximum size of a rate limited response body that
+	// is inspected for a retry delay.
+	maxRetryBodySize = 64 * 1024
 )
 
-var errorCodesToRetry = [...]int{502, 503, 504}
+var errorCodesToRetry = [...]int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// tryAgainRegex matches the retry delay the HCP API returns in the message of
+// rate limited responses.
+var tryAgainRegex = regexp.MustCompile(`try again in (\d+) seconds`)
 
 // Helper to check what requests to retry based on the response HTTP code
 func shouldRetryErrorCode(errorCode int, errorCodesToRetry []int) bool {
@@ -32,123 +56,215 @@ func shouldRetryErrorCode(errorCode int, errorCodesToRetry []int) bool {
 	return false
 }
 
-// Wraps the OrganizationServiceList function in a loop that supports retrying the GET request
-func RetryOrganizationServiceList(client *Client, params *organization_service.OrganizationServiceListParams) (*organization_service.OrganizationServiceListOK, error) {
-	resp, err := client.Organization.OrganizationServiceList(params, nil)
+// retryTransport is an http.RoundTripper that retries requests to the HCP API
+// that failed with a transient error. Requests are retried if the connection
+// was reset and the request can safely be sent again, or if the API responded
+// with one of errorCodesToRetry.
+//
+// The delay between attempts is taken from the Retry-After header of the
+// response if present, and otherwise follows a jittered exponential backoff.
+// Retries stop once the request context is done or its deadline would be
+// exceeded by waiting for the next attempt.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
 
-	if err != nil {
-		serviceErr, ok := err.(*organization_service.OrganizationServiceListDefault)
-		if !ok {
-			return nil, err
-		}
-		counter := counterStart
-		for shouldRetryErrorCode(serviceErr.Code(), errorCodesToRetry[:]) && counter < retryCount {
-			resp, err = client.Organization.OrganizationServiceList(params, nil)
-			if err == nil {
-				break
-			}
-			// Avoid wasting time if we're not going to retry next loop cycle
-			if (counter + 1) != retryCount {
-				fmt.Printf("Error trying to get list of organizations. Retrying in %d seconds...", retryDelay*counter)
-				time.Sleep(time.Duration(retryDelay*counter) * time.Second)
-			}
-			counter++
-		}
+	// newBackoff returns the backoff used between attempts that don't specify
+	// a delay. It can be overridden in tests.
+	newBackoff func() backoff.BackOff
+}
+
+// newRetryTransport wraps the passed http.RoundTripper such that failed
+// requests are retried up to maxRetries times.
+func newRetryTransport(next http.RoundTripper, maxRetries int) *retryTransport {
+	return &retryTransport{
+		next:       next,
+		maxRetries: maxRetries,
+		newBackoff: newBackoff,
 	}
-	return resp, err
 }
 
-// Wraps the ProjectServiceList function in a loop that supports retrying the GET request
-func RetryProjectServiceList(client *Client, params *project_service.ProjectServiceListParams) (*project_service.ProjectServiceListOK, error) {
-	resp, err := client.Project.ProjectServiceList(params, nil)
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.maxRetries <= 0 {
+		return t.next.RoundTrip(req)
+	}
 
+	req, err := rewindableRequest(req)
 	if err != nil {
-		serviceErr, ok := err.(*project_service.ProjectServiceListDefault)
-		if !ok {
-			return nil, err
-		}
+		return nil, err
+	}
 
-		counter := counterStart
-		for shouldRetryErrorCode(serviceErr.Code(), errorCodesToRetry[:]) && counter < retryCount {
-			resp, err = client.Project.ProjectServiceList(params, nil)
-			if err == nil {
-				break
-			}
-			// Avoid wasting time if we're not going to retry next loop cycle
-			if (counter + 1) != retryCount {
-				fmt.Printf("Error trying to get list of projects. Retrying in %d seconds...", retryDelay*counter)
-				time.Sleep(time.Duration(retryDelay*counter) * time.Second)
+	ctx := req.Context()
+	b := t.newBackoff()
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			attemptReq = req.Clone(ctx)
+			if attemptReq.Body, err = req.GetBody(); err != nil {
+				return nil, err
 			}
-			counter++
 		}
-	}
-	return resp, err
-}
 
-// Wraps the ProjectServiceGet function in a loop that supports retrying the GET request
-func RetryProjectServiceGet(client *Client, params *project_service.ProjectServiceGetParams) (*project_service.ProjectServiceGetOK, error) {
-	resp, err := client.Project.ProjectServiceGet(params, nil)
+		resp, err := t.next.RoundTrip(attemptReq)
+		if attempt >= t.maxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
 
-	if err != nil {
-		serviceErr, ok := err.(*project_service.ProjectServiceGetDefault)
-		if !ok {
-			return nil, err
+		wait := retryAfter(resp)
+		if wait <= 0 {
+			wait = b.NextBackOff()
 		}
 
-		counter := counterStart
-		for shouldRetryErrorCode(serviceErr.Code(), errorCodesToRetry[:]) && counter < retryCount {
-			resp, err = client.Project.ProjectServiceGet(params, nil)
-			if err == nil {
-				break
-			}
-			// Avoid wasting time if we're not going to retry next loop cycle
-			if (counter + 1) != retryCount {
-				fmt.Printf("Error trying to get configured project. Retrying in %d seconds...", retryDelay*counter)
-				time.Sleep(time.Duration(retryDelay*counter) * time.Second)
-			}
-			counter++
+		// Don't wait for a retry that can't complete before the deadline, the
+		// caller is better served by the response we already have.
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return resp, err
+		}
+
+		fields := map[string]interface{}{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status_code"] = resp.StatusCode
+		}
+		tflog.Debug(ctx, "retrying HCP API request", fields)
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
 		}
 	}
-	return resp, err
 }
 
-// newBackoff creates a new exponential backoff with default values.
-func newBackoff() backoff.BackOff {
-	// Create a new exponential backoff with explicit default values.
-	return backoff.NewExponentialBackOff(
-		backoff.WithInitialInterval(backoff.DefaultInitialInterval),
-		backoff.WithRandomizationFactor(backoff.DefaultRandomizationFactor),
-		backoff.WithMultiplier(backoff.DefaultMultiplier),
-		backoff.WithMaxInterval(backoff.DefaultMaxInterval),
-		backoff.WithMaxElapsedTime(backoff.DefaultMaxElapsedTime),
-	)
+// shouldRetry returns whether a request that resulted in the passed response
+// or error should be retried.
+//
+// A connection that broke during a request doesn't tell whether the API
+// processed it, so such requests are only retried if repeating them is safe:
+// the method is idempotent, or the connection failed before it was
+// established.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		transient := errors.Is(err, syscall.ECONNRESET) ||
+			errors.Is(err, io.EOF) ||
+			errors.Is(err, io.ErrUnexpectedEOF)
+		return transient && (isIdempotent(req.Method) || isDialError(err))
+	}
+
+	return shouldRetryErrorCode(resp.StatusCode, errorCodesToRetry[:])
 }
 
-func newBackoffOp(op func() error, serviceError ServiceError) func() error {
-	return func() error {
-		err := op()
+// isIdempotent returns whether sending a request with the passed method
+// several times has the same effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
 
-		if err == nil {
-			return nil
-		}
+// isDialError returns whether the error happened while connecting to the
+// API, in which case the request was never sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
 
-		code, codeErr := getCode(err, serviceError)
-		if codeErr != nil {
-			return backoff.Permanent(codeErr)
-		}
+// retryAfter returns how long the API asked us to wait before retrying the
+// request. Zero is returned if the response does not specify a delay.
+func retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
 
-		if !shouldRetryErrorCode(code, groupErrorCodesToRetry[:]) {
-			return backoff.Permanent(err)
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
 		}
+		if at, err := http.ParseTime(v); err == nil {
+			return time.Until(at)
+		}
+	}
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		return 0
+	}
+
+	// Some services only communicate the delay in the error message, so the
+	// body is inspected and then restored for the caller.
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxRetryBodySize))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+	if err != nil {
+		return rateLimitDefaultWait
+	}
+
+	return getAPIBackoffDuration(string(body))
+}
 
-		return err
+// getAPIBackoffDuration parses the retry delay from the error message of a
+// rate limited response, defaulting to rateLimitDefaultWait.
+func getAPIBackoffDuration(serviceErrStr string) time.Duration {
+	match := tryAgainRegex.FindStringSubmatch(serviceErrStr)
+	if len(match) > 1 {
+		if seconds, err := strconv.Atoi(match[1]); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
 	}
+	return rateLimitDefaultWait
 }
 
-func getCode(err error, serviceErr ServiceError) (int, error) {
-	ok := errors.As(err, &serviceErr)
-	if !ok {
-		return 0, err
+// rewindableRequest ensures that the body of the passed request can be
+// replayed for every attempt. If the request body can't be rewound, it is
+// buffered in memory and a copy of the request is returned.
+func rewindableRequest(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return req, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	rewindable := req.Clone(req.Context())
+	rewindable.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
 	}
-	return serviceErr.Code(), nil
+	rewindable.Body, _ = rewindable.GetBody()
+
+	return rewindable, nil
+}
+
+// newBackoff creates a new jittered exponential backoff for retrying requests.
+// The backoff never stops on its own, the number of attempts is bounded by
+// the transport and the overall time by the request context.
+func newBackoff() backoff.BackOff {
+	return backoff.NewExponentialBackOff(
+		backoff.WithInitialInterval(retryInitialInterval),
+		backoff.WithRandomizationFactor(backoff.DefaultRandomizationFactor),
+		backoff.WithMultiplier(backoff.DefaultMultiplier),
+		backoff.WithMaxInterval(retryMaxInterval),
+		backoff.WithMaxElapsedTime(0),
+	)
 }
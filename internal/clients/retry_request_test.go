@@ -3,7 +3,21 @@
 
 package clients
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/require"
+)
 
 func TestShouldRetryErrorCode(t *testing.T) {
 	errorCodesToRetry := []int{502, 503, 504}
@@ -18,3 +32,245 @@ func TestShouldRetryErrorCode(t *testing.T) {
 		t.Errorf("shouldRetryErrorCode(503, []int{502, 503, 504}[:]) = %v; want true", shouldSucceed)
 	}
 }
+
+func newTestRetryTransport(maxRetries int) *retryTransport {
+	t := newRetryTransport(http.DefaultTransport, maxRetries)
+	t.newBackoff = func() backoff.BackOff { return &backoff.ZeroBackOff{} }
+	return t
+}
+
+func TestRetryTransport(t *testing.T) {
+	tcs := map[string]struct {
+		maxRetries   int
+		statusCodes  []int
+		header       http.Header
+		wantStatus   int
+		wantRequests int
+	}{
+		"success": {
+			maxRetries:   3,
+			statusCodes:  []int{http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantRequests: 1,
+		},
+		"retry until success": {
+			maxRetries:   3,
+			statusCodes:  []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantRequests: 3,
+		},
+		"rate limited with retry after": {
+			maxRetries:   3,
+			statusCodes:  []int{http.StatusTooManyRequests, http.StatusOK},
+			header:       http.Header{"Retry-After": []string{"0"}},
+			wantStatus:   http.StatusOK,
+			wantRequests: 2,
+		},
+		"retries exhausted": {
+			maxRetries:   2,
+			statusCodes:  []int{http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusOK},
+			wantStatus:   http.StatusGatewayTimeout,
+			wantRequests: 3,
+		},
+		"retries disabled": {
+			maxRetries:   0,
+			statusCodes:  []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantStatus:   http.StatusServiceUnavailable,
+			wantRequests: 1,
+		},
+		"client error is not retried": {
+			maxRetries:   3,
+			statusCodes:  []int{http.StatusBadRequest, http.StatusOK},
+			wantStatus:   http.StatusBadRequest,
+			wantRequests: 1,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+
+			var requests int
+			var bodies []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				body, err := io.ReadAll(req.Body)
+				r.NoError(err)
+				bodies = append(bodies, string(body))
+
+				for k, v := range tc.header {
+					w.Header()[k] = v
+				}
+				w.WriteHeader(tc.statusCodes[requests])
+				requests++
+			}))
+			defer srv.Close()
+
+			req, err := http.NewRequest(http.MethodPost, srv.URL, io.NopCloser(strings.NewReader("payload")))
+			r.NoError(err)
+
+			resp, err := newTestRetryTransport(tc.maxRetries).RoundTrip(req)
+			r.NoError(err)
+			defer resp.Body.Close()
+
+			r.Equal(tc.wantStatus, resp.StatusCode)
+			r.Equal(tc.wantRequests, requests)
+			for _, body := range bodies {
+				r.Equal("payload", body)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_ConnectionClosed(t *testing.T) {
+	tcs := map[string]struct {
+		method       string
+		wantRequests int
+	}{
+		"idempotent request is retried": {
+			method:       http.MethodGet,
+			wantRequests: 2,
+		},
+		"non-idempotent request is not retried": {
+			method:       http.MethodPost,
+			wantRequests: 1,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+
+			// The first request is received but the connection is closed
+			// without a response, so it is unknown whether it was processed.
+			var requests int
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requests++
+				if requests == 1 {
+					conn, _, err := w.(http.Hijacker).Hijack()
+					r.NoError(err)
+					conn.Close()
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer srv.Close()
+
+			req, err := http.NewRequest(tc.method, srv.URL, nil)
+			r.NoError(err)
+
+			transport := newTestRetryTransport(3)
+			transport.next = &http.Transport{DisableKeepAlives: true}
+			resp, err := transport.RoundTrip(req)
+			if tc.wantRequests == 1 {
+				r.ErrorIs(err, io.EOF)
+			} else {
+				r.NoError(err)
+				defer resp.Body.Close()
+				r.Equal(http.StatusOK, resp.StatusCode)
+			}
+			r.Equal(tc.wantRequests, requests)
+		})
+	}
+}
+
+func TestShouldRetry_TransportErrors(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNRESET}
+	readErr := &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+
+	tcs := map[string]struct {
+		method string
+		err    error
+		want   bool
+	}{
+		"reset idempotent request":                   {http.MethodPut, readErr, true},
+		"reset non-idempotent request":               {http.MethodPost, readErr, false},
+		"non-idempotent request reset while dialing": {http.MethodPost, dialErr, true},
+		"unexpected EOF of idempotent request":       {http.MethodDelete, io.ErrUnexpectedEOF, true},
+		"unexpected EOF of non-idempotent request":   {http.MethodPatch, io.ErrUnexpectedEOF, false},
+		"other error": {http.MethodGet, errors.New("boom"), false},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			req, err := http.NewRequest(tc.method, "https://api.cloud.hashicorp.com", nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, shouldRetry(req, nil, tc.err))
+		})
+	}
+}
+
+func TestRetryTransport_ContextDeadline(t *testing.T) {
+	r := require.New(t)
+
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	r.NoError(err)
+
+	// The requested delay exceeds the deadline, so the rate limited response
+	// is returned without waiting.
+	resp, err := newTestRetryTransport(3).RoundTrip(req)
+	r.NoError(err)
+	defer resp.Body.Close()
+
+	r.Equal(http.StatusTooManyRequests, resp.StatusCode)
+	r.Equal(1, requests)
+}
+
+func TestRetryAfter(t *testing.T) {
+	tcs := map[string]struct {
+		status int
+		header string
+		body   string
+		want   time.Duration
+	}{
+		"seconds": {
+			status: http.StatusServiceUnavailable,
+			header: "7",
+			want:   7 * time.Second,
+		},
+		"no header": {
+			status: http.StatusServiceUnavailable,
+			want:   0,
+		},
+		"rate limit message": {
+			status: http.StatusTooManyRequests,
+			body:   `{"code":8,"message":"rate limit exceeded, try again in 12 seconds"}`,
+			want:   12 * time.Second,
+		},
+		"rate limit without delay": {
+			status: http.StatusTooManyRequests,
+			body:   `{"code":8,"message":"rate limit exceeded"}`,
+			want:   rateLimitDefaultWait,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{
+				StatusCode: tc.status,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(tc.body)),
+			}
+			if tc.header != "" {
+				resp.Header.Set("Retry-After", tc.header)
+			}
+
+			require.Equal(t, tc.want, retryAfter(resp))
+
+			// The body must still be readable by the caller.
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tc.body, string(body))
+		})
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// providerMetaType is the type of the provider_meta block of modules.
+var providerMetaType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"module_name": tftypes.String,
+	},
+}
+
+// NewProviderServer wraps the provider server returned by next, such that
+// the deferred client of the provider is resolved before resources, data
+// sources, ephemeral resources and list resources use it, see
+// NewDeferredClient. client returns the client the provider was configured
+// with, or nil if it isn't configured yet.
+//
+// Validating configurations and calling functions never resolves the client,
+// so they work without network access. If resolving fails, the error is
+// returned by the operation that triggered the resolution.
+//
+// Every operation is traced, along with the requests it sends to the HCP API.
+//
+// The module name of the provider_meta block of the module that configured a
+// resource or data source is added to the context of its operations, such
+// that the requests they send identify the module, see WithModuleName.
+func NewProviderServer(next func() tfprotov6.ProviderServer, client func() *Client) func() tfprotov6.ProviderServer {
+	return func() tfprotov6.ProviderServer {
+		return &providerServer{
+			ProviderServer: next(),
+			client:         client,
+		}
+	}
+}
+
+type providerServer struct {
+	tfprotov6.ProviderServer
+	client func() *Client
+}
+
+func (s *providerServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	ctx = withProviderMeta(ctx, req.ProviderMeta)
+	ctx, span := startOperationSpan(ctx, "ReadResource", req.TypeName)
+	diags := s.resolve(ctx)
+	if hasError(diags) {
+		endOperationSpan(span, diags, nil)
+		return &tfprotov6.ReadResourceResponse{Diagnostics: diags}, nil
+	}
+
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = append(diags, resp.Diagnostics...)
+		diags = resp.Diagnostics
+	}
+	endOperationSpan(span, diags, err)
+	return resp, err
+}
+
+func (s *providerServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	ctx = withProviderMeta(ctx, req.ProviderMeta)
+	ctx, span := startOperationSpan(ctx, "PlanResourceChange", req.TypeName)
+	diags := s.resolve(ctx)
+	if hasError(diags) {
+		endOperationSpan(span, diags, nil)
+		return &tfprotov6.PlanResourceChangeResponse{Diagnostics: diags}, nil
+	}
+
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = append(diags, resp.Diagnostics...)
+		diags = resp.Diagnostics
+	}
+	endOperationSpan(span, diags, err)
+	return resp, err
+}
+
+func (s *providerServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	ctx = withProviderMeta(ctx, req.ProviderMeta)
+	ctx, span := startOperationSpan(ctx, "ApplyResourceChange", req.TypeName, attribute.String("tf.action", applyAction(req)))
+	diags := s.resolve(ctx)
+	if hasError(diags) {
+		endOperationSpan(span, diags, nil)
+		return &tfprotov6.ApplyResourceChangeResponse{Diagnostics: diags}, nil
+	}
+
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = append(diags, resp.Diagnostics...)
+		diags = resp.Diagnostics
+	}
+	endOperationSpan(span, diags, err)
+	return resp, err
+}
+
+func (s *providerServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	ctx, span := startOperationSpan(ctx, "ImportResourceState", req.TypeName)
+	diags := s.resolve(ctx)
+	if hasError(diags) {
+		endOperationSpan(span, diags, nil)
+		return &tfprotov6.ImportResourceStateResponse{Diagnostics: diags}, nil
+	}
+
+	resp, err := s.ProviderServer.ImportResourceState(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = append(diags, resp.Diagnostics...)
+		diags = resp.Diagnostics
+	}
+	endOperationSpan(span, diags, err)
+	return resp, err
+}
+
+func (s *providerServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	ctx = withProviderMeta(ctx, req.ProviderMeta)
+	ctx, span := startOperationSpan(ctx, "ReadDataSource", req.TypeName)
+	diags := s.resolve(ctx)
+	if hasError(diags) {
+		endOperationSpan(span, diags, nil)
+		return &tfprotov6.ReadDataSourceResponse{Diagnostics: diags}, nil
+	}
+
+	resp, err := s.ProviderServer.ReadDataSource(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = append(diags, resp.Diagnostics...)
+		diags = resp.Diagnostics
+	}
+	endOperationSpan(span, diags, err)
+	return resp, err
+}
+
+func (s *providerServer) OpenEphemeralResource(ctx context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	ctx, span := startOperationSpan(ctx, "OpenEphemeralResource", req.TypeName)
+	diags := s.resolve(ctx)
+	if hasError(diags) {
+		endOperationSpan(span, diags, nil)
+		return &tfprotov6.OpenEphemeralResourceResponse{Diagnostics: diags}, nil
+	}
+
+	resp, err := s.ProviderServer.OpenEphemeralResource(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = append(diags, resp.Diagnostics...)
+		diags = resp.Diagnostics
+	}
+	endOperationSpan(span, diags, err)
+	return resp, err
+}
+
+func (s *providerServer) RenewEphemeralResource(ctx context.Context, req *tfprotov6.RenewEphemeralResourceRequest) (*tfprotov6.RenewEphemeralResourceResponse, error) {
+	ctx, span := startOperationSpan(ctx, "RenewEphemeralResource", req.TypeName)
+	diags := s.resolve(ctx)
+	if hasError(diags) {
+		endOperationSpan(span, diags, nil)
+		return &tfprotov6.RenewEphemeralResourceResponse{Diagnostics: diags}, nil
+	}
+
+	resp, err := s.ProviderServer.RenewEphemeralResource(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = append(diags, resp.Diagnostics...)
+		diags = resp.Diagnostics
+	}
+	endOperationSpan(span, diags, err)
+	return resp, err
+}
+
+func (s *providerServer) CloseEphemeralResource(ctx context.Context, req *tfprotov6.CloseEphemeralResourceRequest) (*tfprotov6.CloseEphemeralResourceResponse, error) {
+	ctx, span := startOperationSpan(ctx, "CloseEphemeralResource", req.TypeName)
+	diags := s.resolve(ctx)
+	if hasError(diags) {
+		endOperationSpan(span, diags, nil)
+		return &tfprotov6.CloseEphemeralResourceResponse{Diagnostics: diags}, nil
+	}
+
+	resp, err := s.ProviderServer.CloseEphemeralResource(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = append(diags, resp.Diagnostics...)
+		diags = resp.Diagnostics
+	}
+	endOperationSpan(span, diags, err)
+	return resp, err
+}
+
+func (s *providerServer) ValidateListResourceConfig(ctx context.Context, req *tfprotov6.ValidateListResourceConfigRequest) (*tfprotov6.ValidateListResourceConfigResponse, error) {
+	listServer, ok := s.ProviderServer.(tfprotov6.ListResourceServer)
+	if !ok {
+		return &tfprotov6.ValidateListResourceConfigResponse{}, nil
+	}
+	return listServer.ValidateListResourceConfig(ctx, req)
+}
+
+func (s *providerServer) ListResource(ctx context.Context, req *tfprotov6.ListResourceRequest) (*tfprotov6.ListResourceServerStream, error) {
+	listServer, ok := s.ProviderServer.(tfprotov6.ListResourceServer)
+	if !ok {
+		return &tfprotov6.ListResourceServerStream{Results: tfprotov6.NoListResults}, nil
+	}
+
+	// The span ends once the results are streamed, which is when the
+	// requests listing the resources are sent.
+	ctx, span := startOperationSpan(ctx, "ListResource", req.TypeName)
+	diags := s.resolve(ctx)
+	if hasError(diags) {
+		endOperationSpan(span, diags, nil)
+		return &tfprotov6.ListResourceServerStream{Results: slices.Values([]tfprotov6.ListResourceResult{{Diagnostics: diags}})}, nil
+	}
+
+	stream, err := listServer.ListResource(ctx, req)
+	if err != nil || stream == nil || stream.Results == nil {
+		endOperationSpan(span, diags, err)
+		return stream, err
+	}
+
+	results := stream.Results
+	stream.Results = func(push func(tfprotov6.ListResourceResult) bool) {
+		var resultDiags []*tfprotov6.Diagnostic
+		defer func() { endOperationSpan(span, resultDiags, nil) }()
+
+		first := true
+		for result := range results {
+			if first {
+				result.Diagnostics = append(diags, result.Diagnostics...)
+				first = false
+			}
+			resultDiags = append(resultDiags, result.Diagnostics...)
+			if !push(result) {
+				return
+			}
+		}
+	}
+	return stream, nil
+}
+
+// resolve resolves the client of the provider, and returns the error or the
+// warning raised doing so as diagnostics.
+func (s *providerServer) resolve(ctx context.Context) []*tfprotov6.Diagnostic {
+	client := s.client()
+	if client == nil {
+		return nil
+	}
+
+	warning, err := client.resolve(ctx)
+	if err != nil {
+		return []*tfprotov6.Diagnostic{{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Unable to configure the HCP API client",
+			Detail:   err.Error(),
+		}}
+	}
+	if warning != nil {
+		return []*tfprotov6.Diagnostic{{
+			Severity: tfprotov6.DiagnosticSeverityWarning,
+			Summary:  warning.summary,
+			Detail:   warning.detail,
+		}}
+	}
+	return nil
+}
+
+// withProviderMeta returns a copy of ctx carrying the module name of the
+// provider_meta block of a request, if set.
+func withProviderMeta(ctx context.Context, meta *tfprotov6.DynamicValue) context.Context {
+	if meta == nil {
+		return ctx
+	}
+
+	value, err := meta.Unmarshal(providerMetaType)
+	if err != nil {
+		tflog.Warn(ctx, "failed to decode provider_meta", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return ctx
+	}
+	if value.IsNull() || !value.IsKnown() {
+		return ctx
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := value.As(&attrs); err != nil {
+		tflog.Warn(ctx, "failed to decode provider_meta", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return ctx
+	}
+
+	var moduleName *string
+	if err := attrs["module_name"].As(&moduleName); err != nil || moduleName == nil {
+		return ctx
+	}
+	return WithModuleName(ctx, *moduleName)
+}
+
+// hasError returns whether diags contains an error.
+func hasError(diags []*tfprotov6.Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
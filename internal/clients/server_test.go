@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProviderServer responds to every request with empty responses.
+type fakeProviderServer struct {
+	tfprotov6.ProviderServer
+}
+
+func (fakeProviderServer) ValidateResourceConfig(context.Context, *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
+	return &tfprotov6.ValidateResourceConfigResponse{}, nil
+}
+
+func (fakeProviderServer) ReadDataSource(context.Context, *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	return &tfprotov6.ReadDataSourceResponse{}, nil
+}
+
+func TestProviderServer(t *testing.T) {
+	ctx := context.Background()
+	resolutions := 0
+	client := &Client{
+		resolution: &resolution{
+			token: func() error {
+				resolutions++
+				return errors.New("invalid client secret")
+			},
+		},
+	}
+	server := NewProviderServer(
+		func() tfprotov6.ProviderServer { return fakeProviderServer{} },
+		func() *Client { return client },
+	)()
+
+	validateResp, err := server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{TypeName: "hcp_project"})
+	require.NoError(t, err)
+	require.Empty(t, validateResp.Diagnostics)
+	require.Zero(t, resolutions, "validating must not resolve the client")
+
+	for range 2 {
+		readResp, err := server.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{TypeName: "hcp_project"})
+		require.NoError(t, err)
+		require.Len(t, readResp.Diagnostics, 1)
+		require.Equal(t, tfprotov6.DiagnosticSeverityError, readResp.Diagnostics[0].Severity)
+		require.Contains(t, readResp.Diagnostics[0].Detail, "invalid client secret")
+	}
+	require.Equal(t, 1, resolutions)
+}
+
+func TestProviderServer_Unconfigured(t *testing.T) {
+	server := NewProviderServer(
+		func() tfprotov6.ProviderServer { return fakeProviderServer{} },
+		func() *Client { return nil },
+	)()
+
+	resp, err := server.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{TypeName: "hcp_project"})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/hcp-sdk-go/version"
+)
+
+// sourceChannelHeader is the header that identifies the client (channel)
+// that originated a request, it's synonymous to a user-agent.
+const sourceChannelHeader = "X-HCP-Source-Channel"
+
+// moduleNameKey is the context key of the module name of a request.
+type moduleNameKey struct{}
+
+// WithModuleName returns a copy of ctx carrying the name of the module that
+// uses the provider, as set in the provider_meta block of the module. The
+// source channel of the requests sent with the context includes it.
+func WithModuleName(ctx context.Context, moduleName string) context.Context {
+	if moduleName == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, moduleNameKey{}, moduleName)
+}
+
+// moduleName returns the module name carried by ctx, if any.
+func moduleName(ctx context.Context) string {
+	name, _ := ctx.Value(moduleNameKey{}).(string)
+	return name
+}
+
+// sourceChannelTransport stamps the source channel of the client on every
+// request, including the module that sent the request, if known.
+type sourceChannelTransport struct {
+	next          http.RoundTripper
+	sourceChannel string
+}
+
+// newSourceChannelTransport returns a transport stamping requests with the
+// passed source channel before sending them with next.
+func newSourceChannelTransport(next http.RoundTripper, sourceChannel string) http.RoundTripper {
+	return &sourceChannelTransport{
+		next:          next,
+		sourceChannel: sourceChannel,
+	}
+}
+
+func (t *sourceChannelTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	sc := t.sourceChannel
+	if name := moduleName(req.Context()); name != "" {
+		sc = fmt.Sprintf("%s terraform-module/%s", sc, name)
+	}
+	if sc == "" {
+		return t.next.RoundTrip(req)
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set(sourceChannelHeader, fmt.Sprintf("%s hcp-go-sdk/%s", sc, version.Version))
+	return t.next.RoundTrip(req)
+}
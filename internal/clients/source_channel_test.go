@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/hcp-sdk-go/version"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sourceChannelTransport(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get(sourceChannelHeader)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: newSourceChannelTransport(http.DefaultTransport, "terraform-provider-hcp/dev")}
+	send := func(ctx context.Context) *http.Request {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return req
+	}
+
+	req := send(context.Background())
+	require.Equal(t, "terraform-provider-hcp/dev hcp-go-sdk/"+version.Version, got)
+	require.Empty(t, req.Header.Get(sourceChannelHeader), "the request of the caller must not be modified")
+
+	send(WithModuleName(context.Background(), "vault"))
+	require.Equal(t, "terraform-provider-hcp/dev terraform-module/vault hcp-go-sdk/"+version.Version, got)
+
+	// The module of one request doesn't leak into the next.
+	send(context.Background())
+	require.Equal(t, "terraform-provider-hcp/dev hcp-go-sdk/"+version.Version, got)
+}
+
+// moduleProviderServer records the module name of the requests it receives.
+type moduleProviderServer struct {
+	tfprotov6.ProviderServer
+	moduleName *string
+}
+
+func (s moduleProviderServer) ReadDataSource(ctx context.Context, _ *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	*s.moduleName = moduleName(ctx)
+	return &tfprotov6.ReadDataSourceResponse{}, nil
+}
+
+func TestProviderServer_ProviderMeta(t *testing.T) {
+	var got string
+	server := NewProviderServer(
+		func() tfprotov6.ProviderServer { return moduleProviderServer{moduleName: &got} },
+		func() *Client { return nil },
+	)()
+
+	meta := func(moduleName tftypes.Value) *tfprotov6.DynamicValue {
+		value, err := tfprotov6.NewDynamicValue(providerMetaType, tftypes.NewValue(providerMetaType, map[string]tftypes.Value{
+			"module_name": moduleName,
+		}))
+		require.NoError(t, err)
+		return &value
+	}
+
+	for name, tc := range map[string]struct {
+		meta     *tfprotov6.DynamicValue
+		expected string
+	}{
+		"module":  {meta: meta(tftypes.NewValue(tftypes.String, "vault")), expected: "vault"},
+		"null":    {meta: meta(tftypes.NewValue(tftypes.String, nil))},
+		"missing": {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got = "unset"
+			_, err := server.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{
+				TypeName:     "hcp_project",
+				ProviderMeta: tc.meta,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// DefaultOperationTimeout is the timeout of operations on resources if the
+// configuration doesn't set one.
+const DefaultOperationTimeout = 5 * time.Minute
+
+// AttributeGetter is implemented by the plan and state of framework resources.
+type AttributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// WithOperationTimeout returns a context that is canceled once the timeout
+// configured for the operation in the timeouts block of d expires. The
+// operation is passed as a method expression, e.g. timeouts.Value.Create, and
+// defaultTimeout is used if the configuration doesn't set a timeout.
+//
+// It is meant for resources that don't decode their plan or state into a
+// model, models should use the methods of their timeouts.Value instead.
+func WithOperationTimeout(
+	ctx context.Context,
+	d AttributeGetter,
+	operation func(timeouts.Value, context.Context, time.Duration) (time.Duration, diag.Diagnostics),
+	defaultTimeout time.Duration,
+) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	var t timeouts.Value
+	diags := d.GetAttribute(ctx, path.Root("timeouts"), &t)
+	if diags.HasError() {
+		return ctx, func() {}, diags
+	}
+
+	timeout, timeoutDiags := operation(t, ctx, defaultTimeout)
+	diags.Append(timeoutDiags...)
+	if diags.HasError() {
+		return ctx, func() {}, diags
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, cancel, diags
+}
@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/hcp-sdk-go/auth"
+	hcpConfig "github.com/hashicorp/hcp-sdk-go/config"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// tokenCacheDirEnvVar and tokenCacheKeyEnvVar configure the token cache
+	// if the provider configuration doesn't.
+	tokenCacheDirEnvVar = "HCP_TOKEN_CACHE_DIR"
+	tokenCacheKeyEnvVar = "HCP_TOKEN_CACHE_KEY"
+
+	// tokenCacheMinTTL is how long a cached token has to remain valid to be
+	// reused, such that it doesn't expire while requests are sent with it.
+	tokenCacheMinTTL = time.Minute
+
+	// tokenCacheLockTimeout is how long to wait for another process holding
+	// the lock of a cache entry, before a token is exchanged without it.
+	tokenCacheLockTimeout = time.Minute
+
+	// tokenCacheLockInterval is how often the lock of a cache entry is tried
+	// while it's held by another process.
+	tokenCacheLockInterval = time.Millisecond * 100
+)
+
+// tokenCacheEntry is a token as stored, encrypted, in the token cache.
+type tokenCacheEntry struct {
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type,omitempty"`
+	Expiry      time.Time `json:"expiry"`
+}
+
+// tokenCache is a token source that reuses tokens that are cached on disk by
+// other provider processes with the same credentials, and caches the tokens
+// it gets from the next source for them. Processes hold a lock on the entry
+// of their credentials while exchanging tokens, such that concurrent
+// processes wait for a single exchange.
+type tokenCache struct {
+	next oauth2.TokenSource
+
+	// path is the file the tokens of the credentials are cached in.
+	path string
+
+	// aead encrypts the cached tokens, and authenticates that they were
+	// cached for the credentials.
+	aead     cipher.AEAD
+	identity []byte
+}
+
+// newTokenCache returns a token source that caches the tokens of next in
+// dir, encrypted with key. identity identifies the credentials next
+// exchanges for tokens, and apiAddress the HCP they are valid for.
+func newTokenCache(next oauth2.TokenSource, dir, key, identity, apiAddress string) (*tokenCache, error) {
+	if key == "" {
+		return nil, errors.New("a token cache key must be set to cache tokens")
+	}
+
+	// The key of the cipher is derived from the configured key, such that
+	// keys of any length can be configured.
+	derived := sha256.Sum256([]byte("terraform-provider-hcp token cache\x00" + key))
+	block, err := aes.NewCipher(derived[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// Entries are named by a hash of the credentials and the HCP they are
+	// valid for, such that the names don't reveal the credentials.
+	id := sha256.Sum256([]byte(identity + "\x00" + apiAddress))
+	name := hex.EncodeToString(id[:])
+
+	return &tokenCache{
+		next:     next,
+		path:     filepath.Join(dir, name+".json"),
+		aead:     aead,
+		identity: id[:],
+	}, nil
+}
+
+// Token returns the cached token, if it remains valid for long enough, or
+// exchanges and caches a new token otherwise. Errors accessing the cache are
+// logged, the token is then exchanged without it.
+func (c *tokenCache) Token() (*oauth2.Token, error) {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		log.Printf("[WARN] failed to create token cache directory, tokens are not cached: %v", err)
+		return c.next.Token()
+	}
+
+	unlock, err := lockFile(c.path+".lock", tokenCacheLockTimeout)
+	if err != nil {
+		log.Printf("[WARN] failed to lock token cache, tokens are not cached: %v", err)
+		return c.next.Token()
+	}
+	defer unlock()
+
+	token, err := c.read()
+	if err != nil {
+		log.Printf("[WARN] failed to read token cache: %v", err)
+	}
+	if token != nil && token.Expiry.After(time.Now().Add(tokenCacheMinTTL)) {
+		log.Printf("[DEBUG] reusing cached HCP token, valid until %s", token.Expiry.Format(time.RFC3339))
+		return token, nil
+	}
+
+	token, err = c.next.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens without an expiry can't be reused safely.
+	if !token.Expiry.IsZero() {
+		if err := c.write(token); err != nil {
+			log.Printf("[WARN] failed to write token cache: %v", err)
+		}
+	}
+
+	return token, nil
+}
+
+// read reads and decrypts the cached token, it returns nil if no token is
+// cached.
+func (c *tokenCache) read() (*oauth2.Token, error) {
+	data, err := os.ReadFile(c.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := c.aead.NonceSize()
+	if len(data) < nonceSize {
+		return nil, errors.New("cached token is truncated")
+	}
+	plaintext, err := c.aead.Open(nil, data[:nonceSize], data[nonceSize:], c.identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt cached token, it may have been cached with another key: %w", err)
+	}
+
+	var entry tokenCacheEntry
+	if err := json.Unmarshal(plaintext, &entry); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cached token: %w", err)
+	}
+
+	return &oauth2.Token{
+		AccessToken: entry.AccessToken,
+		TokenType:   entry.TokenType,
+		Expiry:      entry.Expiry,
+	}, nil
+}
+
+// write encrypts and caches the token. The file is replaced atomically, such
+// that a process that crashes while writing doesn't corrupt the cache.
+func (c *tokenCache) write(token *oauth2.Token) error {
+	plaintext, err := json.Marshal(tokenCacheEntry{
+		AccessToken: token.AccessToken,
+		TokenType:   token.TokenType,
+		Expiry:      token.Expiry,
+	})
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	data := c.aead.Seal(nonce, nonce, plaintext, c.identity)
+
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), c.path)
+}
+
+// tokenCacheIdentity returns the identity of the credentials the client
+// authenticates with, following the precedence of the HCP SDK. It returns
+// an empty identity for user logins, whose tokens are cached by the HCP SDK.
+func tokenCacheIdentity(config ClientConfig) (string, error) {
+	clientID, clientSecret := config.ClientID, config.ClientSecret
+	if clientID == "" || clientSecret == "" {
+		clientID, clientSecret = os.Getenv("HCP_CLIENT_ID"), os.Getenv("HCP_CLIENT_SECRET")
+	}
+	if clientID != "" && clientSecret != "" {
+		return servicePrincipalIdentity(clientID, clientSecret), nil
+	}
+
+	cf := loadCredentialFile(config)
+	if cf == nil {
+		var err error
+		if config.CredentialFile != "" {
+			cf, err = auth.ReadCredentialFile(config.CredentialFile)
+		} else {
+			cf, err = auth.GetDefaultCredentialFile()
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	switch {
+	case cf == nil:
+		return "", nil
+	case cf.Scheme == auth.CredentialFileSchemeServicePrincipal && cf.Oauth != nil:
+		return servicePrincipalIdentity(cf.Oauth.ClientID, cf.Oauth.ClientSecret), nil
+	case cf.Scheme == auth.CredentialFileSchemeWorkload && cf.Workload != nil:
+		return "workload:" + cf.Workload.ProviderResourceName, nil
+	default:
+		return "", nil
+	}
+}
+
+// servicePrincipalIdentity returns the identity of a service principal key.
+// The secret is part of the identity, such that tokens aren't reused once
+// the key is rotated.
+func servicePrincipalIdentity(clientID, clientSecret string) string {
+	secret := sha256.Sum256([]byte(clientSecret))
+	return "service-principal:" + clientID + ":" + hex.EncodeToString(secret[:])
+}
+
+// cachingHCPConfig is an HCP config whose tokens are sourced from a token
+// cache.
+type cachingHCPConfig struct {
+	hcpConfig.HCPConfig
+	source oauth2.TokenSource
+}
+
+func (c *cachingHCPConfig) Token() (*oauth2.Token, error) {
+	return c.source.Token()
+}
+
+// withTokenCache returns the HCP config of a client, whose tokens are cached
+// as configured, see ClientConfig.TokenCacheDir.
+func withTokenCache(hcp hcpConfig.HCPConfig, config ClientConfig) (hcpConfig.HCPConfig, error) {
+	dir := config.TokenCacheDir
+	if dir == "" {
+		dir = os.Getenv(tokenCacheDirEnvVar)
+	}
+	if dir == "" {
+		return hcp, nil
+	}
+
+	key := config.TokenCacheKey
+	if key == "" {
+		key = os.Getenv(tokenCacheKeyEnvVar)
+	}
+	if key == "" {
+		return nil, fmt.Errorf("a token cache key must be set to cache tokens, set token_cache_key or the %s environment variable", tokenCacheKeyEnvVar)
+	}
+
+	identity, err := tokenCacheIdentity(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to identify credentials for the token cache: %w", err)
+	}
+	if identity == "" {
+		return hcp, nil
+	}
+
+	cache, err := newTokenCache(hcp, dir, key, identity, hcp.APIAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens are reused within the process until they expire, the cache is
+	// only read for new tokens.
+	return &cachingHCPConfig{
+		HCPConfig: hcp,
+		source:    oauth2.ReuseTokenSource(nil, cache),
+	}, nil
+}
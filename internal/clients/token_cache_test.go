@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// countingTokenSource exchanges tokens that are valid for ttl, and counts
+// the exchanges.
+type countingTokenSource struct {
+	ttl       time.Duration
+	delay     time.Duration
+	exchanges atomic.Int32
+}
+
+func (s *countingTokenSource) Token() (*oauth2.Token, error) {
+	n := s.exchanges.Add(1)
+	time.Sleep(s.delay)
+	return &oauth2.Token{
+		AccessToken: fmt.Sprintf("token-%d", n),
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(s.ttl),
+	}, nil
+}
+
+func TestTokenCache(t *testing.T) {
+	newCache := func(t *testing.T, next oauth2.TokenSource, dir, key string) *tokenCache {
+		c, err := newTokenCache(next, dir, key, "service-principal:id", "api.hashicorp.cloud")
+		require.NoError(t, err)
+		return c
+	}
+
+	t.Run("reuse", func(t *testing.T) {
+		dir := t.TempDir()
+		next := &countingTokenSource{ttl: time.Hour}
+
+		first, err := newCache(t, next, dir, "key").Token()
+		require.NoError(t, err)
+
+		// Another process with the same credentials reuses the token.
+		second, err := newCache(t, next, dir, "key").Token()
+		require.NoError(t, err)
+		require.Equal(t, first.AccessToken, second.AccessToken)
+		require.EqualValues(t, 1, next.exchanges.Load())
+
+		// The token is encrypted.
+		entries, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		data, err := os.ReadFile(entries[0])
+		require.NoError(t, err)
+		require.NotContains(t, string(data), first.AccessToken)
+	})
+
+	t.Run("expiring", func(t *testing.T) {
+		dir := t.TempDir()
+		next := &countingTokenSource{ttl: tokenCacheMinTTL / 2}
+
+		_, err := newCache(t, next, dir, "key").Token()
+		require.NoError(t, err)
+		_, err = newCache(t, next, dir, "key").Token()
+		require.NoError(t, err)
+		require.EqualValues(t, 2, next.exchanges.Load())
+	})
+
+	t.Run("other key", func(t *testing.T) {
+		dir := t.TempDir()
+		next := &countingTokenSource{ttl: time.Hour}
+
+		_, err := newCache(t, next, dir, "key").Token()
+		require.NoError(t, err)
+		token, err := newCache(t, next, dir, "other").Token()
+		require.NoError(t, err)
+		require.Equal(t, "token-2", token.AccessToken)
+	})
+
+	t.Run("other identity", func(t *testing.T) {
+		dir := t.TempDir()
+		next := &countingTokenSource{ttl: time.Hour}
+
+		_, err := newCache(t, next, dir, "key").Token()
+		require.NoError(t, err)
+
+		other, err := newTokenCache(next, dir, "key", "workload:provider", "api.hashicorp.cloud")
+		require.NoError(t, err)
+		token, err := other.Token()
+		require.NoError(t, err)
+		require.Equal(t, "token-2", token.AccessToken)
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		dir := t.TempDir()
+		next := &countingTokenSource{ttl: time.Hour, delay: 50 * time.Millisecond}
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				token, err := newCache(t, next, dir, "key").Token()
+				assert.NoError(t, err)
+				assert.Equal(t, "token-1", token.AccessToken)
+			}()
+		}
+		wg.Wait()
+
+		require.EqualValues(t, 1, next.exchanges.Load())
+	})
+}
+
+func Test_tokenCacheIdentity(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("HCP_CLIENT_ID", "")
+	t.Setenv("HCP_CLIENT_SECRET", "")
+	t.Setenv("HCP_CRED_FILE", "")
+
+	identity := func(t *testing.T, config ClientConfig) string {
+		id, err := tokenCacheIdentity(config)
+		require.NoError(t, err)
+		return id
+	}
+
+	sp := identity(t, ClientConfig{ClientID: "id", ClientSecret: "secret"})
+	require.Contains(t, sp, "service-principal:id:")
+	require.NotContains(t, sp, "secret")
+	require.NotEqual(t, sp, identity(t, ClientConfig{ClientID: "id", ClientSecret: "rotated"}))
+
+	require.Equal(t, "workload:provider", identity(t, ClientConfig{
+		WorkloadIdentityToken:        "jwt",
+		WorkloadIdentityResourceName: "provider",
+	}))
+
+	// User logins are cached by the HCP SDK.
+	require.Empty(t, identity(t, ClientConfig{}))
+
+	t.Setenv("HCP_CLIENT_ID", "id")
+	t.Setenv("HCP_CLIENT_SECRET", "secret")
+	require.Equal(t, sp, identity(t, ClientConfig{}))
+}
+
+func Test_withTokenCache(t *testing.T) {
+	t.Setenv(tokenCacheKeyEnvVar, "")
+
+	_, err := NewDeferredClient(ClientConfig{
+		ClientID:      "id",
+		ClientSecret:  "secret",
+		TokenCacheDir: t.TempDir(),
+	})
+	require.ErrorContains(t, err, "a token cache key must be set")
+
+	client, err := NewDeferredClient(ClientConfig{
+		ClientID:      "id",
+		ClientSecret:  "secret",
+		TokenCacheDir: t.TempDir(),
+		TokenCacheKey: "key",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, client)
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer traces the operations of the provider and the requests they send to
+// the HCP API. Spans are only exported if tracing is enabled, see
+// telemetry.Start.
+var tracer = otel.Tracer("github.com/hashicorp/terraform-provider-hcp/internal/clients")
+
+// startOperationSpan starts the span of an operation of the provider on a
+// resource, data source or ephemeral resource of the passed type.
+func startOperationSpan(ctx context.Context, operation, typeName string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append([]attribute.KeyValue{
+		attribute.String("tf.operation", operation),
+		attribute.String("tf.type_name", typeName),
+	}, attrs...)
+	return tracer.Start(ctx, fmt.Sprintf("%s %s", operation, typeName), trace.WithAttributes(attrs...))
+}
+
+// endOperationSpan ends the span of an operation, as failed if diags contains
+// an error.
+func endOperationSpan(span trace.Span, diags []*tfprotov6.Diagnostic, err error) {
+	defer span.End()
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			span.SetStatus(codes.Error, d.Summary)
+			return
+		}
+	}
+}
+
+// applyAction returns the action an ApplyResourceChange request takes, based
+// on which of its states are null.
+func applyAction(req *tfprotov6.ApplyResourceChangeRequest) string {
+	switch {
+	case isNull(req.PriorState):
+		return "create"
+	case isNull(req.PlannedState):
+		return "delete"
+	default:
+		return "update"
+	}
+}
+
+// isNull returns whether the dynamic value is null, without knowing its type.
+func isNull(value *tfprotov6.DynamicValue) bool {
+	if value == nil {
+		return true
+	}
+	if len(value.MsgPack) > 0 {
+		// The MessagePack encoding of nil.
+		return len(value.MsgPack) == 1 && value.MsgPack[0] == 0xc0
+	}
+	return len(value.JSON) == 0 || string(value.JSON) == "null"
+}
+
+// tracingClientTransport traces the operations of the HCP API that the
+// clients of a service submit, the requests sent for an operation are traced
+// within its span.
+type tracingClientTransport struct {
+	next    runtime.ClientTransport
+	service string
+}
+
+// newTracingClientTransport returns a transport tracing the operations of the
+// passed service that are submitted with next.
+func newTracingClientTransport(next runtime.ClientTransport, service string) runtime.ClientTransport {
+	return &tracingClientTransport{
+		next:    next,
+		service: service,
+	}
+}
+
+func (t *tracingClientTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	ctx := op.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, span := tracer.Start(ctx, op.ID,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("hcp.service", t.service),
+			attribute.String("hcp.api.operation_id", op.ID),
+			attribute.String("http.request.method", op.Method),
+			attribute.String("url.template", op.PathPattern),
+		),
+	)
+	defer span.End()
+
+	op.Context = ctx
+	result, err := t.next.Submit(op)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
+
+// tracingTransport traces the attempts to send a request to the HCP API,
+// including the time they wait for the rate limit.
+type tracingTransport struct {
+	next http.RoundTripper
+}
+
+// newTracingTransport returns a transport tracing the requests sent with
+// next.
+func newTracingTransport(next http.RoundTripper) http.RoundTripper {
+	return &tracingTransport{next: next}
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := tracer.Start(req.Context(), "HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("server.address", req.URL.Hostname()),
+			attribute.String("url.path", req.URL.Path),
+		),
+	)
+	defer span.End()
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Int("http.response.status_code", resp.StatusCode),
+		attribute.String("hcp.request_id", resp.Header.Get(requestIDHeader)),
+	)
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	return resp, nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var (
+	spanRecorderOnce sync.Once
+	spanRecorder     *tracetest.SpanRecorder
+)
+
+// recordSpans records the spans of the test. The global tracer provider can
+// only be set once, so all tests share the recorder.
+func recordSpans(t *testing.T) func() tracetest.SpanStubs {
+	t.Helper()
+
+	spanRecorderOnce.Do(func() {
+		spanRecorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+	})
+
+	start := len(spanRecorder.Ended())
+	return func() tracetest.SpanStubs {
+		return tracetest.SpanStubsFromReadOnlySpans(spanRecorder.Ended()[start:])
+	}
+}
+
+// attributes returns the attributes of the span as a map.
+func attributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value, len(span.Attributes))
+	for _, kv := range span.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestProviderServer_Tracing(t *testing.T) {
+	spans := recordSpans(t)
+
+	server := NewProviderServer(
+		func() tfprotov6.ProviderServer { return fakeProviderServer{} },
+		func() *Client { return nil },
+	)()
+
+	_, err := server.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{TypeName: "hcp_project"})
+	require.NoError(t, err)
+
+	ended := spans()
+	require.Len(t, ended, 1)
+	require.Equal(t, "ReadDataSource hcp_project", ended[0].Name)
+	require.Equal(t, "hcp_project", attributes(ended[0])["tf.type_name"].AsString())
+	require.Equal(t, codes.Unset, ended[0].Status.Code)
+}
+
+func Test_applyAction(t *testing.T) {
+	null := &tfprotov6.DynamicValue{MsgPack: []byte{0xc0}}
+	state := &tfprotov6.DynamicValue{MsgPack: []byte{0x81, 0xa2, 'i', 'd', 0xa1, 'x'}}
+
+	require.Equal(t, "create", applyAction(&tfprotov6.ApplyResourceChangeRequest{PriorState: null, PlannedState: state}))
+	require.Equal(t, "update", applyAction(&tfprotov6.ApplyResourceChangeRequest{PriorState: state, PlannedState: state}))
+	require.Equal(t, "delete", applyAction(&tfprotov6.ApplyResourceChangeRequest{PriorState: state, PlannedState: null}))
+	require.Equal(t, "delete", applyAction(&tfprotov6.ApplyResourceChangeRequest{
+		PriorState:   state,
+		PlannedState: &tfprotov6.DynamicValue{JSON: []byte("null")},
+	}))
+}
+
+func Test_tracingTransport(t *testing.T) {
+	spans := recordSpans(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIDHeader, "req-1")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/resource-manager/2019-12-10/projects/p", nil)
+	require.NoError(t, err)
+	resp, err := newTracingTransport(http.DefaultTransport).RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	ended := spans()
+	require.Len(t, ended, 1)
+	require.Equal(t, "HTTP GET", ended[0].Name)
+	attrs := attributes(ended[0])
+	require.EqualValues(t, http.StatusNotFound, attrs["http.response.status_code"].AsInt64())
+	require.Equal(t, "req-1", attrs["hcp.request_id"].AsString())
+	require.Equal(t, codes.Error, ended[0].Status.Code)
+}
+
+func TestWaitForOperation_Tracing(t *testing.T) {
+	spans := recordSpans(t)
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{OrganizationID: "org", ProjectID: "project"}
+	client := &Client{
+		Config: ClientConfig{MaxRetries: 1},
+		Operation: &fakeOperationService{wait: func() (*sharedmodels.HashicorpCloudOperationOperation, error) {
+			return &sharedmodels.HashicorpCloudOperationOperation{
+				ID:    "op-1",
+				State: sharedmodels.HashicorpCloudOperationOperationStateDONE.Pointer(),
+			}, nil
+		}},
+	}
+	require.NoError(t, WaitForOperation(context.Background(), client, "create", loc, "op-1"))
+
+	ended := spans()
+	require.Len(t, ended, 1)
+	require.Equal(t, "WaitForOperation create", ended[0].Name)
+	require.Equal(t, "op-1", attributes(ended[0])["hcp.operation.id"].AsString())
+	require.Len(t, ended[0].Events, 1)
+}
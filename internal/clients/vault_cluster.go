@@ -5,6 +5,7 @@ package clients
 
 import (
 	"context"
+	"time"
 
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/client/vault_service"
@@ -68,6 +69,10 @@ func DeleteVaultCluster(ctx context.Context, client *Client, loc *sharedmodels.H
 	return deleteResp.Payload, nil
 }
 
+// VaultClusterAdminTokenExpiry is the length of time before a generated
+// admin token expires.
+const VaultClusterAdminTokenExpiry = time.Hour * 6
+
 // CreateVaultClusterAdminToken will make a call to the Vault service to generate an admin token for the Vault cluster
 // that expires after 6 hours.
 func CreateVaultClusterAdminToken(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation,
@@ -17,6 +17,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// RadarOffboardTimeout is how long the offboarding of Vault Radar sources and
+// secret managers is waited for. It is also the timeout of operations on Vault
+// Radar resources if the configuration doesn't set one.
+const RadarOffboardTimeout = 10 * time.Minute
+
 func OnboardRadarSource(ctx context.Context, client *Client, projectID string, source dsrs.OnboardDataSourceBody) (*dsrs.OnboardDataSourceOK, error) {
 	onboardParams := dsrs.NewOnboardDataSourceParamsWithTimeout(2 * time.Minute) // gives datasources with "agent" detector type more time to complete
 	onboardParams.Context = ctx
@@ -128,7 +133,7 @@ func WaitOnOffboardRadarSource(ctx context.Context, client *Client, projectID, s
 	}
 
 	retry := 10 * time.Second
-	timeout := 10 * time.Minute
+	timeout := RadarOffboardTimeout
 	maxConsecutiveErrors := 5
 	return waitFor(ctx, retry, timeout, maxConsecutiveErrors, deletionConfirmation)
 }
@@ -152,18 +157,22 @@ func WaitOnOffboardRadarSecretManager(ctx context.Context, client *Client, proje
 	}
 
 	retry := 10 * time.Second
-	timeout := 10 * time.Minute
+	timeout := RadarOffboardTimeout
 	maxConsecutiveErrors := 5
 	return waitFor(ctx, retry, timeout, maxConsecutiveErrors, deletionConfirmation)
 }
 
 // waitFor waits for isDone to return true or retrying every retry duration until timeout.
+// The timeout only applies if ctx has no deadline, e.g. set by the timeouts of a resource.
 // Returns an error if isDone errors or timeout expires.
 func waitFor(ctx context.Context, retry, timeout time.Duration, maxConsecutiveErrors int, isDone func() (bool, error)) error {
 	consecutiveErrors := 0
 
-	waitCtx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
 
 	ticker := time.NewTicker(retry)
 	defer ticker.Stop()
@@ -187,9 +196,10 @@ func waitFor(ctx context.Context, retry, timeout time.Duration, maxConsecutiveEr
 		select {
 		case <-ticker.C:
 			// retry duration has passed.
-		case <-waitCtx.Done():
-			return errors.New("timeout expired while waiting")
 		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return errors.New("timeout expired while waiting")
+			}
 			return errors.New("context canceled while waiting")
 		}
 	}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitFor(t *testing.T) {
+	notDone := func() (bool, error) { return false, nil }
+
+	t.Run("done", func(t *testing.T) {
+		calls := 0
+		err := waitFor(context.Background(), time.Millisecond, time.Minute, 1, func() (bool, error) {
+			calls++
+			return calls == 3, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("default timeout", func(t *testing.T) {
+		err := waitFor(context.Background(), time.Millisecond, 10*time.Millisecond, 1, notDone)
+		require.EqualError(t, err, "timeout expired while waiting")
+	})
+
+	t.Run("context deadline overrides the default timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		err := waitFor(ctx, time.Millisecond, time.Hour, 1, notDone)
+		require.EqualError(t, err, "timeout expired while waiting")
+		require.Less(t, time.Since(start), time.Minute)
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := waitFor(ctx, time.Hour, time.Hour, 1, notDone)
+		require.EqualError(t, err, "context canceled while waiting")
+	})
+
+	t.Run("max consecutive errors", func(t *testing.T) {
+		err := waitFor(context.Background(), time.Millisecond, time.Minute, 2, func() (bool, error) {
+			return false, errors.New("unavailable")
+		})
+		require.EqualError(t, err, "max consecutive errors reached")
+	})
+}
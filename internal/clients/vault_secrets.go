@@ -5,14 +5,8 @@ package clients
 
 import (
 	"context"
-	"fmt"
-	"net/http"
-	"regexp"
-	"strconv"
-	"time"
 
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
@@ -54,6 +48,65 @@ func GetVaultSecretsApp(ctx context.Context, client *Client, loc *sharedmodels.H
 	return getResp.Payload.App, nil
 }
 
+// ListVaultSecretsApps lists the Vault Secrets applications of a project,
+// paging through all results.
+func ListVaultSecretsApps(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation) ([]*secretmodels.Secrets20231128App, error) {
+	nextPage := ""
+	var apps []*secretmodels.Secrets20231128App
+
+	for {
+		params := secret_service.NewListAppsParamsWithContext(ctx)
+		params.OrganizationID = loc.OrganizationID
+		params.ProjectID = loc.ProjectID
+		if nextPage != "" {
+			params.PaginationNextPageToken = &nextPage
+		}
+
+		res, err := client.VaultSecrets.ListApps(params, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		apps = append(apps, res.Payload.Apps...)
+		pagination := res.Payload.Pagination
+		if pagination == nil || pagination.NextPageToken == "" {
+			return apps, nil
+		}
+
+		nextPage = pagination.NextPageToken
+	}
+}
+
+// ListVaultSecretsAppSecrets lists the secrets of a Vault Secrets
+// application, paging through all results. Secret values aren't returned.
+func ListVaultSecretsAppSecrets(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName string) ([]*secretmodels.Secrets20231128Secret, error) {
+	nextPage := ""
+	var secrets []*secretmodels.Secrets20231128Secret
+
+	for {
+		params := secret_service.NewListAppSecretsParamsWithContext(ctx)
+		params.OrganizationID = loc.OrganizationID
+		params.ProjectID = loc.ProjectID
+		params.AppName = appName
+		if nextPage != "" {
+			params.PaginationNextPageToken = &nextPage
+		}
+
+		res, err := client.VaultSecrets.ListAppSecrets(params, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		secrets = append(secrets, res.Payload.Secrets...)
+		pagination := res.Payload.Pagination
+		if pagination == nil || pagination.NextPageToken == "" {
+			return secrets, nil
+		}
+
+		nextPage = pagination.NextPageToken
+	}
+}
+
 // UpdateVaultSecretsApp will update an app's description
 func UpdateVaultSecretsApp(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName string, description string) (*secretmodels.Secrets20231128App, error) {
 	updateParams := secret_service.NewUpdateAppParams()
@@ -128,26 +181,3 @@ func DeleteVaultSecretsAppSecret(ctx context.Context, client *Client, loc *share
 
 	return nil
 }
-
-func shouldRetryWithSleep(ctx context.Context, err ErrorWithCode, attemptNum int, expectedErrorCodes []int) bool {
-	if shouldRetryErrorCode(err.Code(), expectedErrorCodes) {
-		backOffDuration := getAPIBackoffDuration(err.Error())
-		tflog.Debug(ctx, fmt.Sprintf("error: %q, retrying in %d seconds, attempt: %d", http.StatusText(err.Code()), int64(backOffDuration.Seconds()), attemptNum+1))
-		time.Sleep(backOffDuration)
-		return true
-	}
-	return false
-}
-
-func getAPIBackoffDuration(serviceErrStr string) time.Duration {
-	re := regexp.MustCompile(`try again in (\d+) seconds`)
-	match := re.FindStringSubmatch(serviceErrStr)
-	backoffSeconds := 60
-	if len(match) > 1 {
-		backoffSecondsOverride, err := strconv.Atoi(match[1])
-		if err == nil {
-			backoffSeconds = backoffSecondsOverride
-		}
-	}
-	return time.Duration(backoffSeconds) * time.Second
-}
@@ -5,15 +5,10 @@ package clients
 
 import (
 	"context"
-	"errors"
-	"fmt"
-	"net/http"
-	"time"
 
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // OpenVaultSecretsAppSecret will retrieve the latest secret for a Vault Secrets app, including it's value.
@@ -24,30 +19,9 @@ func OpenVaultSecretsAppSecret(ctx context.Context, client *Client, loc *sharedm
 		WithOrganizationID(loc.OrganizationID).
 		WithProjectID(loc.ProjectID)
 
-	var getResp *secret_service.OpenAppSecretOK
-	var err error
-	for attempt := 0; attempt < retryCount; attempt++ {
-		getResp, err = client.VaultSecrets.OpenAppSecret(getParams, nil)
-		if err != nil {
-			var serviceErr *secret_service.OpenAppSecretDefault
-			ok := errors.As(err, &serviceErr)
-			if !ok {
-				return nil, err
-			}
-
-			if shouldRetryErrorCode(serviceErr.Code(), []int{http.StatusTooManyRequests}) {
-				backOffDuration := getAPIBackoffDuration(serviceErr.Error())
-				tflog.Debug(ctx, fmt.Sprintf("The api rate limit has been exceeded, retrying in %d seconds, attempt: %d", int64(backOffDuration.Seconds()), (attempt+1)))
-				time.Sleep(backOffDuration)
-				continue
-			}
-			return nil, err
-		}
-		break
-	}
-
-	if getResp == nil {
-		return nil, errors.New("unable to get secret")
+	getResp, err := client.VaultSecrets.OpenAppSecret(getParams, nil)
+	if err != nil {
+		return nil, err
 	}
 
 	return getResp.GetPayload().Secret, nil
@@ -59,28 +33,12 @@ func OpenVaultSecretsAppSecrets(ctx context.Context, client *Client, loc *shared
 		WithOrganizationID(loc.OrganizationID).
 		WithProjectID(loc.ProjectID)
 
-	var secrets *secret_service.OpenAppSecretsOK
-	var err error
 	var result []*secretmodels.Secrets20231128OpenSecret
 
 	for {
-		for attempt := 0; attempt < retryCount; attempt++ {
-			secrets, err = client.VaultSecrets.OpenAppSecrets(params, nil)
-			if err != nil {
-				var serviceErr *secret_service.OpenAppSecretDefault
-				ok := errors.As(err, &serviceErr)
-				if !ok {
-					return nil, err
-				}
-				if shouldRetryWithSleep(ctx, serviceErr, attempt, []int{http.StatusTooManyRequests}) {
-					continue
-				}
-				return nil, err
-			}
-			break
-		}
-		if secrets == nil {
-			return nil, errors.New("unable to get secrets")
+		secrets, err := client.VaultSecrets.OpenAppSecrets(params, nil)
+		if err != nil {
+			return nil, err
 		}
 		result = append(result, secrets.GetPayload().Secrets...)
 		pagination := secrets.GetPayload().Pagination
@@ -91,6 +49,74 @@ func OpenVaultSecretsAppSecrets(ctx context.Context, client *Client, loc *shared
 	}
 }
 
+// OpenVaultSecretsAppSecretVersion retrieves a version of a secret of a Vault
+// Secrets app, including its value. The opened version is returned as an
+// opened secret, as OpenVaultSecretsAppSecret does.
+func OpenVaultSecretsAppSecretVersion(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName, secretName string, version int64) (*secretmodels.Secrets20231128OpenSecret, error) {
+	params := secret_service.NewOpenAppSecretVersionParamsWithContext(ctx).
+		WithAppName(appName).
+		WithSecretName(secretName).
+		WithVersion(version).
+		WithOrganizationID(loc.OrganizationID).
+		WithProjectID(loc.ProjectID)
+
+	resp, err := client.VaultSecrets.OpenAppSecretVersion(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := resp.GetPayload()
+	return &secretmodels.Secrets20231128OpenSecret{
+		Name:            secretName,
+		Type:            payload.Type,
+		StaticVersion:   payload.StaticVersion,
+		RotatingVersion: payload.RotatingVersion,
+		DynamicInstance: payload.DynamicInstance,
+	}, nil
+}
+
+// ListVaultSecretsAppSecretVersions lists the versions of a secret of a Vault
+// Secrets app, paging through all results. The versions of static and rotating
+// secrets are returned in StaticVersions and RotatingVersions respectively.
+// Secret values aren't returned.
+func ListVaultSecretsAppSecretVersions(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName, secretName string) (*secretmodels.Secrets20231128ListAppSecretVersionsResponse, error) {
+	nextPage := ""
+	result := &secretmodels.Secrets20231128ListAppSecretVersionsResponse{
+		StaticVersions:   &secretmodels.Secrets20231128SecretStaticVersionList{},
+		RotatingVersions: &secretmodels.Secrets20231128SecretRotatingVersionList{},
+	}
+
+	for {
+		params := secret_service.NewListAppSecretVersionsParamsWithContext(ctx)
+		params.OrganizationID = loc.OrganizationID
+		params.ProjectID = loc.ProjectID
+		params.AppName = appName
+		params.SecretName = secretName
+		if nextPage != "" {
+			params.PaginationNextPageToken = &nextPage
+		}
+
+		res, err := client.VaultSecrets.ListAppSecretVersions(params, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Type = res.Payload.Type
+		if res.Payload.StaticVersions != nil {
+			result.StaticVersions.Versions = append(result.StaticVersions.Versions, res.Payload.StaticVersions.Versions...)
+		}
+		if res.Payload.RotatingVersions != nil {
+			result.RotatingVersions.Versions = append(result.RotatingVersions.Versions, res.Payload.RotatingVersions.Versions...)
+		}
+		pagination := res.Payload.Pagination
+		if pagination == nil || pagination.NextPageToken == "" {
+			return result, nil
+		}
+
+		nextPage = pagination.NextPageToken
+	}
+}
+
 func GetRotatingSecretState(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName, secretName string) (*secretmodels.Secrets20231128RotatingSecretState, error) {
 	params := secret_service.NewGetRotatingSecretStateParamsWithContext(ctx).
 		WithOrganizationID(loc.OrganizationID).
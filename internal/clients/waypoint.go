@@ -73,6 +73,37 @@ func GetApplicationTemplateByID(ctx context.Context, client *Client, loc *shared
 	return getResp.GetPayload().ApplicationTemplate, nil
 }
 
+// ListApplicationTemplates lists the templates of a project, paging through
+// all results.
+func ListApplicationTemplates(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation) ([]*waypoint_models.HashicorpCloudWaypointV20241122ApplicationTemplate, error) {
+	nextPage := ""
+	var templates []*waypoint_models.HashicorpCloudWaypointV20241122ApplicationTemplate
+
+	for {
+		params := &waypoint_service.WaypointServiceListApplicationTemplatesParams{
+			Context:                         ctx,
+			NamespaceLocationOrganizationID: loc.OrganizationID,
+			NamespaceLocationProjectID:      loc.ProjectID,
+		}
+		if nextPage != "" {
+			params.PaginationNextPageToken = &nextPage
+		}
+
+		res, err := client.Waypoint.WaypointServiceListApplicationTemplates(params, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		templates = append(templates, res.GetPayload().ApplicationTemplates...)
+		pagination := res.GetPayload().Pagination
+		if pagination == nil || pagination.NextPageToken == "" {
+			return templates, nil
+		}
+
+		nextPage = pagination.NextPageToken
+	}
+}
+
 // GetAddOnDefinitionByName will retrieve an add-on definition by name
 func GetAddOnDefinitionByName(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, defName string) (*waypoint_models.HashicorpCloudWaypointV20241122AddOnDefinition, error) {
 	params := &waypoint_service.WaypointServiceGetAddOnDefinition2Params{
@@ -133,6 +164,37 @@ func GetApplicationByID(ctx context.Context, client *Client, loc *sharedmodels.H
 	return getResp.GetPayload().Application, nil
 }
 
+// ListApplications lists the applications of a project, paging through all
+// results.
+func ListApplications(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation) ([]*waypoint_models.HashicorpCloudWaypointV20241122Application, error) {
+	nextPage := ""
+	var applications []*waypoint_models.HashicorpCloudWaypointV20241122Application
+
+	for {
+		params := &waypoint_service.WaypointServiceListApplicationsParams{
+			Context:                         ctx,
+			NamespaceLocationOrganizationID: loc.OrganizationID,
+			NamespaceLocationProjectID:      loc.ProjectID,
+		}
+		if nextPage != "" {
+			params.PaginationNextPageToken = &nextPage
+		}
+
+		res, err := client.Waypoint.WaypointServiceListApplications(params, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		applications = append(applications, res.GetPayload().Applications...)
+		pagination := res.GetPayload().Pagination
+		if pagination == nil || pagination.NextPageToken == "" {
+			return applications, nil
+		}
+
+		nextPage = pagination.NextPageToken
+	}
+}
+
 // GetAddOnByName will retrieve an add-on by name
 func GetAddOnByName(ctx context.Context, client *Client, loc *sharedmodels.HashicorpCloudLocationLocation, defName string) (*waypoint_models.HashicorpCloudWaypointV20241122AddOn, error) {
 	params := &waypoint_service.WaypointServiceGetAddOn2Params{
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customdiags
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// requestIDHeader is the header identifying a request to the HCP API.
+	requestIDHeader = "X-Request-Id"
+
+	// errorInfoType and requestInfoType are the types of the error details
+	// the HCP API responds with.
+	errorInfoType   = "type.googleapis.com/google.rpc.ErrorInfo"
+	requestInfoType = "type.googleapis.com/google.rpc.RequestInfo"
+	badRequestType  = "type.googleapis.com/google.rpc.BadRequest"
+)
+
+// permissionRegexp matches HCP permissions, such as
+// vault-secrets.apps.create, in error messages.
+var permissionRegexp = regexp.MustCompile(`\b[a-z][a-z0-9-]*(?:\.[a-z][a-z0-9-]*){2,}\b`)
+
+// APIError is the error of a failed request to the HCP API, as returned by
+// the *Default errors of the HCP SDK.
+type APIError struct {
+	// HTTPStatusCode is the status code of the response.
+	HTTPStatusCode int
+
+	// Code is the gRPC status code of the error, and Message its message.
+	Code    codes.Code
+	Message string
+
+	// Reason and Metadata are the reason and metadata of the
+	// google.rpc.ErrorInfo detail of the error, if any.
+	Reason   string
+	Metadata map[string]string
+
+	// FieldViolations are the invalid fields of the request, by their
+	// description, as listed by the google.rpc.BadRequest detail of the
+	// error.
+	FieldViolations map[string]string
+
+	// RequestID identifies the request, support requests should reference
+	// it.
+	RequestID string
+
+	err error
+}
+
+func (e *APIError) Error() string {
+	return e.err.Error()
+}
+
+func (e *APIError) Unwrap() error {
+	return e.err
+}
+
+// rpcStatus is the google.rpc.Status payload of the *Default errors of the
+// HCP SDK. Every service of the SDK generates its own model of the payload,
+// so payloads are decoded from their JSON encoding.
+type rpcStatus struct {
+	Code    int32                    `json:"code"`
+	Message string                   `json:"message"`
+	Details []map[string]interface{} `json:"details"`
+}
+
+// ParseAPIError returns the details of the failed HCP API request that err
+// wraps, or nil if err isn't an error of the HCP API.
+func ParseAPIError(err error) *APIError {
+	if err == nil {
+		return nil
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	// Unexpected responses, that aren't documented by the API.
+	var runtimeErr *runtime.APIError
+	if errors.As(err, &runtimeErr) {
+		parsed := &APIError{
+			HTTPStatusCode: runtimeErr.Code,
+			Code:           codes.Unknown,
+			Message:        runtimeErr.Error(),
+			err:            err,
+		}
+		if resp, ok := runtimeErr.Response.(runtime.ClientResponse); ok {
+			parsed.RequestID = resp.GetHeader(requestIDHeader)
+		}
+		return parsed
+	}
+
+	// The *Default errors of the HCP SDK.
+	var codeErr interface {
+		error
+		Code() int
+	}
+	if !errors.As(err, &codeErr) {
+		return nil
+	}
+
+	parsed := &APIError{
+		HTTPStatusCode: codeErr.Code(),
+		Code:           codes.Unknown,
+		Message:        codeErr.Error(),
+		err:            err,
+	}
+
+	status := payload(codeErr)
+	if status == nil {
+		return parsed
+	}
+	if status.Code != 0 {
+		parsed.Code = codes.Code(status.Code)
+	}
+	if status.Message != "" {
+		parsed.Message = status.Message
+	}
+
+	for _, detail := range status.Details {
+		switch detail["@type"] {
+		case errorInfoType:
+			parsed.Reason, _ = detail["reason"].(string)
+			if metadata, ok := detail["metadata"].(map[string]interface{}); ok {
+				parsed.Metadata = make(map[string]string, len(metadata))
+				for k, v := range metadata {
+					parsed.Metadata[k] = fmt.Sprint(v)
+				}
+			}
+		case requestInfoType:
+			parsed.RequestID, _ = detail["request_id"].(string)
+		case badRequestType:
+			violations, _ := detail["field_violations"].([]interface{})
+			for _, v := range violations {
+				violation, ok := v.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				field, _ := violation["field"].(string)
+				description, _ := violation["description"].(string)
+				if parsed.FieldViolations == nil {
+					parsed.FieldViolations = make(map[string]string)
+				}
+				parsed.FieldViolations[field] = description
+			}
+		}
+	}
+
+	return parsed
+}
+
+// payload returns the google.rpc.Status payload of a *Default error of the
+// HCP SDK, or nil if it has none.
+func payload(err error) *rpcStatus {
+	method := reflect.ValueOf(err).MethodByName("GetPayload")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return nil
+	}
+
+	out := method.Call(nil)[0]
+	if out.Kind() == reflect.Ptr && out.IsNil() {
+		return nil
+	}
+
+	data, err := json.Marshal(out.Interface())
+	if err != nil {
+		return nil
+	}
+
+	var status rpcStatus
+	if err := json.Unmarshal(data, &status); err != nil {
+		return nil
+	}
+	return &status
+}
+
+// Permission returns the HCP permission the request lacked, if the error
+// names it.
+func (e *APIError) Permission() string {
+	if permission := e.Metadata["permission"]; permission != "" {
+		return permission
+	}
+
+	if e.HTTPStatusCode == http.StatusForbidden || e.Code == codes.PermissionDenied {
+		if strings.Contains(strings.ToLower(e.Message), "permission") {
+			return permissionRegexp.FindString(e.Message)
+		}
+	}
+	return ""
+}
+
+// Hint returns how to remediate the error, or an empty string if there's no
+// known remediation.
+func (e *APIError) Hint() string {
+	switch {
+	case e.HTTPStatusCode == http.StatusUnauthorized || e.Code == codes.Unauthenticated:
+		return "The credentials of the provider were rejected. Check that they are valid and haven't expired."
+	case e.HTTPStatusCode == http.StatusForbidden || e.Code == codes.PermissionDenied:
+		if permission := e.Permission(); permission != "" {
+			return fmt.Sprintf("The credentials of the provider lack the %q permission. Grant the principal of the credentials a role that includes it, on the resource, its project or its organization.", permission)
+		}
+		return "The credentials of the provider lack a permission required for this operation. Check the roles granted to the principal of the credentials on the resource, its project and its organization."
+	case e.HTTPStatusCode == http.StatusNotFound || e.Code == codes.NotFound:
+		return "The resource doesn't exist, or the credentials of the provider can't access it. Check the project and IDs in the configuration."
+	case e.HTTPStatusCode == http.StatusConflict || e.Code == codes.Aborted || e.Code == codes.AlreadyExists:
+		return "The resource was changed concurrently, or is in a state that conflicts with the change. Refresh the state with `terraform apply -refresh-only` and retry."
+	case e.HTTPStatusCode == http.StatusBadRequest || e.Code == codes.InvalidArgument:
+		return "HCP rejected the arguments of the request. Check the configuration of the resource."
+	case e.HTTPStatusCode == http.StatusTooManyRequests || e.Code == codes.ResourceExhausted:
+		return "The HCP API rate limit was exceeded. Lower the rate_limits of the provider, or retry later."
+	case e.HTTPStatusCode >= http.StatusInternalServerError:
+		return "HCP failed to process the request. Retry later, and contact HashiCorp support with the request ID if the error persists."
+	default:
+		return ""
+	}
+}
+
+// Detail returns the detail of the diagnostic of the error, with the HCP
+// error, its remediation and the ID of the request.
+func (e *APIError) Detail() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "HCP API error [HTTP %d, %s]: %s", e.HTTPStatusCode, e.Code, e.Message)
+	if e.Reason != "" {
+		fmt.Fprintf(&b, " (reason: %s)", e.Reason)
+	}
+	if len(e.FieldViolations) > 0 {
+		fields := make([]string, 0, len(e.FieldViolations))
+		for field := range e.FieldViolations {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		b.WriteString("\n\nInvalid fields:")
+		for _, field := range fields {
+			fmt.Fprintf(&b, "\n  - %s: %s", field, e.FieldViolations[field])
+		}
+	}
+	if hint := e.Hint(); hint != "" {
+		fmt.Fprintf(&b, "\n\n%s", hint)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, "\n\nRequest ID: %s", e.RequestID)
+	}
+	return b.String()
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customdiags
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/project_service"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
+	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestParseAPIError(t *testing.T) {
+	t.Run("default error", func(t *testing.T) {
+		defaultErr := project_service.NewProjectServiceSetIamPolicyDefault(http.StatusForbidden)
+		defaultErr.Payload = &models.GoogleRPCStatus{
+			Code:    int32(codes.PermissionDenied),
+			Message: "caller lacks permission resource-manager.projects.set-iam-policy",
+			Details: []*models.GoogleProtobufAny{{
+				AtType: requestInfoType,
+				GoogleProtobufAny: map[string]interface{}{
+					"request_id": "req-1",
+				},
+			}},
+		}
+
+		apiErr := ParseAPIError(fmt.Errorf("failed to update policy: %w", defaultErr))
+		require.NotNil(t, apiErr)
+		require.Equal(t, http.StatusForbidden, apiErr.HTTPStatusCode)
+		require.Equal(t, codes.PermissionDenied, apiErr.Code)
+		require.Equal(t, "req-1", apiErr.RequestID)
+		require.Equal(t, "resource-manager.projects.set-iam-policy", apiErr.Permission())
+		require.ErrorIs(t, apiErr, defaultErr)
+
+		detail := apiErr.Detail()
+		require.Contains(t, detail, "HCP API error [HTTP 403, PermissionDenied]: caller lacks permission")
+		require.Contains(t, detail, `lack the "resource-manager.projects.set-iam-policy" permission`)
+		require.Contains(t, detail, "Request ID: req-1")
+	})
+
+	t.Run("service specific payload", func(t *testing.T) {
+		defaultErr := secret_service.NewCreateAppKVSecretDefault(http.StatusBadRequest)
+		defaultErr.Payload = &secretmodels.GooglerpcStatus{
+			Code:    int32(codes.InvalidArgument),
+			Message: "invalid secret",
+			Details: []*secretmodels.ProtobufAny{{
+				AtType: badRequestType,
+			}},
+		}
+
+		apiErr := ParseAPIError(defaultErr)
+		require.NotNil(t, apiErr)
+		require.Equal(t, codes.InvalidArgument, apiErr.Code)
+		require.Equal(t, "invalid secret", apiErr.Message)
+		require.Contains(t, apiErr.Detail(), "HCP rejected the arguments")
+	})
+
+	t.Run("error info", func(t *testing.T) {
+		defaultErr := project_service.NewProjectServiceSetIamPolicyDefault(http.StatusConflict)
+		defaultErr.Payload = &models.GoogleRPCStatus{
+			Code:    int32(codes.Aborted),
+			Message: "etag mismatch",
+			Details: []*models.GoogleProtobufAny{{
+				AtType: errorInfoType,
+				GoogleProtobufAny: map[string]interface{}{
+					"reason":   "ETAG_MISMATCH",
+					"metadata": map[string]interface{}{"permission": "ignored.by.conflicts"},
+				},
+			}, {
+				AtType: badRequestType,
+				GoogleProtobufAny: map[string]interface{}{
+					"field_violations": []interface{}{
+						map[string]interface{}{"field": "policy.etag", "description": "is stale"},
+					},
+				},
+			}},
+		}
+
+		apiErr := ParseAPIError(defaultErr)
+		require.Equal(t, "ETAG_MISMATCH", apiErr.Reason)
+		require.Equal(t, map[string]string{"policy.etag": "is stale"}, apiErr.FieldViolations)
+		require.Contains(t, apiErr.Detail(), "(reason: ETAG_MISMATCH)")
+		require.Contains(t, apiErr.Detail(), "  - policy.etag: is stale")
+		require.Contains(t, apiErr.Detail(), "terraform apply -refresh-only")
+	})
+
+	t.Run("no payload", func(t *testing.T) {
+		apiErr := ParseAPIError(project_service.NewProjectServiceSetIamPolicyDefault(http.StatusInternalServerError))
+		require.NotNil(t, apiErr)
+		require.Equal(t, codes.Unknown, apiErr.Code)
+		require.Contains(t, apiErr.Detail(), "Retry later")
+	})
+
+	t.Run("unexpected response", func(t *testing.T) {
+		apiErr := ParseAPIError(runtime.NewAPIError("unknown error", nil, http.StatusTooManyRequests))
+		require.NotNil(t, apiErr)
+		require.Equal(t, http.StatusTooManyRequests, apiErr.HTTPStatusCode)
+		require.Contains(t, apiErr.Detail(), "rate_limits")
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		require.Nil(t, ParseAPIError(errors.New("boom")))
+		require.Nil(t, ParseAPIError(nil))
+	})
+}
+
+func TestNewAPIError(t *testing.T) {
+	conflict := project_service.NewProjectServiceSetIamPolicyDefault(http.StatusConflict)
+
+	d := NewAPIError("failed to update project IAM policy", conflict)
+	require.Equal(t, "failed to update project IAM policy", d.Summary())
+	require.Contains(t, d.Detail(), "HTTP 409")
+	require.True(t, HasConflictError(diag.Diagnostics{d}))
+
+	d = NewAttributeAPIError(path.Root("secret_name"), "Error creating secret", conflict)
+	withPath, ok := d.(diag.DiagnosticWithPath)
+	require.True(t, ok)
+	require.True(t, withPath.Path().Equal(path.Root("secret_name")))
+	require.True(t, HasConflictError(diag.Diagnostics{d}))
+
+	d = NewAPIError("failed", errors.New("boom"))
+	require.Equal(t, "boom", d.Detail())
+	require.False(t, HasConflictError(diag.Diagnostics{d}))
+}
+
+func TestSDKv2APIError(t *testing.T) {
+	diags := SDKv2APIError("unable to create HVN (hvn)", project_service.NewProjectServiceSetIamPolicyDefault(http.StatusNotFound), cty.GetAttrPath("project_id"))
+	require.Len(t, diags, 1)
+	require.Equal(t, "unable to create HVN (hvn)", diags[0].Summary)
+	require.Contains(t, diags[0].Detail, "Check the project and IDs")
+	require.Equal(t, cty.GetAttrPath("project_id"), diags[0].AttributePath)
+}
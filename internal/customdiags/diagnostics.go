@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customdiags
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// NewAPIError returns the error diagnostic of a failed request to the HCP
+// API. If err is an error of the HCP API, the detail of the diagnostic
+// includes the HCP error, how to remediate it and the ID of the request, and
+// the diagnostic stores the HTTP status code of the response.
+func NewAPIError(summary string, err error) diag.Diagnostic {
+	apiErr := ParseAPIError(err)
+	if apiErr == nil {
+		return diag.NewErrorDiagnostic(summary, err.Error())
+	}
+
+	return NewErrorHTTPStatusCode(summary, apiErr.Detail(), apiErr.HTTPStatusCode)
+}
+
+// NewAttributeAPIError is NewAPIError, but scopes the diagnostic to the
+// attribute at the given path.
+func NewAttributeAPIError(p path.Path, summary string, err error) diag.Diagnostic {
+	apiErr := ParseAPIError(err)
+	if apiErr == nil {
+		return diag.NewAttributeErrorDiagnostic(p, summary, err.Error())
+	}
+
+	return NewAttributeErrorHTTPStatusCode(p, summary, apiErr.Detail(), apiErr.HTTPStatusCode)
+}
+
+// SDKv2APIError returns the diagnostics of a failed request to the HCP API
+// for SDKv2 resources, see NewAPIError. If attributePath is set, the
+// diagnostic is scoped to the attribute at the path.
+func SDKv2APIError(summary string, err error, attributePath cty.Path) sdkdiag.Diagnostics {
+	detail := err.Error()
+	if apiErr := ParseAPIError(err); apiErr != nil {
+		detail = apiErr.Detail()
+	}
+
+	return sdkdiag.Diagnostics{{
+		Severity:      sdkdiag.Error,
+		Summary:       summary,
+		Detail:        detail,
+		AttributePath: attributePath,
+	}}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // ErrorHTTPStatusCode is an error diagnostic that stored the error code.
@@ -51,14 +52,58 @@ func NewErrorHTTPStatusCode(summary string, detail string, statusCode int) Error
 	}
 }
 
-// HasConflictError checks if a diagnostic has a specific error code.
+// AttributeErrorHTTPStatusCode is an ErrorHTTPStatusCode scoped to an
+// attribute.
+type AttributeErrorHTTPStatusCode struct {
+	ErrorHTTPStatusCode
+	path path.Path
+}
+
+// Equal returns true if the other diagnostic is equivalent.
+func (d AttributeErrorHTTPStatusCode) Equal(o diag.Diagnostic) bool {
+	ed, ok := o.(AttributeErrorHTTPStatusCode)
+
+	if !ok {
+		return false
+	}
+
+	return ed.ErrorHTTPStatusCode.Equal(d.ErrorHTTPStatusCode) && ed.Path().Equal(d.Path())
+}
+
+// Path returns the diagnostic path.
+func (d AttributeErrorHTTPStatusCode) Path() path.Path {
+	return d.path
+}
+
+// NewAttributeErrorHTTPStatusCode returns a new error severity diagnostic scoped to the attribute at the given path,
+// with the given summary, detail and error code.
+func NewAttributeErrorHTTPStatusCode(p path.Path, summary string, detail string, statusCode int) AttributeErrorHTTPStatusCode {
+	return AttributeErrorHTTPStatusCode{
+		ErrorHTTPStatusCode: NewErrorHTTPStatusCode(summary, detail, statusCode),
+		path:                p,
+	}
+}
+
+// HTTPStatusCode returns the HTTP status code stored in a diagnostic, if any.
+func HTTPStatusCode(d diag.Diagnostic) (int, bool) {
+	switch d := d.(type) {
+	case ErrorHTTPStatusCode:
+		return d.HTTPStatusCode, true
+	case *ErrorHTTPStatusCode:
+		return d.HTTPStatusCode, true
+	case AttributeErrorHTTPStatusCode:
+		return d.HTTPStatusCode, true
+	case *AttributeErrorHTTPStatusCode:
+		return d.HTTPStatusCode, true
+	default:
+		return 0, false
+	}
+}
+
+// HasConflictError checks if any of the diagnostics stores a conflict error code.
 func HasConflictError(diags diag.Diagnostics) bool {
 	for _, d := range diags {
-		diag, ok := d.(*ErrorHTTPStatusCode)
-		if !ok {
-			return false
-		}
-		if diag.HTTPStatusCode == http.StatusConflict {
+		if code, ok := HTTPStatusCode(d); ok && code == http.StatusConflict {
 			return true
 		}
 	}
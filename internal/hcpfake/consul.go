@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hcpfake
+
+import (
+	"fmt"
+	"net/http"
+
+	consulmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-consul-service/stable/2021-02-04/models"
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"google.golang.org/grpc/codes"
+)
+
+const consulPrefix = "/consul/2021-02-04/organizations/{organization_id}/projects/{project_id}"
+
+// consulClusterKey identifies a Consul cluster.
+type consulClusterKey struct {
+	projectID string
+	id        string
+}
+
+// consulCACertificate is the CA certificate returned in the client
+// configuration of every Consul cluster of the fake.
+const consulCACertificate = "-----BEGIN CERTIFICATE-----\nhcpfake\n-----END CERTIFICATE-----\n"
+
+func (s *Server) registerConsul(mux *http.ServeMux) {
+	mux.HandleFunc("POST "+consulPrefix+"/clusters", s.createConsulCluster)
+	mux.HandleFunc("GET "+consulPrefix+"/clusters/{id}", s.getConsulCluster)
+	mux.HandleFunc("GET "+consulPrefix+"/clusters/{id}/client-config", s.getConsulClientConfig)
+	mux.HandleFunc("POST "+consulPrefix+"/clusters/{id}/master-acl-tokens", s.createConsulRootToken)
+}
+
+// lookupConsulCluster returns the Consul cluster addressed by the request, or
+// writes a not found error. The caller must hold s.mu.
+func (s *Server) lookupConsulCluster(w http.ResponseWriter, r *http.Request) (*consulmodels.HashicorpCloudConsul20210204Cluster, bool) {
+	if !s.checkProject(w, r) {
+		return nil, false
+	}
+
+	c, ok := s.consulClusters[consulClusterKey{r.PathValue("project_id"), r.PathValue("id")}]
+	if !ok {
+		writeError(w, codes.NotFound, "cluster %q not found", r.PathValue("id"))
+		return nil, false
+	}
+	return c, true
+}
+
+// createConsulCluster creates a running Consul cluster. Only the ID and the
+// datacenter of the cluster are kept.
+func (s *Server) createConsulCluster(w http.ResponseWriter, r *http.Request) {
+	var body consulmodels.HashicorpCloudConsul20210204CreateRequest
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.checkProject(w, r) {
+		return
+	}
+
+	if body.Cluster == nil || body.Cluster.ID == "" {
+		writeError(w, codes.InvalidArgument, "cluster ID must be set")
+		return
+	}
+	key := consulClusterKey{r.PathValue("project_id"), body.Cluster.ID}
+	if _, ok := s.consulClusters[key]; ok {
+		writeError(w, codes.AlreadyExists, "cluster %q already exists", body.Cluster.ID)
+		return
+	}
+
+	c := &consulmodels.HashicorpCloudConsul20210204Cluster{
+		ID:     body.Cluster.ID,
+		Config: body.Cluster.Config,
+		Location: &sharedmodels.HashicorpCloudLocationLocation{
+			OrganizationID: r.PathValue("organization_id"),
+			ProjectID:      key.projectID,
+		},
+		State:     consulmodels.HashicorpCloudConsul20210204ClusterStateRUNNING.Pointer(),
+		CreatedAt: now(),
+	}
+	s.consulClusters[key] = c
+
+	writeJSON(w, http.StatusOK, consulmodels.HashicorpCloudConsul20210204CreateResponse{
+		Cluster:   c,
+		Operation: s.completeOperationLocked(c.Location.OrganizationID, c.Location.ProjectID),
+	})
+}
+
+func (s *Server) getConsulCluster(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.lookupConsulCluster(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, consulmodels.HashicorpCloudConsul20210204GetResponse{
+		Cluster: c,
+	})
+}
+
+// getConsulClientConfig returns a client configuration that only sets the
+// datacenter of the cluster.
+func (s *Server) getConsulClientConfig(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.lookupConsulCluster(w, r)
+	if !ok {
+		return
+	}
+
+	var datacenter string
+	if c.Config != nil && c.Config.ConsulConfig != nil {
+		datacenter = c.Config.ConsulConfig.Datacenter
+	}
+
+	writeJSON(w, http.StatusOK, consulmodels.HashicorpCloudConsul20210204GetClientConfigResponse{
+		ConsulConfigFile: []byte(fmt.Sprintf(`{"datacenter":%q}`, datacenter)),
+		CaFile:           []byte(consulCACertificate),
+	})
+}
+
+// createConsulRootToken returns a new root ACL token for the cluster. Like the
+// API, every token replaces the previous one, but as the fake doesn't run
+// Consul, tokens aren't checked anywhere.
+func (s *Server) createConsulRootToken(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.lookupConsulCluster(w, r); !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, consulmodels.HashicorpCloudConsul20210204CreateCustomerMasterACLTokenResponse{
+		ACLToken: &consulmodels.HashicorpCloudConsul20210204ACLToken{
+			AccessorID: newID(),
+			SecretID:   newID(),
+		},
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hcpfake
+
+import (
+	"net/http"
+
+	operationmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-operation/stable/2020-05-05/models"
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"google.golang.org/grpc/codes"
+)
+
+type operation = sharedmodels.HashicorpCloudOperationOperation
+
+const operationPrefix = "/operation/2020-05-05/organizations/{organization_id}/projects/{project_id}/operations/{id}"
+
+func (s *Server) registerOperation(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+operationPrefix, s.getOperation)
+	mux.HandleFunc("GET "+operationPrefix+"/wait", s.waitOperation)
+}
+
+// completeOperationLocked records an operation that is already done. Changes
+// to the fake are applied synchronously, so the operations returned by the
+// API are complete by the time they are waited on. The caller must hold s.mu.
+func (s *Server) completeOperationLocked(organizationID, projectID string) *operation {
+	op := &operation{
+		ID: newID(),
+		Location: &sharedmodels.HashicorpCloudLocationLocation{
+			OrganizationID: organizationID,
+			ProjectID:      projectID,
+		},
+		State:     sharedmodels.HashicorpCloudOperationOperationStateDONE.Pointer(),
+		CreatedAt: now(),
+		UpdatedAt: now(),
+	}
+	s.operations[op.ID] = op
+
+	return op
+}
+
+// lookupOperation returns the operation addressed by the request, or writes a
+// not found error.
+func (s *Server) lookupOperation(w http.ResponseWriter, r *http.Request) (*operation, bool) {
+	op, ok := s.operations[r.PathValue("id")]
+	if !ok || op.Location.OrganizationID != r.PathValue("organization_id") {
+		writeError(w, codes.NotFound, "operation not found")
+		return nil, false
+	}
+
+	// Operations that are not scoped to a project are addressed with the
+	// wildcard project ID "-".
+	if projectID := r.PathValue("project_id"); projectID != "-" && projectID != op.Location.ProjectID {
+		writeError(w, codes.NotFound, "operation not found")
+		return nil, false
+	}
+
+	return op, true
+}
+
+func (s *Server) getOperation(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	op, ok := s.lookupOperation(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, operationmodels.HashicorpCloudOperationGetResponse{
+		Operation: op,
+	})
+}
+
+func (s *Server) waitOperation(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	op, ok := s.lookupOperation(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, operationmodels.HashicorpCloudOperationWaitResponse{
+		Operation: op,
+	})
+}
@@ -0,0 +1,447 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hcpfake
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	packermodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-packer-service/stable/2023-01-01/models"
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"google.golang.org/grpc/codes"
+)
+
+const packerPrefix = "/packer/2023-01-01/organizations/{organization_id}/projects/{project_id}"
+
+// bucketKey identifies a Packer bucket.
+type bucketKey struct {
+	projectID string
+	name      string
+}
+
+// bucket is a Packer bucket and its versions and channels.
+type bucket struct {
+	model    *packermodels.HashicorpCloudPacker20230101Bucket
+	versions map[string]*packermodels.HashicorpCloudPacker20230101Version
+	channels map[string]*packermodels.HashicorpCloudPacker20230101Channel
+}
+
+func (s *Server) registerPacker(mux *http.ServeMux) {
+	mux.HandleFunc("PUT "+packerPrefix+"/buckets", s.createBucket)
+	mux.HandleFunc("GET "+packerPrefix+"/buckets", s.listBuckets)
+	mux.HandleFunc("GET "+packerPrefix+"/buckets/{bucket}", s.getBucket)
+	mux.HandleFunc("PATCH "+packerPrefix+"/buckets/{bucket}", s.updateBucket)
+	mux.HandleFunc("DELETE "+packerPrefix+"/buckets/{bucket}", s.deleteBucket)
+
+	mux.HandleFunc("POST "+packerPrefix+"/buckets/{bucket}/versions", s.createVersion)
+	mux.HandleFunc("GET "+packerPrefix+"/buckets/{bucket}/versions/{fingerprint}", s.getVersion)
+	mux.HandleFunc("PATCH "+packerPrefix+"/buckets/{bucket}/versions/{fingerprint}", s.updateVersion)
+
+	mux.HandleFunc("POST "+packerPrefix+"/buckets/{bucket}/channels", s.createChannel)
+	mux.HandleFunc("GET "+packerPrefix+"/buckets/{bucket}/channels", s.listChannels)
+	mux.HandleFunc("GET "+packerPrefix+"/buckets/{bucket}/channels/{channel}", s.getChannel)
+	mux.HandleFunc("PATCH "+packerPrefix+"/buckets/{bucket}/channels/{channel}", s.updateChannel)
+	mux.HandleFunc("DELETE "+packerPrefix+"/buckets/{bucket}/channels/{channel}", s.deleteChannel)
+}
+
+// lookupBucket returns the bucket addressed by the request, or writes a not
+// found error. The caller must hold s.mu.
+func (s *Server) lookupBucket(w http.ResponseWriter, r *http.Request) (*bucket, bool) {
+	if !s.checkProject(w, r) {
+		return nil, false
+	}
+
+	b, ok := s.buckets[bucketKey{r.PathValue("project_id"), r.PathValue("bucket")}]
+	if !ok {
+		writeError(w, codes.NotFound, "bucket %q not found", r.PathValue("bucket"))
+		return nil, false
+	}
+	return b, true
+}
+
+func (s *Server) createBucket(w http.ResponseWriter, r *http.Request) {
+	var body packermodels.HashicorpCloudPacker20230101CreateBucketBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.checkProject(w, r) {
+		return
+	}
+
+	key := bucketKey{r.PathValue("project_id"), body.Name}
+	if body.Name == "" {
+		writeError(w, codes.InvalidArgument, "bucket name must be set")
+		return
+	}
+	if _, ok := s.buckets[key]; ok {
+		writeError(w, codes.AlreadyExists, "bucket %q already exists", body.Name)
+		return
+	}
+
+	b := &bucket{
+		model: &packermodels.HashicorpCloudPacker20230101Bucket{
+			ID:          newID(),
+			Name:        body.Name,
+			Description: body.Description,
+			Labels:      body.Labels,
+			Platforms:   []string{},
+			Location: &sharedmodels.HashicorpCloudLocationLocation{
+				OrganizationID: r.PathValue("organization_id"),
+				ProjectID:      key.projectID,
+			},
+			ResourceName: fmt.Sprintf("packer/project/%s/bucket/%s", key.projectID, body.Name),
+			VersionCount: "0",
+			CreatedAt:    now(),
+			UpdatedAt:    now(),
+		},
+		versions: make(map[string]*packermodels.HashicorpCloudPacker20230101Version),
+		channels: make(map[string]*packermodels.HashicorpCloudPacker20230101Channel),
+	}
+	s.buckets[key] = b
+	s.registerResource(b.model.ID, b.model.ResourceName)
+
+	writeJSON(w, http.StatusOK, packermodels.HashicorpCloudPacker20230101CreateBucketResponse{
+		Bucket: b.model,
+	})
+}
+
+func (s *Server) listBuckets(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.checkProject(w, r) {
+		return
+	}
+
+	buckets := make([]*packermodels.HashicorpCloudPacker20230101Bucket, 0)
+	for key, b := range s.buckets {
+		if key.projectID == r.PathValue("project_id") {
+			buckets = append(buckets, b.model)
+		}
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Name < buckets[j].Name })
+
+	writeJSON(w, http.StatusOK, packermodels.HashicorpCloudPacker20230101ListBucketsResponse{
+		Buckets: buckets,
+	})
+}
+
+func (s *Server) getBucket(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.lookupBucket(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, packermodels.HashicorpCloudPacker20230101GetBucketResponse{
+		Bucket: b.model,
+	})
+}
+
+func (s *Server) updateBucket(w http.ResponseWriter, r *http.Request) {
+	var body packermodels.HashicorpCloudPacker20230101UpdateBucketBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.lookupBucket(w, r)
+	if !ok {
+		return
+	}
+
+	b.model.Description = body.Description
+	b.model.Labels = body.Labels
+	b.model.UpdatedAt = now()
+
+	writeJSON(w, http.StatusOK, packermodels.HashicorpCloudPacker20230101UpdateBucketResponse{
+		Bucket: b.model,
+	})
+}
+
+func (s *Server) deleteBucket(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.lookupBucket(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.buckets, bucketKey{b.model.Location.ProjectID, b.model.Name})
+	s.unregisterResource(b.model.ID)
+
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+// createVersion creates a running version. The version becomes active once it
+// is marked as complete.
+func (s *Server) createVersion(w http.ResponseWriter, r *http.Request) {
+	var body packermodels.HashicorpCloudPacker20230101CreateVersionBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.lookupBucket(w, r)
+	if !ok {
+		return
+	}
+
+	if body.Fingerprint == "" {
+		writeError(w, codes.InvalidArgument, "version fingerprint must be set")
+		return
+	}
+	if _, ok := b.versions[body.Fingerprint]; ok {
+		writeError(w, codes.AlreadyExists, "version with fingerprint %q already exists", body.Fingerprint)
+		return
+	}
+
+	v := &packermodels.HashicorpCloudPacker20230101Version{
+		ID:           newID(),
+		BucketName:   b.model.Name,
+		Fingerprint:  body.Fingerprint,
+		Name:         fmt.Sprintf("v%d", len(b.versions)+1),
+		Builds:       []*packermodels.HashicorpCloudPacker20230101Build{},
+		Status:       packermodels.HashicorpCloudPacker20230101VersionStatusVERSIONRUNNING.Pointer(),
+		TemplateType: body.TemplateType,
+		CreatedAt:    now(),
+		UpdatedAt:    now(),
+	}
+	b.versions[v.Fingerprint] = v
+	b.model.VersionCount = fmt.Sprint(len(b.versions))
+
+	writeJSON(w, http.StatusOK, packermodels.HashicorpCloudPacker20230101CreateVersionResponse{
+		Version: v,
+	})
+}
+
+func (s *Server) getVersion(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.lookupBucket(w, r)
+	if !ok {
+		return
+	}
+
+	v, ok := b.versions[r.PathValue("fingerprint")]
+	if !ok {
+		writeError(w, codes.NotFound, "version with fingerprint %q not found", r.PathValue("fingerprint"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, packermodels.HashicorpCloudPacker20230101GetVersionResponse{
+		Version: v,
+	})
+}
+
+func (s *Server) updateVersion(w http.ResponseWriter, r *http.Request) {
+	var body packermodels.HashicorpCloudPacker20230101UpdateVersionBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.lookupBucket(w, r)
+	if !ok {
+		return
+	}
+
+	v, ok := b.versions[r.PathValue("fingerprint")]
+	if !ok {
+		writeError(w, codes.NotFound, "version with fingerprint %q not found", r.PathValue("fingerprint"))
+		return
+	}
+
+	if body.Complete {
+		v.Status = packermodels.HashicorpCloudPacker20230101VersionStatusVERSIONACTIVE.Pointer()
+		v.UpdatedAt = now()
+		b.model.LatestVersion = v
+	}
+
+	writeJSON(w, http.StatusOK, packermodels.HashicorpCloudPacker20230101UpdateVersionResponse{
+		Version: v,
+	})
+}
+
+func (s *Server) createChannel(w http.ResponseWriter, r *http.Request) {
+	var body packermodels.HashicorpCloudPacker20230101CreateChannelBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.lookupBucket(w, r)
+	if !ok {
+		return
+	}
+
+	if body.Name == "" {
+		writeError(w, codes.InvalidArgument, "channel name must be set")
+		return
+	}
+	if _, ok := b.channels[body.Name]; ok {
+		writeError(w, codes.AlreadyExists, "channel %q already exists", body.Name)
+		return
+	}
+
+	c := &packermodels.HashicorpCloudPacker20230101Channel{
+		ID:         newID(),
+		BucketName: b.model.Name,
+		Name:       body.Name,
+		Restricted: body.Restricted,
+		CreatedAt:  now(),
+		UpdatedAt:  now(),
+	}
+	if body.VersionFingerprint != "" {
+		v, ok := assignableVersion(w, b, body.VersionFingerprint)
+		if !ok {
+			return
+		}
+		c.Version = v
+	}
+	b.channels[c.Name] = c
+
+	writeJSON(w, http.StatusOK, packermodels.HashicorpCloudPacker20230101CreateChannelResponse{
+		Channel: c,
+	})
+}
+
+func (s *Server) listChannels(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.lookupBucket(w, r)
+	if !ok {
+		return
+	}
+
+	channels := make([]*packermodels.HashicorpCloudPacker20230101Channel, 0, len(b.channels))
+	for _, c := range b.channels {
+		channels = append(channels, c)
+	}
+	sort.Slice(channels, func(i, j int) bool { return channels[i].Name < channels[j].Name })
+
+	writeJSON(w, http.StatusOK, packermodels.HashicorpCloudPacker20230101ListChannelsResponse{
+		Channels: channels,
+	})
+}
+
+func (s *Server) getChannel(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.lookupBucket(w, r)
+	if !ok {
+		return
+	}
+
+	c, ok := b.channels[r.PathValue("channel")]
+	if !ok {
+		writeError(w, codes.NotFound, "channel %q not found", r.PathValue("channel"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, packermodels.HashicorpCloudPacker20230101GetChannelResponse{
+		Channel: c,
+	})
+}
+
+// updateChannel updates the fields of a channel listed in the update mask of
+// the request.
+func (s *Server) updateChannel(w http.ResponseWriter, r *http.Request) {
+	var body packermodels.HashicorpCloudPacker20230101UpdateChannelBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.lookupBucket(w, r)
+	if !ok {
+		return
+	}
+
+	c, ok := b.channels[r.PathValue("channel")]
+	if !ok {
+		writeError(w, codes.NotFound, "channel %q not found", r.PathValue("channel"))
+		return
+	}
+
+	for _, field := range strings.Split(body.UpdateMask, ",") {
+		switch strings.TrimSpace(field) {
+		case "restricted":
+			c.Restricted = body.Restricted
+		case "versionFingerprint", "version_fingerprint":
+			if body.VersionFingerprint == "" {
+				c.Version = nil
+				continue
+			}
+
+			v, ok := assignableVersion(w, b, body.VersionFingerprint)
+			if !ok {
+				return
+			}
+			c.Version = v
+		}
+	}
+	c.UpdatedAt = now()
+
+	writeJSON(w, http.StatusOK, packermodels.HashicorpCloudPacker20230101UpdateChannelResponse{
+		Channel: c,
+	})
+}
+
+func (s *Server) deleteChannel(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.lookupBucket(w, r)
+	if !ok {
+		return
+	}
+
+	name := r.PathValue("channel")
+	if _, ok := b.channels[name]; !ok {
+		writeError(w, codes.NotFound, "channel %q not found", name)
+		return
+	}
+	delete(b.channels, name)
+
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+// assignableVersion returns the version of the bucket with the passed
+// fingerprint, if it can be assigned to a channel. Otherwise, an error is
+// written.
+func assignableVersion(w http.ResponseWriter, b *bucket, fingerprint string) (*packermodels.HashicorpCloudPacker20230101Version, bool) {
+	v, ok := b.versions[fingerprint]
+	if !ok {
+		writeError(w, codes.NotFound, "version with fingerprint %q not found", fingerprint)
+		return nil, false
+	}
+	if *v.Status != packermodels.HashicorpCloudPacker20230101VersionStatusVERSIONACTIVE {
+		writeError(w, codes.FailedPrecondition, "version with fingerprint %q is not complete", fingerprint)
+		return nil, false
+	}
+	return v, true
+}
@@ -0,0 +1,360 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hcpfake
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
+	"google.golang.org/grpc/codes"
+)
+
+type (
+	organization = models.HashicorpCloudResourcemanagerOrganization
+	project      = models.HashicorpCloudResourcemanagerProject
+	policy       = models.HashicorpCloudResourcemanagerPolicy
+)
+
+const resourceManagerPrefix = "/resource-manager/2019-12-10"
+
+func (s *Server) registerResourceManager(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+resourceManagerPrefix+"/organizations", s.listOrganizations)
+	mux.HandleFunc("GET "+resourceManagerPrefix+"/organizations/{id}", s.getOrganization)
+	mux.HandleFunc("GET "+resourceManagerPrefix+"/organizations/{id}/iam-policy", s.getOrganizationIamPolicy)
+	mux.HandleFunc("PUT "+resourceManagerPrefix+"/organizations/{id}/iam-policy", s.setOrganizationIamPolicy)
+
+	mux.HandleFunc("POST "+resourceManagerPrefix+"/projects", s.createProject)
+	mux.HandleFunc("GET "+resourceManagerPrefix+"/projects", s.listProjects)
+	mux.HandleFunc("GET "+resourceManagerPrefix+"/projects/{id}", s.getProject)
+	mux.HandleFunc("DELETE "+resourceManagerPrefix+"/projects/{id}", s.deleteProject)
+	mux.HandleFunc("PUT "+resourceManagerPrefix+"/projects/{id}/name", s.setProjectName)
+	mux.HandleFunc("PUT "+resourceManagerPrefix+"/projects/{id}/description", s.setProjectDescription)
+	mux.HandleFunc("GET "+resourceManagerPrefix+"/projects/{id}/iam-policy", s.getProjectIamPolicy)
+	mux.HandleFunc("PUT "+resourceManagerPrefix+"/projects/{id}/iam-policy", s.setProjectIamPolicy)
+
+	// The resource service is served under a different prefix than the rest
+	// of the resource manager.
+	mux.HandleFunc("GET /2019-12-10/resource-manager/resources/iam-policy", s.getResourceIamPolicy)
+	mux.HandleFunc("PUT /2019-12-10/resource-manager/resources/iam-policy", s.setResourceIamPolicy)
+}
+
+// addOrganization adds an organization and returns its ID. The caller must
+// not hold s.mu.
+func (s *Server) addOrganization(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := newID()
+	s.organizations[id] = &organization{
+		ID:        id,
+		Name:      name,
+		CreatedAt: now(),
+		State:     models.HashicorpCloudResourcemanagerOrganizationOrganizationStateACTIVE.Pointer(),
+	}
+	s.registerResource(id, "organization/"+id)
+
+	return id
+}
+
+// addProject adds a project to the organization and returns its ID. The
+// caller must not hold s.mu.
+func (s *Server) addProject(organizationID, name, description string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.createProjectLocked(organizationID, name, description).ID
+}
+
+func (s *Server) createProjectLocked(organizationID, name, description string) *project {
+	id := newID()
+	p := &project{
+		ID:          id,
+		Name:        name,
+		Description: description,
+		CreatedAt:   now(),
+		State:       models.HashicorpCloudResourcemanagerProjectProjectStateACTIVE.Pointer(),
+		Parent: &models.HashicorpCloudResourcemanagerResourceID{
+			ID:   organizationID,
+			Type: models.HashicorpCloudResourcemanagerResourceIDResourceTypeORGANIZATION.Pointer(),
+		},
+	}
+	s.projects[id] = p
+	s.registerResource(id, "project/"+id)
+
+	return p
+}
+
+// registerResource records the resource name of a resource, so that IAM
+// policies can be attached to it.
+func (s *Server) registerResource(id, name string) {
+	s.resourceNames[id] = name
+	s.policies[name] = &policy{Etag: newID()}
+}
+
+// unregisterResource removes a resource and its IAM policy.
+func (s *Server) unregisterResource(id string) {
+	delete(s.policies, s.resourceNames[id])
+	delete(s.resourceNames, id)
+}
+
+func (s *Server) listOrganizations(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	orgs := make([]*organization, 0, len(s.organizations))
+	for _, o := range s.organizations {
+		orgs = append(orgs, o)
+	}
+	sort.Slice(orgs, func(i, j int) bool { return orgs[i].ID < orgs[j].ID })
+
+	writeJSON(w, http.StatusOK, models.HashicorpCloudResourcemanagerOrganizationListResponse{
+		Organizations: orgs,
+	})
+}
+
+func (s *Server) getOrganization(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.organizations[r.PathValue("id")]
+	if !ok {
+		writeError(w, codes.NotFound, "organization not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, models.HashicorpCloudResourcemanagerOrganizationGetResponse{
+		Organization: o,
+	})
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	var body models.HashicorpCloudResourcemanagerProjectCreateRequest
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if body.Name == "" {
+		writeError(w, codes.InvalidArgument, "project name must be set")
+		return
+	}
+	if body.Parent == nil || s.organizations[body.Parent.ID] == nil {
+		writeError(w, codes.NotFound, "parent organization not found")
+		return
+	}
+
+	p := s.createProjectLocked(body.Parent.ID, body.Name, body.Description)
+	op := s.completeOperationLocked(body.Parent.ID, p.ID)
+
+	writeJSON(w, http.StatusOK, models.HashicorpCloudResourcemanagerProjectCreateResponse{
+		Project:     p,
+		OperationID: op.ID,
+	})
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	scopeID := r.URL.Query().Get("scope.id")
+
+	projects := make([]*project, 0, len(s.projects))
+	for _, p := range s.projects {
+		if scopeID == "" || p.Parent.ID == scopeID {
+			projects = append(projects, p)
+		}
+	}
+	sort.Slice(projects, func(i, j int) bool {
+		return time.Time(projects[i].CreatedAt).Before(time.Time(projects[j].CreatedAt))
+	})
+
+	writeJSON(w, http.StatusOK, models.HashicorpCloudResourcemanagerProjectListResponse{
+		Projects: projects,
+	})
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.projects[r.PathValue("id")]
+	if !ok {
+		writeError(w, codes.NotFound, "project not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, models.HashicorpCloudResourcemanagerProjectGetResponse{
+		Project: p,
+	})
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	p, ok := s.projects[id]
+	if !ok {
+		writeError(w, codes.NotFound, "project not found")
+		return
+	}
+
+	delete(s.projects, id)
+	s.unregisterResource(id)
+
+	// Deleting a project is tracked by an operation scoped to the
+	// organization.
+	op := s.completeOperationLocked(p.Parent.ID, "")
+
+	writeJSON(w, http.StatusOK, models.HashicorpCloudResourcemanagerProjectDeleteResponse{
+		Operation: op,
+	})
+}
+
+func (s *Server) setProjectName(w http.ResponseWriter, r *http.Request) {
+	var body models.HashicorpCloudResourcemanagerProjectServiceSetNameBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.projects[r.PathValue("id")]
+	if !ok {
+		writeError(w, codes.NotFound, "project not found")
+		return
+	}
+
+	p.Name = body.Name
+	op := s.completeOperationLocked(p.Parent.ID, p.ID)
+
+	writeJSON(w, http.StatusOK, models.HashicorpCloudResourcemanagerProjectSetNameResponse{
+		OperationID: op.ID,
+	})
+}
+
+func (s *Server) setProjectDescription(w http.ResponseWriter, r *http.Request) {
+	var body models.HashicorpCloudResourcemanagerProjectServiceSetDescriptionBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.projects[r.PathValue("id")]
+	if !ok {
+		writeError(w, codes.NotFound, "project not found")
+		return
+	}
+
+	p.Description = body.Description
+	op := s.completeOperationLocked(p.Parent.ID, p.ID)
+
+	writeJSON(w, http.StatusOK, models.HashicorpCloudResourcemanagerProjectSetDescriptionResponse{
+		OperationID: op.ID,
+	})
+}
+
+func (s *Server) getOrganizationIamPolicy(w http.ResponseWriter, r *http.Request) {
+	s.getIamPolicy(w, "organization/"+r.PathValue("id"))
+}
+
+func (s *Server) setOrganizationIamPolicy(w http.ResponseWriter, r *http.Request) {
+	var body models.HashicorpCloudResourcemanagerOrganizationServiceSetIamPolicyBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.setIamPolicy(w, "organization/"+r.PathValue("id"), body.Policy)
+}
+
+func (s *Server) getProjectIamPolicy(w http.ResponseWriter, r *http.Request) {
+	s.getIamPolicy(w, "project/"+r.PathValue("id"))
+}
+
+func (s *Server) setProjectIamPolicy(w http.ResponseWriter, r *http.Request) {
+	var body models.HashicorpCloudResourcemanagerProjectServiceSetIamPolicyBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.setIamPolicy(w, "project/"+r.PathValue("id"), body.Policy)
+}
+
+func (s *Server) getResourceIamPolicy(w http.ResponseWriter, r *http.Request) {
+	s.getIamPolicy(w, s.resourceName(r.URL.Query().Get("resource_id"), r.URL.Query().Get("resource_name")))
+}
+
+func (s *Server) setResourceIamPolicy(w http.ResponseWriter, r *http.Request) {
+	var body models.HashicorpCloudResourcemanagerResourceSetIamPolicyRequest
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.setIamPolicy(w, s.resourceName(body.ResourceID, body.ResourceName), body.Policy)
+}
+
+// resourceName returns the resource name of a resource identified by either
+// its ID or its name.
+func (s *Server) resourceName(id, name string) string {
+	if name != "" {
+		return name
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.resourceNames[id]
+}
+
+func (s *Server) getIamPolicy(w http.ResponseWriter, resourceName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.policies[resourceName]
+	if !ok {
+		writeError(w, codes.NotFound, "resource %q not found", resourceName)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, models.HashicorpCloudResourcemanagerResourceGetIamPolicyResponse{
+		Policy: p,
+	})
+}
+
+// setIamPolicy replaces the IAM policy of a resource. As in the HCP API, the
+// update is rejected if the policy passed a stale etag.
+func (s *Server) setIamPolicy(w http.ResponseWriter, resourceName string, p *policy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.policies[resourceName]
+	if !ok {
+		writeError(w, codes.NotFound, "resource %q not found", resourceName)
+		return
+	}
+	if p == nil {
+		writeError(w, codes.InvalidArgument, "policy must be set")
+		return
+	}
+	if p.Etag != "" && p.Etag != current.Etag {
+		writeError(w, codes.Aborted, "the policy of resource %q was concurrently modified", resourceName)
+		return
+	}
+
+	updated := &policy{
+		Bindings: p.Bindings,
+		Etag:     newID(),
+	}
+	s.policies[resourceName] = updated
+
+	writeJSON(w, http.StatusOK, models.HashicorpCloudResourcemanagerResourceSetIamPolicyResponse{
+		Policy: updated,
+	})
+}
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package hcpfake implements an in-process fake of the HCP API. It allows
+// resources to be tested offline, without HCP credentials.
+//
+// The fake covers the parts of the API that are used by the resource manager
+// projects and IAM policies, Vault Secrets apps, secrets and secret versions,
+// Packer buckets, versions and channels, Consul clusters, their client
+// configuration and root tokens, Vault clusters and their admin tokens,
+// Waypoint agent groups and action configs, and the operation service. All
+// state is kept in memory and discarded when the server is closed.
+//
+// A client can be pointed at the fake by using the ClientConfig of the
+// server:
+//
+//	server := hcpfake.NewServer(t)
+//	client, err := clients.NewClient(server.ClientConfig())
+//
+// Providers under test are pointed at the fake with its HCPConfigOptions.
+package hcpfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+
+	consulmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-consul-service/stable/2021-02-04/models"
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	waypointmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
+	hcpConfig "github.com/hashicorp/hcp-sdk-go/config"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+const (
+	// fakeToken is the bearer token the fake expects on every request.
+	fakeToken = "hcpfake-token"
+
+	// defaultOrganizationName is the name of the organization every fake is
+	// seeded with.
+	defaultOrganizationName = "hcpfake-organization"
+
+	// defaultProjectName is the name of the project every fake is seeded
+	// with.
+	defaultProjectName = "hcpfake-project"
+)
+
+// Server is a fake HCP API served over HTTP. A Server is seeded with a single
+// organization containing a single project, which the credentials of the
+// ClientConfig have access to.
+type Server struct {
+	server *httptest.Server
+
+	organizationID string
+	projectID      string
+
+	// mu guards all state below.
+	mu sync.Mutex
+
+	organizations map[string]*organization
+	projects      map[string]*project
+	operations    map[string]*operation
+
+	// policies holds the IAM policies keyed by the resource name of the
+	// resource they are attached to.
+	policies map[string]*policy
+
+	// resourceNames maps resource IDs to resource names, so that policies
+	// can be looked up by either.
+	resourceNames map[string]string
+
+	apps    map[appKey]*app
+	buckets map[bucketKey]*bucket
+
+	consulClusters map[consulClusterKey]*consulmodels.HashicorpCloudConsul20210204Cluster
+	vaultClusters  map[vaultClusterKey]*vaultmodels.HashicorpCloudVault20201125Cluster
+
+	agentGroups   map[waypointKey]*waypointmodels.HashicorpCloudWaypointV20241122AgentGroup
+	actionConfigs map[waypointKey]*waypointmodels.HashicorpCloudWaypointV20241122ActionConfig
+}
+
+// NewServer starts a new fake HCP API. The server is closed when the test and
+// all its subtests complete.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		organizations:  make(map[string]*organization),
+		projects:       make(map[string]*project),
+		operations:     make(map[string]*operation),
+		policies:       make(map[string]*policy),
+		resourceNames:  make(map[string]string),
+		apps:           make(map[appKey]*app),
+		buckets:        make(map[bucketKey]*bucket),
+		consulClusters: make(map[consulClusterKey]*consulmodels.HashicorpCloudConsul20210204Cluster),
+		vaultClusters:  make(map[vaultClusterKey]*vaultmodels.HashicorpCloudVault20201125Cluster),
+		agentGroups:    make(map[waypointKey]*waypointmodels.HashicorpCloudWaypointV20241122AgentGroup),
+		actionConfigs:  make(map[waypointKey]*waypointmodels.HashicorpCloudWaypointV20241122ActionConfig),
+	}
+
+	s.organizationID = s.addOrganization(defaultOrganizationName)
+	s.projectID = s.addProject(s.organizationID, defaultProjectName, "")
+
+	mux := http.NewServeMux()
+	s.registerResourceManager(mux)
+	s.registerOperation(mux)
+	s.registerVaultSecrets(mux)
+	s.registerPacker(mux)
+	s.registerConsul(mux)
+	s.registerVault(mux)
+	s.registerWaypoint(mux)
+
+	s.server = httptest.NewServer(authenticate(mux))
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+// URL returns the base URL of the fake, e.g. http://127.0.0.1:1234.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// OrganizationID returns the ID of the organization the fake is seeded with.
+func (s *Server) OrganizationID() string {
+	return s.organizationID
+}
+
+// ProjectID returns the ID of the project the fake is seeded with.
+func (s *Server) ProjectID() string {
+	return s.projectID
+}
+
+// HCPConfigOptions returns the options that point the HCP SDK at the fake and
+// authenticate requests to it.
+func (s *Server) HCPConfigOptions() []hcpConfig.HCPConfigOption {
+	u, _ := url.Parse(s.URL())
+
+	return []hcpConfig.HCPConfigOption{
+		// A nil TLS config disables TLS, the fake is served over plain HTTP.
+		hcpConfig.WithAPI(u.Host, nil),
+		hcpConfig.WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{
+			AccessToken: fakeToken,
+			TokenType:   "Bearer",
+		})),
+	}
+}
+
+// ClientConfig returns the configuration of a client that sends all requests
+// to the fake, with the seeded organization and project as defaults.
+func (s *Server) ClientConfig() clients.ClientConfig {
+	return clients.ClientConfig{
+		OrganizationID:   s.organizationID,
+		ProjectID:        s.projectID,
+		SourceChannel:    "terraform-provider-hcp",
+		HCPConfigOptions: s.HCPConfigOptions(),
+	}
+}
+
+// NewClient returns a client that sends all requests to the fake.
+func (s *Server) NewClient(t testing.TB) *clients.Client {
+	t.Helper()
+
+	client, err := clients.NewClient(s.ClientConfig())
+	if err != nil {
+		t.Fatalf("failed to create client for fake HCP API: %v", err)
+	}
+
+	return client
+}
+
+// authenticate rejects all requests that don't carry the token of the fake.
+func authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+fakeToken {
+			writeError(w, codes.Unauthenticated, "missing or invalid bearer token")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// rpcStatus is the error body returned by the HCP API.
+type rpcStatus struct {
+	Code    codes.Code    `json:"code"`
+	Message string        `json:"message"`
+	Details []interface{} `json:"details"`
+}
+
+// httpStatus maps gRPC status codes to the HTTP status codes the HCP API
+// responds with.
+var httpStatus = map[codes.Code]int{
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.Aborted:            http.StatusConflict,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Internal:           http.StatusInternalServerError,
+}
+
+// writeError writes an error response in the format of the HCP API.
+func writeError(w http.ResponseWriter, code codes.Code, format string, args ...interface{}) {
+	status, ok := httpStatus[code]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+
+	writeJSON(w, status, rpcStatus{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+		Details: []interface{}{},
+	})
+}
+
+// writeJSON writes the passed body as a JSON response.
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// readJSON decodes the body of the request into v. If the body is malformed,
+// an error response is written and false is returned.
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, codes.InvalidArgument, "invalid request body: %v", err)
+		return false
+	}
+	return true
+}
+
+// newID returns a new random resource ID.
+func newID() string {
+	return uuid.NewString()
+}
+
+// now returns the current time, truncated to the precision of the API.
+func now() strfmt.DateTime {
+	return strfmt.DateTime(time.Now().UTC().Truncate(time.Millisecond))
+}
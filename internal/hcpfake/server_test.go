@@ -0,0 +1,382 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hcpfake_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-consul-service/stable/2021-02-04/client/consul_service"
+	consulmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-consul-service/stable/2021-02-04/models"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-packer-service/stable/2023-01-01/client/packer_service"
+	packermodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-packer-service/stable/2023-01-01/models"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/project_service"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/resource_service"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/client/vault_service"
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/client/waypoint_service"
+	waypointmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients/packerv2"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpfake"
+)
+
+func TestServer_Unauthenticated(t *testing.T) {
+	server := hcpfake.NewServer(t)
+
+	// Requests without the token of the fake are rejected.
+	resp, err := http.Get(server.URL() + "/resource-manager/2019-12-10/organizations")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestServer_Projects(t *testing.T) {
+	ctx := context.Background()
+	server := hcpfake.NewServer(t)
+	client := server.NewClient(t)
+
+	seeded, err := clients.GetProjectByID(ctx, client, server.ProjectID())
+	require.NoError(t, err)
+	assert.Equal(t, server.OrganizationID(), seeded.Parent.ID)
+
+	createParams := project_service.NewProjectServiceCreateParamsWithContext(ctx)
+	createParams.Body = &models.HashicorpCloudResourcemanagerProjectCreateRequest{
+		Name:        "example",
+		Description: "An example project",
+		Parent: &models.HashicorpCloudResourcemanagerResourceID{
+			ID:   server.OrganizationID(),
+			Type: models.HashicorpCloudResourcemanagerResourceIDResourceTypeORGANIZATION.Pointer(),
+		},
+	}
+	created, err := clients.CreateProjectAndWait(client, createParams)
+	require.NoError(t, err)
+	id := created.Payload.Project.ID
+
+	nameParams := project_service.NewProjectServiceSetNameParamsWithContext(ctx)
+	nameParams.ID = id
+	nameParams.Body = &models.HashicorpCloudResourcemanagerProjectServiceSetNameBody{Name: "renamed"}
+	_, err = clients.SetProjectName(client, nameParams)
+	require.NoError(t, err)
+
+	p, err := clients.GetProjectByID(ctx, client, id)
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", p.Name)
+	assert.Equal(t, "An example project", p.Description)
+
+	projects, err := clients.ListProjects(ctx, client, server.OrganizationID())
+	require.NoError(t, err)
+	require.Len(t, projects, 2)
+	assert.Equal(t, id, projects[1].ID)
+
+	deleteParams := project_service.NewProjectServiceDeleteParamsWithContext(ctx)
+	deleteParams.ID = id
+	_, err = clients.DeleteProject(client, deleteParams)
+	require.NoError(t, err)
+
+	_, err = clients.GetProjectByID(ctx, client, id)
+	assert.True(t, clients.IsResponseCodeNotFound(err), "unexpected error: %v", err)
+}
+
+func TestServer_IamPolicy(t *testing.T) {
+	ctx := context.Background()
+	server := hcpfake.NewServer(t)
+	client := server.NewClient(t)
+
+	resourceName := "project/" + server.ProjectID()
+
+	getParams := resource_service.NewResourceServiceGetIamPolicyParamsWithContext(ctx)
+	getParams.ResourceName = &resourceName
+	got, err := client.ResourceService.ResourceServiceGetIamPolicy(getParams, nil)
+	require.NoError(t, err)
+	etag := got.Payload.Policy.Etag
+
+	binding := &models.HashicorpCloudResourcemanagerPolicyBinding{
+		RoleID: "roles/viewer",
+		Members: []*models.HashicorpCloudResourcemanagerPolicyBindingMember{{
+			MemberID:   "principal",
+			MemberType: models.HashicorpCloudResourcemanagerPolicyBindingMemberTypeUSER.Pointer(),
+		}},
+	}
+
+	setParams := resource_service.NewResourceServiceSetIamPolicyParamsWithContext(ctx)
+	setParams.Body = &models.HashicorpCloudResourcemanagerResourceSetIamPolicyRequest{
+		ResourceName: resourceName,
+		Policy: &models.HashicorpCloudResourcemanagerPolicy{
+			Bindings: []*models.HashicorpCloudResourcemanagerPolicyBinding{binding},
+			Etag:     etag,
+		},
+	}
+	_, err = client.ResourceService.ResourceServiceSetIamPolicy(setParams, nil)
+	require.NoError(t, err)
+
+	// The policy is shared with the project service.
+	projectParams := project_service.NewProjectServiceGetIamPolicyParamsWithContext(ctx)
+	projectParams.ID = server.ProjectID()
+	policy, err := client.Project.ProjectServiceGetIamPolicy(projectParams, nil)
+	require.NoError(t, err)
+	require.Len(t, policy.Payload.Policy.Bindings, 1)
+	assert.Equal(t, "roles/viewer", policy.Payload.Policy.Bindings[0].RoleID)
+
+	// Setting the policy with a stale etag fails.
+	_, err = client.ResourceService.ResourceServiceSetIamPolicy(setParams, nil)
+	var setErr *resource_service.ResourceServiceSetIamPolicyDefault
+	require.ErrorAs(t, err, &setErr)
+	assert.Equal(t, http.StatusConflict, setErr.Code())
+}
+
+func TestServer_VaultSecrets(t *testing.T) {
+	ctx := context.Background()
+	server := hcpfake.NewServer(t)
+	client := server.NewClient(t)
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: server.OrganizationID(),
+		ProjectID:      server.ProjectID(),
+	}
+
+	app, err := clients.CreateVaultSecretsApp(ctx, client, loc, "example", "An example app")
+	require.NoError(t, err)
+	assert.Equal(t, "secrets/project/"+server.ProjectID()+"/app/example", app.ResourceName)
+
+	_, err = clients.CreateVaultSecretsAppSecret(ctx, client, loc, "example", "password", "hunter2")
+	require.NoError(t, err)
+
+	// Creating an existing secret adds a new version.
+	secret, err := clients.CreateVaultSecretsAppSecret(ctx, client, loc, "example", "password", "correct-horse")
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, secret.LatestVersion)
+
+	opened, err := clients.OpenVaultSecretsAppSecret(ctx, client, loc, "example", "password")
+	require.NoError(t, err)
+	assert.Equal(t, "correct-horse", opened.StaticVersion.Value)
+
+	opened, err = clients.OpenVaultSecretsAppSecretVersion(ctx, client, loc, "example", "password", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", opened.StaticVersion.Value)
+
+	_, err = clients.OpenVaultSecretsAppSecretVersion(ctx, client, loc, "example", "password", 3)
+	assert.True(t, clients.IsResponseCodeNotFound(err), "unexpected error: %v", err)
+
+	versions, err := clients.ListVaultSecretsAppSecretVersions(ctx, client, loc, "example", "password")
+	require.NoError(t, err)
+	assert.Equal(t, "kv", versions.Type)
+	require.Len(t, versions.StaticVersions.Versions, 2)
+	assert.EqualValues(t, 1, versions.StaticVersions.Versions[0].Version)
+	assert.NotEmpty(t, versions.StaticVersions.Versions[0].CreatedBy.Name)
+
+	all, err := clients.OpenVaultSecretsAppSecrets(ctx, client, loc, "example")
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+
+	apps, err := clients.ListVaultSecretsApps(ctx, client, loc)
+	require.NoError(t, err)
+	require.Len(t, apps, 1)
+	assert.Equal(t, "example", apps[0].Name)
+
+	secrets, err := clients.ListVaultSecretsAppSecrets(ctx, client, loc, "example")
+	require.NoError(t, err)
+	require.Len(t, secrets, 1)
+	assert.Equal(t, "password", secrets[0].Name)
+
+	require.NoError(t, clients.DeleteVaultSecretsAppSecret(ctx, client, loc, "example", "password"))
+	_, err = clients.OpenVaultSecretsAppSecret(ctx, client, loc, "example", "password")
+	assert.True(t, clients.IsResponseCodeNotFound(err), "unexpected error: %v", err)
+
+	require.NoError(t, clients.DeleteVaultSecretsApp(ctx, client, loc, "example"))
+
+	// Reading a deleted app is forbidden, as in the HCP API.
+	_, err = clients.GetVaultSecretsApp(ctx, client, loc, "example")
+	assert.True(t, clients.IsResponseForbidden(err), "unexpected error: %v", err)
+}
+
+func TestServer_Packer(t *testing.T) {
+	ctx := context.Background()
+	server := hcpfake.NewServer(t)
+	client := server.NewClient(t)
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: server.OrganizationID(),
+		ProjectID:      server.ProjectID(),
+	}
+
+	_, err := packerv2.CreateBucket(ctx, client, loc, "example")
+	require.NoError(t, err)
+
+	createVersion := packer_service.NewPackerServiceCreateVersionParamsWithContext(ctx)
+	createVersion.LocationOrganizationID = loc.OrganizationID
+	createVersion.LocationProjectID = loc.ProjectID
+	createVersion.BucketName = "example"
+	createVersion.Body = &packermodels.HashicorpCloudPacker20230101CreateVersionBody{Fingerprint: "fingerprint"}
+	_, err = client.PackerV2.PackerServiceCreateVersion(createVersion, nil)
+	require.NoError(t, err)
+
+	_, err = packerv2.CreatePackerChannel(ctx, client, loc, "example", "production", false)
+	require.NoError(t, err)
+
+	// Incomplete versions can't be assigned to a channel.
+	_, err = packerv2.UpdatePackerChannelAssignment(ctx, client, loc, "example", "production", "fingerprint")
+	require.Error(t, err)
+
+	completeVersion := packer_service.NewPackerServiceUpdateVersionParamsWithContext(ctx)
+	completeVersion.LocationOrganizationID = loc.OrganizationID
+	completeVersion.LocationProjectID = loc.ProjectID
+	completeVersion.BucketName = "example"
+	completeVersion.Fingerprint = "fingerprint"
+	completeVersion.Body = &packermodels.HashicorpCloudPacker20230101UpdateVersionBody{Complete: true}
+	_, err = client.PackerV2.PackerServiceUpdateVersion(completeVersion, nil)
+	require.NoError(t, err)
+
+	_, err = packerv2.UpdatePackerChannelAssignment(ctx, client, loc, "example", "production", "fingerprint")
+	require.NoError(t, err)
+
+	channel, err := packerv2.GetPackerChannelByNameFromList(ctx, client, loc, "example", "production")
+	require.NoError(t, err)
+	require.NotNil(t, channel.Version)
+	assert.Equal(t, "fingerprint", channel.Version.Fingerprint)
+
+	_, err = packerv2.DeletePackerChannel(ctx, client, loc, "example", "production")
+	require.NoError(t, err)
+
+	buckets, err := packerv2.ListBuckets(ctx, client, loc)
+	require.NoError(t, err)
+	require.Len(t, buckets, 1)
+	assert.Equal(t, "1", buckets[0].VersionCount)
+}
+
+func TestServer_Consul(t *testing.T) {
+	ctx := context.Background()
+	server := hcpfake.NewServer(t)
+	client := server.NewClient(t)
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: server.OrganizationID(),
+		ProjectID:      server.ProjectID(),
+	}
+
+	params := consul_service.NewCreateParamsWithContext(ctx)
+	params.ClusterLocationOrganizationID = loc.OrganizationID
+	params.ClusterLocationProjectID = loc.ProjectID
+	params.Body = &consulmodels.HashicorpCloudConsul20210204CreateRequest{
+		Cluster: &consulmodels.HashicorpCloudConsul20210204Cluster{ID: "example"},
+	}
+	created, err := client.Consul.Create(params, nil)
+	require.NoError(t, err)
+	require.NoError(t, clients.WaitForOperation(ctx, client, "create Consul cluster", loc, created.Payload.Operation.ID))
+
+	cluster, err := clients.GetConsulClusterByID(ctx, client, loc, "example")
+	require.NoError(t, err)
+	assert.Equal(t, consulmodels.HashicorpCloudConsul20210204ClusterStateRUNNING, *cluster.State)
+
+	config, err := clients.GetConsulClientConfigFiles(ctx, client, loc, "example")
+	require.NoError(t, err)
+	assert.NotEmpty(t, config.ConsulConfigFile)
+	assert.NotEmpty(t, config.CaFile)
+
+	first, err := clients.CreateCustomerRootACLToken(ctx, client, loc, "example")
+	require.NoError(t, err)
+	second, err := clients.CreateCustomerRootACLToken(ctx, client, loc, "example")
+	require.NoError(t, err)
+	assert.NotEqual(t, first.ACLToken.SecretID, second.ACLToken.SecretID)
+
+	_, err = clients.GetConsulClusterByID(ctx, client, loc, "missing")
+	require.True(t, clients.IsResponseCodeNotFound(err), err)
+}
+
+func TestServer_Vault(t *testing.T) {
+	ctx := context.Background()
+	server := hcpfake.NewServer(t)
+	client := server.NewClient(t)
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: server.OrganizationID(),
+		ProjectID:      server.ProjectID(),
+	}
+
+	params := vault_service.NewCreateParamsWithContext(ctx)
+	params.ClusterLocationOrganizationID = loc.OrganizationID
+	params.ClusterLocationProjectID = loc.ProjectID
+	params.Body = &vaultmodels.HashicorpCloudVault20201125CreateRequest{
+		Cluster: &vaultmodels.HashicorpCloudVault20201125InputCluster{
+			ID: "example",
+			Location: &vaultmodels.HashicorpCloudInternalLocationLocation{
+				Region: &vaultmodels.HashicorpCloudInternalLocationRegion{Provider: "aws", Region: "us-west-2"},
+			},
+		},
+	}
+	created, err := client.Vault.Create(params, nil)
+	require.NoError(t, err)
+	require.NoError(t, clients.WaitForOperation(ctx, client, "create Vault cluster", loc, created.Payload.Operation.ID))
+
+	cluster, err := clients.GetVaultClusterByID(ctx, client, loc, "example")
+	require.NoError(t, err)
+	assert.Equal(t, vaultmodels.HashicorpCloudVault20201125ClusterStateRUNNING, *cluster.State)
+
+	// The admin token is only returned for the region of the cluster.
+	loc.Region = &sharedmodels.HashicorpCloudLocationRegion{Provider: "aws", Region: "us-east-1"}
+	_, err = clients.CreateVaultClusterAdminToken(ctx, client, loc, "example")
+	require.Error(t, err)
+
+	loc.Region.Region = "us-west-2"
+	token, err := clients.CreateVaultClusterAdminToken(ctx, client, loc, "example")
+	require.NoError(t, err)
+	assert.NotEmpty(t, token.Token)
+
+	_, err = clients.GetVaultClusterByID(ctx, client, loc, "missing")
+	require.True(t, clients.IsResponseCodeNotFound(err), err)
+}
+
+func TestServer_Waypoint(t *testing.T) {
+	ctx := context.Background()
+	server := hcpfake.NewServer(t)
+	client := server.NewClient(t)
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: server.OrganizationID(),
+		ProjectID:      server.ProjectID(),
+	}
+
+	createGroup := waypoint_service.NewWaypointServiceCreateAgentGroupParamsWithContext(ctx)
+	createGroup.NamespaceLocationOrganizationID = loc.OrganizationID
+	createGroup.NamespaceLocationProjectID = loc.ProjectID
+	createGroup.Body = &waypointmodels.HashicorpCloudWaypointV20241122WaypointServiceCreateAgentGroupBody{
+		Group: &waypointmodels.HashicorpCloudWaypointV20241122AgentGroup{Name: "example", Description: "An agent group"},
+	}
+	_, err := client.Waypoint.WaypointServiceCreateAgentGroup(createGroup, nil)
+	require.NoError(t, err)
+
+	group, err := clients.GetAgentGroup(ctx, client, loc, "example")
+	require.NoError(t, err)
+	assert.Equal(t, "An agent group", group.Description)
+
+	_, err = clients.GetAgentGroup(ctx, client, loc, "missing")
+	require.True(t, clients.IsResponseCodeNotFound(err), err)
+
+	createAction := waypoint_service.NewWaypointServiceCreateActionConfigParamsWithContext(ctx)
+	createAction.NamespaceLocationOrganizationID = loc.OrganizationID
+	createAction.NamespaceLocationProjectID = loc.ProjectID
+	createAction.Body = &waypointmodels.HashicorpCloudWaypointV20241122WaypointServiceCreateActionConfigBody{
+		ActionConfig: &waypointmodels.HashicorpCloudWaypointV20241122ActionConfig{
+			Name:    "example",
+			Request: &waypointmodels.HashicorpCloudWaypointV20241122ActionConfigRequest{},
+		},
+	}
+	created, err := client.Waypoint.WaypointServiceCreateActionConfig(createAction, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, created.Payload.ActionConfig.ID)
+
+	byID, err := clients.GetAction(ctx, client, loc, created.Payload.ActionConfig.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, "example", byID.Name)
+
+	byName, err := clients.GetAction(ctx, client, loc, "", "example")
+	require.NoError(t, err)
+	assert.Equal(t, created.Payload.ActionConfig.ID, byName.ID)
+}
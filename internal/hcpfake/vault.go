@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hcpfake
+
+import (
+	"net/http"
+
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"google.golang.org/grpc/codes"
+)
+
+const vaultPrefix = "/vault/2020-11-25/organizations/{organization_id}/projects/{project_id}"
+
+// vaultClusterKey identifies a Vault cluster.
+type vaultClusterKey struct {
+	projectID string
+	id        string
+}
+
+func (s *Server) registerVault(mux *http.ServeMux) {
+	mux.HandleFunc("POST "+vaultPrefix+"/clusters", s.createVaultCluster)
+	mux.HandleFunc("GET "+vaultPrefix+"/clusters/{id}", s.getVaultCluster)
+	mux.HandleFunc("GET "+vaultPrefix+"/clusters/{id}/admintoken", s.getVaultAdminToken)
+}
+
+// lookupVaultCluster returns the Vault cluster addressed by the request, or
+// writes a not found error. The caller must hold s.mu.
+func (s *Server) lookupVaultCluster(w http.ResponseWriter, r *http.Request) (*vaultmodels.HashicorpCloudVault20201125Cluster, bool) {
+	if !s.checkProject(w, r) {
+		return nil, false
+	}
+
+	c, ok := s.vaultClusters[vaultClusterKey{r.PathValue("project_id"), r.PathValue("id")}]
+	if !ok {
+		writeError(w, codes.NotFound, "cluster %q not found", r.PathValue("id"))
+		return nil, false
+	}
+	return c, true
+}
+
+// createVaultCluster creates a running Vault cluster. Only the ID and the
+// region of the cluster are kept.
+func (s *Server) createVaultCluster(w http.ResponseWriter, r *http.Request) {
+	var body vaultmodels.HashicorpCloudVault20201125CreateRequest
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.checkProject(w, r) {
+		return
+	}
+
+	if body.Cluster == nil || body.Cluster.ID == "" {
+		writeError(w, codes.InvalidArgument, "cluster ID must be set")
+		return
+	}
+	key := vaultClusterKey{r.PathValue("project_id"), body.Cluster.ID}
+	if _, ok := s.vaultClusters[key]; ok {
+		writeError(w, codes.AlreadyExists, "cluster %q already exists", body.Cluster.ID)
+		return
+	}
+
+	location := &vaultmodels.HashicorpCloudInternalLocationLocation{
+		OrganizationID: r.PathValue("organization_id"),
+		ProjectID:      key.projectID,
+	}
+	if body.Cluster.Location != nil {
+		location.Region = body.Cluster.Location.Region
+	}
+
+	s.vaultClusters[key] = &vaultmodels.HashicorpCloudVault20201125Cluster{
+		ID:        body.Cluster.ID,
+		Location:  location,
+		State:     vaultmodels.HashicorpCloudVault20201125ClusterStateRUNNING.Pointer(),
+		CreatedAt: now(),
+	}
+
+	writeJSON(w, http.StatusOK, vaultmodels.HashicorpCloudVault20201125CreateResponse{
+		ClusterID: body.Cluster.ID,
+		Operation: s.completeOperationLocked(location.OrganizationID, location.ProjectID),
+	})
+}
+
+func (s *Server) getVaultCluster(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.lookupVaultCluster(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, vaultmodels.HashicorpCloudVault20201125GetResponse{
+		Cluster: c,
+	})
+}
+
+// getVaultAdminToken returns a new admin token for the cluster. Like the API,
+// the region of the cluster must be passed in the query.
+func (s *Server) getVaultAdminToken(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.lookupVaultCluster(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	if c.Location.Region == nil ||
+		query.Get("location.region.provider") != c.Location.Region.Provider ||
+		query.Get("location.region.region") != c.Location.Region.Region {
+		writeError(w, codes.InvalidArgument, "region of cluster %q must be set", c.ID)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, vaultmodels.HashicorpCloudVault20201125GetAdminTokenResponse{
+		Token: "hvs." + newID(),
+	})
+}
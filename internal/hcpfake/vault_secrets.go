@@ -0,0 +1,451 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hcpfake
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"google.golang.org/grpc/codes"
+)
+
+const vaultSecretsPrefix = "/secrets/2023-11-28/organizations/{organization_id}/projects/{project_id}"
+
+// openSuffix is appended to the path of a secret to read its value.
+const openSuffix = ":open"
+
+// secretCreator is the principal reported as the creator of every version of
+// the secrets of the fake.
+var secretCreator = &secretmodels.Secrets20231128Principal{
+	Name: "hcpfake-service-principal",
+	Type: "SERVICE_PRINCIPAL",
+}
+
+// appKey identifies a Vault Secrets app.
+type appKey struct {
+	projectID string
+	name      string
+}
+
+// app is a Vault Secrets app and its secrets.
+type app struct {
+	model   *secretmodels.Secrets20231128App
+	secrets map[string]*secret
+}
+
+// secret is a static Vault Secrets secret and all its versions.
+type secret struct {
+	model    *secretmodels.Secrets20231128Secret
+	versions []*secretmodels.Secrets20231128OpenSecretStaticVersion
+}
+
+func (s *Server) registerVaultSecrets(mux *http.ServeMux) {
+	mux.HandleFunc("POST "+vaultSecretsPrefix+"/apps", s.createApp)
+	mux.HandleFunc("GET "+vaultSecretsPrefix+"/apps", s.listApps)
+	mux.HandleFunc("GET "+vaultSecretsPrefix+"/apps/{name}", s.getApp)
+	mux.HandleFunc("PATCH "+vaultSecretsPrefix+"/apps/{name}", s.updateApp)
+	mux.HandleFunc("DELETE "+vaultSecretsPrefix+"/apps/{name}", s.deleteApp)
+
+	mux.HandleFunc("POST "+vaultSecretsPrefix+"/apps/{name}/secret/kv", s.createAppKVSecret)
+	mux.HandleFunc("GET "+vaultSecretsPrefix+"/apps/{name}/secrets", s.listAppSecrets)
+	mux.HandleFunc("GET "+vaultSecretsPrefix+"/apps/{name}/secrets"+openSuffix, s.openAppSecrets)
+	// The secret name wildcard also matches requests to open the secret, as
+	// wildcards have to span a whole path segment.
+	mux.HandleFunc("GET "+vaultSecretsPrefix+"/apps/{name}/secrets/{secret}", s.getAppSecret)
+	mux.HandleFunc("DELETE "+vaultSecretsPrefix+"/apps/{name}/secrets/{secret}", s.deleteAppSecret)
+	mux.HandleFunc("GET "+vaultSecretsPrefix+"/apps/{name}/secrets/{secret}/versions", s.listAppSecretVersions)
+	mux.HandleFunc("GET "+vaultSecretsPrefix+"/apps/{name}/secrets/{secret}/versions/{version}", s.openAppSecretVersion)
+}
+
+// checkProject verifies that the project addressed by the request exists in
+// the organization addressed by the request. The caller must hold s.mu.
+func (s *Server) checkProject(w http.ResponseWriter, r *http.Request) bool {
+	p, ok := s.projects[r.PathValue("project_id")]
+	if !ok || p.Parent.ID != r.PathValue("organization_id") {
+		writeError(w, codes.NotFound, "project not found")
+		return false
+	}
+	return true
+}
+
+// lookupApp returns the app addressed by the request. If the app does not
+// exist, an error with the passed code is written. The caller must hold s.mu.
+func (s *Server) lookupApp(w http.ResponseWriter, r *http.Request, notFound codes.Code) (*app, bool) {
+	if !s.checkProject(w, r) {
+		return nil, false
+	}
+
+	a, ok := s.apps[appKey{r.PathValue("project_id"), r.PathValue("name")}]
+	if !ok {
+		writeError(w, notFound, "app %q not found", r.PathValue("name"))
+		return nil, false
+	}
+	return a, true
+}
+
+func (s *Server) createApp(w http.ResponseWriter, r *http.Request) {
+	var body secretmodels.SecretServiceCreateAppBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.checkProject(w, r) {
+		return
+	}
+
+	key := appKey{r.PathValue("project_id"), body.Name}
+	if body.Name == "" {
+		writeError(w, codes.InvalidArgument, "app name must be set")
+		return
+	}
+	if _, ok := s.apps[key]; ok {
+		writeError(w, codes.AlreadyExists, "app %q already exists", body.Name)
+		return
+	}
+
+	a := &app{
+		model: &secretmodels.Secrets20231128App{
+			Name:           body.Name,
+			Description:    body.Description,
+			OrganizationID: r.PathValue("organization_id"),
+			ProjectID:      key.projectID,
+			ResourceID:     newID(),
+			ResourceName:   fmt.Sprintf("secrets/project/%s/app/%s", key.projectID, body.Name),
+			SyncNames:      nonNil(body.SyncNames),
+			CreatedAt:      now(),
+			UpdatedAt:      now(),
+		},
+		secrets: make(map[string]*secret),
+	}
+	s.apps[key] = a
+	s.registerResource(a.model.ResourceID, a.model.ResourceName)
+
+	writeJSON(w, http.StatusOK, secretmodels.Secrets20231128CreateAppResponse{
+		App: a.model,
+	})
+}
+
+func (s *Server) listApps(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.checkProject(w, r) {
+		return
+	}
+
+	apps := make([]*secretmodels.Secrets20231128App, 0)
+	for key, a := range s.apps {
+		if key.projectID == r.PathValue("project_id") {
+			apps = append(apps, a.model)
+		}
+	}
+	sort.Slice(apps, func(i, j int) bool { return apps[i].Name < apps[j].Name })
+
+	writeJSON(w, http.StatusOK, secretmodels.Secrets20231128ListAppsResponse{
+		Apps: apps,
+	})
+}
+
+func (s *Server) getApp(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// As the HCP API, respond with permission denied rather than not found
+	// if the app does not exist.
+	a, ok := s.lookupApp(w, r, codes.PermissionDenied)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, secretmodels.Secrets20231128GetAppResponse{
+		App: a.model,
+	})
+}
+
+func (s *Server) updateApp(w http.ResponseWriter, r *http.Request) {
+	var body secretmodels.SecretServiceUpdateAppBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.lookupApp(w, r, codes.NotFound)
+	if !ok {
+		return
+	}
+
+	a.model.Description = body.Description
+	a.model.SyncNames = nonNil(body.SyncNames)
+	a.model.UpdatedAt = now()
+
+	writeJSON(w, http.StatusOK, secretmodels.Secrets20231128UpdateAppResponse{
+		App: a.model,
+	})
+}
+
+func (s *Server) deleteApp(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.lookupApp(w, r, codes.NotFound)
+	if !ok {
+		return
+	}
+
+	delete(s.apps, appKey{a.model.ProjectID, a.model.Name})
+	s.unregisterResource(a.model.ResourceID)
+
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+// createAppKVSecret creates a static secret. If the secret already exists, a
+// new version of it is created.
+func (s *Server) createAppKVSecret(w http.ResponseWriter, r *http.Request) {
+	var body secretmodels.SecretServiceCreateAppKVSecretBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.lookupApp(w, r, codes.NotFound)
+	if !ok {
+		return
+	}
+
+	if body.Name == "" {
+		writeError(w, codes.InvalidArgument, "secret name must be set")
+		return
+	}
+
+	sec, ok := a.secrets[body.Name]
+	if !ok {
+		sec = &secret{
+			model: &secretmodels.Secrets20231128Secret{
+				Name:      body.Name,
+				Type:      "kv",
+				CreatedAt: now(),
+			},
+		}
+		a.secrets[body.Name] = sec
+		a.model.SecretCount = int32(len(a.secrets))
+	}
+
+	version := &secretmodels.Secrets20231128OpenSecretStaticVersion{
+		Version:   int64(len(sec.versions) + 1),
+		Value:     body.Value,
+		CreatedAt: now(),
+	}
+	sec.versions = append(sec.versions, version)
+	sec.model.LatestVersion = version.Version
+	sec.model.VersionCount = fmt.Sprint(len(sec.versions))
+	sec.model.StaticVersion = &secretmodels.Secrets20231128SecretStaticVersion{
+		Version:   version.Version,
+		CreatedAt: version.CreatedAt,
+	}
+
+	writeJSON(w, http.StatusOK, secretmodels.Secrets20231128CreateAppKVSecretResponse{
+		Secret: sec.model,
+	})
+}
+
+func (s *Server) listAppSecrets(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.lookupApp(w, r, codes.NotFound)
+	if !ok {
+		return
+	}
+
+	secrets := make([]*secretmodels.Secrets20231128Secret, 0, len(a.secrets))
+	for _, name := range sortedSecretNames(a) {
+		secrets = append(secrets, a.secrets[name].model)
+	}
+
+	writeJSON(w, http.StatusOK, secretmodels.Secrets20231128ListAppSecretsResponse{
+		Secrets: secrets,
+	})
+}
+
+func (s *Server) openAppSecrets(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.lookupApp(w, r, codes.NotFound)
+	if !ok {
+		return
+	}
+
+	secrets := make([]*secretmodels.Secrets20231128OpenSecret, 0, len(a.secrets))
+	for _, name := range sortedSecretNames(a) {
+		secrets = append(secrets, a.secrets[name].open())
+	}
+
+	writeJSON(w, http.StatusOK, secretmodels.Secrets20231128OpenAppSecretsResponse{
+		Secrets: secrets,
+	})
+}
+
+// getAppSecret returns the metadata of a secret, or its value if the secret
+// is opened.
+func (s *Server) getAppSecret(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.lookupApp(w, r, codes.NotFound)
+	if !ok {
+		return
+	}
+
+	name, open := strings.CutSuffix(r.PathValue("secret"), openSuffix)
+	sec, ok := a.secrets[name]
+	if !ok {
+		writeError(w, codes.NotFound, "secret %q not found", name)
+		return
+	}
+
+	if open {
+		writeJSON(w, http.StatusOK, secretmodels.Secrets20231128OpenAppSecretResponse{
+			Secret: sec.open(),
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, secretmodels.Secrets20231128GetAppSecretResponse{
+		Secret: sec.model,
+	})
+}
+
+func (s *Server) deleteAppSecret(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.lookupApp(w, r, codes.NotFound)
+	if !ok {
+		return
+	}
+
+	name := r.PathValue("secret")
+	if _, ok := a.secrets[name]; !ok {
+		writeError(w, codes.NotFound, "secret %q not found", name)
+		return
+	}
+
+	delete(a.secrets, name)
+	a.model.SecretCount = int32(len(a.secrets))
+
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+// open returns the secret including the value of its latest version.
+func (s *Server) listAppSecretVersions(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sec, ok := s.lookupAppSecret(w, r)
+	if !ok {
+		return
+	}
+
+	versions := make([]*secretmodels.Secrets20231128SecretStaticVersion, 0, len(sec.versions))
+	for _, v := range sec.versions {
+		versions = append(versions, &secretmodels.Secrets20231128SecretStaticVersion{
+			Version:   v.Version,
+			CreatedAt: v.CreatedAt,
+			CreatedBy: secretCreator,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, secretmodels.Secrets20231128ListAppSecretVersionsResponse{
+		Type: sec.model.Type,
+		StaticVersions: &secretmodels.Secrets20231128SecretStaticVersionList{
+			Versions: versions,
+		},
+	})
+}
+
+// openAppSecretVersion returns the value of a version of a secret. Only
+// opening versions is supported, as the version wildcard also matches the
+// open suffix.
+func (s *Server) openAppSecretVersion(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sec, ok := s.lookupAppSecret(w, r)
+	if !ok {
+		return
+	}
+
+	raw, open := strings.CutSuffix(r.PathValue("version"), openSuffix)
+	if !open {
+		writeError(w, codes.Unimplemented, "only opening secret versions is supported")
+		return
+	}
+	version, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || version < 1 || version > int64(len(sec.versions)) {
+		writeError(w, codes.NotFound, "version %q of secret %q not found", raw, sec.model.Name)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, secretmodels.Secrets20231128OpenAppSecretVersionResponse{
+		Type:          sec.model.Type,
+		StaticVersion: sec.versions[version-1],
+	})
+}
+
+// lookupAppSecret returns the secret addressed by the request, writing a not
+// found error if either the app or the secret doesn't exist. The caller must
+// hold s.mu.
+func (s *Server) lookupAppSecret(w http.ResponseWriter, r *http.Request) (*secret, bool) {
+	a, ok := s.lookupApp(w, r, codes.NotFound)
+	if !ok {
+		return nil, false
+	}
+
+	sec, ok := a.secrets[r.PathValue("secret")]
+	if !ok {
+		writeError(w, codes.NotFound, "secret %q not found", r.PathValue("secret"))
+		return nil, false
+	}
+	return sec, true
+}
+
+func (sec *secret) open() *secretmodels.Secrets20231128OpenSecret {
+	return &secretmodels.Secrets20231128OpenSecret{
+		Name:          sec.model.Name,
+		Type:          sec.model.Type,
+		CreatedAt:     sec.model.CreatedAt,
+		LatestVersion: sec.model.LatestVersion,
+		StaticVersion: sec.versions[len(sec.versions)-1],
+	}
+}
+
+func sortedSecretNames(a *app) []string {
+	names := make([]string, 0, len(a.secrets))
+	for name := range a.secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// nonNil returns an empty slice in place of nil, matching the JSON the HCP
+// API responds with.
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hcpfake
+
+import (
+	"net/http"
+
+	waypointmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
+	"google.golang.org/grpc/codes"
+)
+
+const waypointPrefix = "/waypoint/2024-11-22/organizations/{organization_id}/projects/{project_id}"
+
+// waypointKey identifies a Waypoint agent group or action config by name.
+type waypointKey struct {
+	projectID string
+	name      string
+}
+
+func (s *Server) registerWaypoint(mux *http.ServeMux) {
+	mux.HandleFunc("POST "+waypointPrefix+"/agent/group", s.createAgentGroup)
+	mux.HandleFunc("GET "+waypointPrefix+"/agent/group/{name}", s.getAgentGroup)
+
+	mux.HandleFunc("POST "+waypointPrefix+"/actionconfig", s.createActionConfig)
+	mux.HandleFunc("GET "+waypointPrefix+"/actionconfig", s.getActionConfig)
+}
+
+func (s *Server) createAgentGroup(w http.ResponseWriter, r *http.Request) {
+	var body waypointmodels.HashicorpCloudWaypointV20241122WaypointServiceCreateAgentGroupBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.checkProject(w, r) {
+		return
+	}
+
+	if body.Group == nil || body.Group.Name == "" {
+		writeError(w, codes.InvalidArgument, "agent group name must be set")
+		return
+	}
+	key := waypointKey{r.PathValue("project_id"), body.Group.Name}
+	if _, ok := s.agentGroups[key]; ok {
+		writeError(w, codes.AlreadyExists, "agent group %q already exists", body.Group.Name)
+		return
+	}
+	s.agentGroups[key] = body.Group
+
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+func (s *Server) getAgentGroup(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.checkProject(w, r) {
+		return
+	}
+
+	group, ok := s.agentGroups[waypointKey{r.PathValue("project_id"), r.PathValue("name")}]
+	if !ok {
+		writeError(w, codes.NotFound, "agent group %q not found", r.PathValue("name"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, waypointmodels.HashicorpCloudWaypointV20241122GetAgentGroupResponse{
+		Group: group,
+	})
+}
+
+func (s *Server) createActionConfig(w http.ResponseWriter, r *http.Request) {
+	var body waypointmodels.HashicorpCloudWaypointV20241122WaypointServiceCreateActionConfigBody
+	if !readJSON(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.checkProject(w, r) {
+		return
+	}
+
+	if body.ActionConfig == nil || body.ActionConfig.Name == "" {
+		writeError(w, codes.InvalidArgument, "action config name must be set")
+		return
+	}
+	key := waypointKey{r.PathValue("project_id"), body.ActionConfig.Name}
+	if _, ok := s.actionConfigs[key]; ok {
+		writeError(w, codes.AlreadyExists, "action config %q already exists", body.ActionConfig.Name)
+		return
+	}
+
+	config := *body.ActionConfig
+	config.ID = newID()
+	config.CreatedAt = now()
+	s.actionConfigs[key] = &config
+
+	writeJSON(w, http.StatusOK, waypointmodels.HashicorpCloudWaypointV20241122CreateActionConfigResponse{
+		ActionConfig: &config,
+	})
+}
+
+// getActionConfig returns the action config with the ID or the name passed in
+// the query of the request.
+func (s *Server) getActionConfig(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.checkProject(w, r) {
+		return
+	}
+
+	id, name := r.URL.Query().Get("action_id"), r.URL.Query().Get("action_name")
+	for key, config := range s.actionConfigs {
+		if key.projectID != r.PathValue("project_id") {
+			continue
+		}
+		if (id != "" && config.ID == id) || (name != "" && config.Name == name) {
+			writeJSON(w, http.StatusOK, waypointmodels.HashicorpCloudWaypointV20241122GetActionConfigResponse{
+				ActionConfig: config,
+			})
+			return
+		}
+	}
+
+	writeError(w, codes.NotFound, "action config not found")
+}
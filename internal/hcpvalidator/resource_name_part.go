@@ -64,3 +64,9 @@ func (v resourceNamePartValidator) ValidateString(ctx context.Context, request v
 func ResourceNamePart() validator.String {
 	return resourceNamePartValidator{}
 }
+
+// IsResourceNamePart reports whether the value only consists of characters that
+// are valid for a resource name part.
+func IsResourceNamePart(value string) bool {
+	return resourceNamePartRegex.MatchString(value)
+}
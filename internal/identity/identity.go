@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package identity implements the identity of the resources of the framework
+// provider, such that they can be imported with the identity attribute of
+// import blocks, and their configuration generated with
+// `terraform plan -generate-config-out`.
+//
+// Resources declare the attributes of their identity by implementing
+// ResourceWithIdentity, and Resources wraps them to implement the identity
+// schema, store the identity in the state after every operation and import
+// them by identity.
+package identity
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+// Attribute is a string attribute of the identity of a resource.
+type Attribute struct {
+	// Name is the name of the attribute in the identity.
+	Name string
+
+	// Description describes the attribute.
+	Description string
+
+	// StateAttribute is the top level attribute of the state the value of
+	// the attribute is read from, and set to when the resource is imported.
+	// Defaults to Name.
+	StateAttribute string
+
+	// Default returns the value of the attribute when it's omitted from an
+	// import block, or null in the state. Attributes without a default are
+	// required to import the resource.
+	Default func(client *clients.Client) string
+}
+
+func (a Attribute) stateAttribute() string {
+	if a.StateAttribute != "" {
+		return a.StateAttribute
+	}
+	return a.Name
+}
+
+// Schema is the identity of a resource.
+type Schema struct {
+	// Attributes are the attributes of the identity, which uniquely
+	// identify an instance of the resource and never change over its
+	// lifetime, unless Mutable is set.
+	Attributes []Attribute
+
+	// Mutable is set if the arguments the attributes are read from can be
+	// updated in place, such that the identity can change.
+	Mutable bool
+
+	// ImportUnsupported is set if the resource can't be imported, because
+	// reading it doesn't restore its arguments, e.g. credentials the HCP API
+	// never returns.
+	ImportUnsupported bool
+}
+
+// ResourceWithIdentity is a resource with an identity.
+type ResourceWithIdentity interface {
+	resource.Resource
+
+	// Identity returns the identity of the resource.
+	Identity() Schema
+}
+
+// ProjectID returns the project_id attribute of the identity of resources
+// that belong to a project. It defaults to the project of the provider.
+func ProjectID() Attribute {
+	return Attribute{
+		Name:        "project_id",
+		Description: "The ID of the HCP project of the resource. Defaults to the project of the provider.",
+		Default: func(client *clients.Client) string {
+			return client.Config.ProjectID
+		},
+	}
+}
+
+// OrganizationID returns the organization_id attribute of the identity of
+// resources that belong to an organization. It defaults to the organization
+// of the provider.
+func OrganizationID() Attribute {
+	return Attribute{
+		Name:        "organization_id",
+		Description: "The ID of the HCP organization of the resource. Defaults to the organization of the provider.",
+		Default: func(client *clients.Client) string {
+			return client.Config.OrganizationID
+		},
+	}
+}
+
+// Name returns the name attribute of the identity, read from the state
+// attribute of the same name.
+func Name(description string) Attribute {
+	return Attribute{
+		Name:        "name",
+		Description: description,
+	}
+}
+
+// ResourceName returns the resource_name attribute of the identity, read from
+// the state attribute of the same name.
+func ResourceName(description string) Attribute {
+	return Attribute{
+		Name:        "resource_name",
+		Description: description,
+	}
+}
+
+// identitySchema returns the framework identity schema of s.
+func (s Schema) identitySchema() identityschema.Schema {
+	attrs := make(map[string]identityschema.Attribute, len(s.Attributes))
+	for _, attr := range s.Attributes {
+		attrs[attr.Name] = identityschema.StringAttribute{
+			Description:       attr.Description,
+			RequiredForImport: attr.Default == nil,
+			OptionalForImport: attr.Default != nil,
+		}
+	}
+	return identityschema.Schema{Attributes: attrs}
+}
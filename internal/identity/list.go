@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+// ListResult is an instance of a resource found by a list resource.
+type ListResult struct {
+	// DisplayName is the human readable name of the instance, e.g. its name.
+	DisplayName string
+
+	// Identity maps the names of the identity attributes of the resource to
+	// their values. Attributes missing from the map are set to their
+	// default, if any.
+	Identity map[string]string
+}
+
+// ListFunc lists the instances of a resource, filtered by the configuration
+// of the list block.
+type ListFunc func(ctx context.Context, client *clients.Client, config tfsdk.Config) ([]ListResult, diag.Diagnostics)
+
+// NewListResource returns a list resource of r, which must implement
+// ResourceWithIdentity, for the list blocks of `terraform query`.
+//
+// The instances returned by listFunc are emitted with their identity, such
+// that they can be imported by identity with import blocks. If the resource
+// objects are requested, each instance is imported by identity and read, as
+// it is when imported.
+func NewListResource(r resource.Resource, configSchema listschema.Schema, listFunc ListFunc) list.ListResource {
+	return &listResource{
+		resource:     NewResource(r).(*identityResource),
+		configSchema: configSchema,
+		list:         listFunc,
+	}
+}
+
+type listResource struct {
+	resource     *identityResource
+	configSchema listschema.Schema
+	list         ListFunc
+}
+
+var _ list.ListResourceWithConfigure = &listResource{}
+
+func (r *listResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.resource.Metadata(ctx, req, resp)
+}
+
+func (r *listResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = r.configSchema
+}
+
+func (r *listResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.resource.Configure(ctx, req, resp)
+}
+
+func (r *listResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	client := r.resource.client
+	if client == nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Unconfigured HCP Client",
+			"The list resource can't be used before the provider is configured.",
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results, diags := r.list(ctx, client, req.Config)
+	if diags.HasError() || (len(results) == 0 && len(diags) > 0) {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if req.Limit > 0 && int64(len(results)) > req.Limit {
+		results = results[:req.Limit]
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, result := range results {
+			listResult := r.listResult(ctx, req, result)
+			if i == 0 {
+				listResult.Diagnostics = append(diags, listResult.Diagnostics...)
+			}
+			if !push(listResult) {
+				return
+			}
+		}
+	}
+}
+
+// listResult returns the list result of an instance of the resource, along
+// with its resource object if requested.
+func (r *listResource) listResult(ctx context.Context, req list.ListRequest, result ListResult) list.ListResult {
+	listResult := req.NewListResult(ctx)
+	listResult.DisplayName = result.DisplayName
+
+	for _, attr := range r.resource.schema.Attributes {
+		value := types.StringNull()
+		if v, ok := result.Identity[attr.Name]; ok && v != "" {
+			value = types.StringValue(v)
+		} else if v := r.resource.defaultValue(attr); v != nil {
+			value = types.StringValue(*v)
+		}
+
+		listResult.Diagnostics.Append(listResult.Identity.SetAttribute(ctx, path.Root(attr.Name), value)...)
+		if listResult.Diagnostics.HasError() {
+			return listResult
+		}
+	}
+
+	if !req.IncludeResource {
+		return listResult
+	}
+
+	importResp := resource.ImportStateResponse{
+		State:    tfsdk.State{Schema: listResult.Resource.Schema, Raw: listResult.Resource.Raw},
+		Identity: listResult.Identity,
+	}
+	r.resource.ImportState(ctx, resource.ImportStateRequest{Identity: listResult.Identity}, &importResp)
+	listResult.Diagnostics.Append(importResp.Diagnostics...)
+	if listResult.Diagnostics.HasError() {
+		return listResult
+	}
+
+	readResp := resource.ReadResponse{
+		State:    importResp.State,
+		Identity: importResp.Identity,
+	}
+	r.resource.Read(ctx, resource.ReadRequest{State: importResp.State, Identity: importResp.Identity}, &readResp)
+	listResult.Diagnostics.Append(readResp.Diagnostics...)
+	if listResult.Diagnostics.HasError() {
+		return listResult
+	}
+
+	listResult.Resource.Raw = readResp.State.Raw
+	return listResult
+}
+
+// ProjectIDListAttribute returns the project_id argument of list resources
+// listing resources that belong to a project, see ListProjectID.
+func ProjectIDListAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Description: "The ID of the HCP project to list the resources of. Defaults to the project of the provider.",
+		Optional:    true,
+	}
+}
+
+// ListProjectID returns the project_id argument of the configuration of a
+// list block, or the project of the provider if it's omitted.
+func ListProjectID(ctx context.Context, client *clients.Client, config tfsdk.Config) (string, diag.Diagnostics) {
+	var projectID types.String
+	diags := config.GetAttribute(ctx, path.Root("project_id"), &projectID)
+	if diags.HasError() || projectID.ValueString() == "" {
+		return client.Config.ProjectID, diags
+	}
+	return projectID.ValueString(), diags
+}
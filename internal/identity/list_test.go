@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+var fakeListSchema = listschema.Schema{
+	Attributes: map[string]listschema.Attribute{
+		"project_id": ProjectIDListAttribute(),
+	},
+}
+
+func newFakeListResource(t *testing.T, listFunc ListFunc) list.ListResource {
+	t.Helper()
+
+	r := NewListResource(&fakeResource{schema: fakeIdentity}, fakeListSchema, listFunc)
+	client := &clients.Client{Config: clients.ClientConfig{OrganizationID: "org", ProjectID: "default-project"}}
+	r.(list.ListResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+	return r
+}
+
+func newListRequest(t *testing.T, projectID *string) list.ListRequest {
+	t.Helper()
+	ctx := context.Background()
+
+	return list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: fakeListSchema,
+			Raw: tftypes.NewValue(fakeListSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"project_id": tftypes.NewValue(tftypes.String, projectID),
+			}),
+		},
+		ResourceSchema:         fakeSchema,
+		ResourceIdentitySchema: fakeIdentity.identitySchema(),
+	}
+}
+
+// listApps lists two apps of the project of the list block.
+func listApps(ctx context.Context, client *clients.Client, config tfsdk.Config) ([]ListResult, diag.Diagnostics) {
+	projectID, diags := ListProjectID(ctx, client, config)
+	return []ListResult{
+		{DisplayName: "first", Identity: map[string]string{"project_id": projectID, "name": "first"}},
+		{DisplayName: "second", Identity: map[string]string{"name": "second"}},
+	}, diags
+}
+
+func TestListResource_List(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("identities", func(t *testing.T) {
+		projectID := "project"
+		req := newListRequest(t, &projectID)
+
+		var stream list.ListResultsStream
+		newFakeListResource(t, listApps).List(ctx, req, &stream)
+		results := slices.Collect(stream.Results)
+		require.Len(t, results, 2)
+
+		var gotProjectID, name types.String
+		require.False(t, results[0].Diagnostics.HasError(), results[0].Diagnostics)
+		require.Equal(t, "first", results[0].DisplayName)
+		results[0].Identity.GetAttribute(ctx, path.Root("project_id"), &gotProjectID)
+		results[0].Identity.GetAttribute(ctx, path.Root("name"), &name)
+		require.Equal(t, "project", gotProjectID.ValueString())
+		require.Equal(t, "first", name.ValueString())
+
+		// Omitted identity attributes are set to their default.
+		results[1].Identity.GetAttribute(ctx, path.Root("project_id"), &gotProjectID)
+		require.Equal(t, "default-project", gotProjectID.ValueString())
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		req := newListRequest(t, nil)
+		req.Limit = 1
+
+		var stream list.ListResultsStream
+		newFakeListResource(t, listApps).List(ctx, req, &stream)
+		require.Len(t, slices.Collect(stream.Results), 1)
+	})
+
+	t.Run("include resource", func(t *testing.T) {
+		req := newListRequest(t, nil)
+		req.IncludeResource = true
+
+		var stream list.ListResultsStream
+		newFakeListResource(t, listApps).List(ctx, req, &stream)
+		results := slices.Collect(stream.Results)
+		require.Len(t, results, 2)
+		require.False(t, results[0].Diagnostics.HasError(), results[0].Diagnostics)
+
+		var organizationID, projectID, appName types.String
+		results[0].Resource.GetAttribute(ctx, path.Root("organization_id"), &organizationID)
+		results[0].Resource.GetAttribute(ctx, path.Root("project_id"), &projectID)
+		results[0].Resource.GetAttribute(ctx, path.Root("app_name"), &appName)
+		require.Equal(t, "org", organizationID.ValueString())
+		require.Equal(t, "default-project", projectID.ValueString())
+		require.Equal(t, "first", appName.ValueString())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		listFunc := func(context.Context, *clients.Client, tfsdk.Config) ([]ListResult, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			diags.AddError("Unable to list apps", "boom")
+			return nil, diags
+		}
+
+		var stream list.ListResultsStream
+		newFakeListResource(t, listFunc).List(ctx, newListRequest(t, nil), &stream)
+		results := slices.Collect(stream.Results)
+		require.Len(t, results, 1)
+		require.True(t, results[0].Diagnostics.HasError())
+		require.Nil(t, results[0].Identity)
+	})
+}
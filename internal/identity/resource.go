@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+// organizationIDAttribute is the state attribute of the organization of
+// resources, which is set to the organization of the provider when resources
+// are imported, as their ImportState do.
+const organizationIDAttribute = "organization_id"
+
+// Resources wraps the resources returned by newResources, see NewResource.
+func Resources(newResources []func() resource.Resource) []func() resource.Resource {
+	wrapped := make([]func() resource.Resource, len(newResources))
+	for i, newResource := range newResources {
+		newResource := newResource
+		wrapped[i] = func() resource.Resource {
+			return NewResource(newResource())
+		}
+	}
+	return wrapped
+}
+
+// NewResource wraps r such that it implements resource.ResourceWithIdentity,
+// if r implements ResourceWithIdentity, or returns r as is otherwise.
+//
+// The identity of the resource is read from its state after every create,
+// read and update. Resources are imported by identity by setting the state
+// attributes of their identity attributes, and reading them. Importing
+// resources by ID is left to their ImportState, if any.
+func NewResource(r resource.Resource) resource.Resource {
+	withIdentity, ok := r.(ResourceWithIdentity)
+	if !ok {
+		return r
+	}
+
+	return &identityResource{
+		ResourceWithIdentity: withIdentity,
+		schema:               withIdentity.Identity(),
+	}
+}
+
+type identityResource struct {
+	ResourceWithIdentity
+	schema Schema
+	client *clients.Client
+}
+
+var _ resource.ResourceWithIdentity = &identityResource{}
+var _ resource.ResourceWithImportState = &identityResource{}
+var _ resource.ResourceWithConfigure = &identityResource{}
+var _ resource.ResourceWithConfigValidators = &identityResource{}
+var _ resource.ResourceWithValidateConfig = &identityResource{}
+var _ resource.ResourceWithModifyPlan = &identityResource{}
+var _ resource.ResourceWithUpgradeState = &identityResource{}
+var _ resource.ResourceWithMoveState = &identityResource{}
+
+func (r *identityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.ResourceWithIdentity.Metadata(ctx, req, resp)
+	resp.ResourceBehavior.MutableIdentity = r.schema.Mutable
+}
+
+func (r *identityResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = r.schema.identitySchema()
+}
+
+func (r *identityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if client, ok := req.ProviderData.(*clients.Client); ok {
+		r.client = client
+	}
+
+	if configurer, ok := r.ResourceWithIdentity.(resource.ResourceWithConfigure); ok {
+		configurer.Configure(ctx, req, resp)
+	}
+}
+
+func (r *identityResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if validator, ok := r.ResourceWithIdentity.(resource.ResourceWithConfigValidators); ok {
+		return validator.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+func (r *identityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if validator, ok := r.ResourceWithIdentity.(resource.ResourceWithValidateConfig); ok {
+		validator.ValidateConfig(ctx, req, resp)
+	}
+}
+
+func (r *identityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if modifier, ok := r.ResourceWithIdentity.(resource.ResourceWithModifyPlan); ok {
+		modifier.ModifyPlan(ctx, req, resp)
+	}
+}
+
+func (r *identityResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	if upgrader, ok := r.ResourceWithIdentity.(resource.ResourceWithUpgradeState); ok {
+		return upgrader.UpgradeState(ctx)
+	}
+	return nil
+}
+
+// MoveState sets the identity of the resource from the state moved by the
+// state movers of the resource, if any.
+func (r *identityResource) MoveState(ctx context.Context) []resource.StateMover {
+	mover, ok := r.ResourceWithIdentity.(resource.ResourceWithMoveState)
+	if !ok {
+		return nil
+	}
+
+	movers := mover.MoveState(ctx)
+	for i := range movers {
+		moveState := movers[i].StateMover
+		movers[i].StateMover = func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			moveState(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(r.setIdentity(ctx, resp.TargetState, resp.TargetIdentity)...)
+		}
+	}
+	return movers
+}
+
+func (r *identityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.ResourceWithIdentity.Create(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *identityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.ResourceWithIdentity.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The identity must be set even if the resource was removed from the
+	// state, it's read from the prior state then.
+	state := resp.State
+	if state.Raw.IsNull() {
+		state = req.State
+	}
+	resp.Diagnostics.Append(r.setIdentity(ctx, state, resp.Identity)...)
+}
+
+func (r *identityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.ResourceWithIdentity.Update(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.State, resp.Identity)...)
+}
+
+func (r *identityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if r.schema.ImportUnsupported {
+		resp.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import, because its arguments can't be read back from HCP.",
+		)
+		return
+	}
+
+	if req.ID != "" {
+		importer, ok := r.ResourceWithIdentity.(resource.ResourceWithImportState)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Resource Import By ID Not Implemented",
+				"This resource can only be imported by identity, with the identity attribute of an import block.",
+			)
+			return
+		}
+
+		importer.ImportState(ctx, req, resp)
+		return
+	}
+
+	stateAttrs := resp.State.Schema.GetAttributes()
+	importedOrganization := false
+	for _, attr := range r.schema.Attributes {
+		var value *string
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(attr.Name), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if value == nil {
+			value = r.defaultValue(attr)
+		}
+
+		if _, ok := stateAttrs[attr.stateAttribute()]; !ok {
+			continue
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr.stateAttribute()), value)...)
+		importedOrganization = importedOrganization || attr.stateAttribute() == organizationIDAttribute
+	}
+
+	if _, ok := stateAttrs[organizationIDAttribute]; ok && !importedOrganization && r.client != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(organizationIDAttribute), r.client.Config.OrganizationID)...)
+	}
+}
+
+// setIdentity sets the attributes of identity to the values of their state
+// attributes.
+func (r *identityResource) setIdentity(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil || state.Raw.IsNull() {
+		return diags
+	}
+
+	stateAttrs := state.Schema.GetAttributes()
+	for _, attr := range r.schema.Attributes {
+		var value *string
+		if _, ok := stateAttrs[attr.stateAttribute()]; ok {
+			diags.Append(state.GetAttribute(ctx, path.Root(attr.stateAttribute()), &value)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+		if value == nil {
+			value = r.defaultValue(attr)
+		}
+
+		diags.Append(identity.SetAttribute(ctx, path.Root(attr.Name), value)...)
+	}
+	return diags
+}
+
+// defaultValue returns the default value of attr, or nil if it has none.
+func (r *identityResource) defaultValue(attr Attribute) *string {
+	if attr.Default == nil || r.client == nil {
+		return nil
+	}
+
+	value := attr.Default(r.client)
+	if value == "" {
+		return nil
+	}
+	return &value
+}
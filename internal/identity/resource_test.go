@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+// fakeResource is a resource of an app, identified by its project and name.
+type fakeResource struct {
+	schema Schema
+}
+
+func (r *fakeResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "hcp_fake"
+}
+
+func (r *fakeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = fakeSchema
+}
+
+func (r *fakeResource) Identity() Schema {
+	return r.schema
+}
+
+func (r *fakeResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {}
+
+func (r *fakeResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {}
+
+func (r *fakeResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {}
+
+func (r *fakeResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+var fakeSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"organization_id": schema.StringAttribute{Computed: true},
+		"project_id":      schema.StringAttribute{Optional: true, Computed: true},
+		"app_name":        schema.StringAttribute{Required: true},
+		"description":     schema.StringAttribute{Optional: true},
+	},
+}
+
+var fakeIdentity = Schema{
+	Attributes: []Attribute{
+		ProjectID(),
+		{Name: "name", Description: "The name of the app.", StateAttribute: "app_name"},
+	},
+}
+
+func newFakeResource(t *testing.T, s Schema) resource.ResourceWithIdentity {
+	t.Helper()
+
+	r, ok := NewResource(&fakeResource{schema: s}).(resource.ResourceWithIdentity)
+	require.True(t, ok)
+
+	client := &clients.Client{Config: clients.ClientConfig{OrganizationID: "org", ProjectID: "default-project"}}
+	r.(resource.ResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{ProviderData: client}, &resource.ConfigureResponse{})
+	return r
+}
+
+func identitySchema(t *testing.T, r resource.ResourceWithIdentity) resource.IdentitySchemaResponse {
+	t.Helper()
+
+	var resp resource.IdentitySchemaResponse
+	r.IdentitySchema(context.Background(), resource.IdentitySchemaRequest{}, &resp)
+	require.False(t, resp.Diagnostics.HasError())
+	return resp
+}
+
+func newState(values map[string]tftypes.Value) tfsdk.State {
+	objectType := fakeSchema.Type().TerraformType(context.Background()).(tftypes.Object)
+	for name, attrType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	return tfsdk.State{
+		Schema: fakeSchema,
+		Raw:    tftypes.NewValue(objectType, values),
+	}
+}
+
+func TestIdentitySchema(t *testing.T) {
+	resp := identitySchema(t, newFakeResource(t, fakeIdentity))
+
+	projectID := resp.IdentitySchema.Attributes["project_id"]
+	require.True(t, projectID.IsOptionalForImport())
+	require.False(t, projectID.IsRequiredForImport())
+
+	name := resp.IdentitySchema.Attributes["name"]
+	require.True(t, name.IsRequiredForImport())
+	require.Equal(t, "The name of the app.", name.GetDescription())
+}
+
+func TestIdentity_Read(t *testing.T) {
+	ctx := context.Background()
+	r := newFakeResource(t, fakeIdentity)
+	idSchema := identitySchema(t, r).IdentitySchema
+
+	state := newState(map[string]tftypes.Value{
+		"app_name": tftypes.NewValue(tftypes.String, "my-app"),
+	})
+	resp := resource.ReadResponse{
+		State: state,
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: idSchema,
+			Raw:    tftypes.NewValue(idSchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var projectID, name types.String
+	resp.Identity.GetAttribute(ctx, path.Root("project_id"), &projectID)
+	resp.Identity.GetAttribute(ctx, path.Root("name"), &name)
+	require.Equal(t, "default-project", projectID.ValueString())
+	require.Equal(t, "my-app", name.ValueString())
+}
+
+func TestIdentity_ImportState(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("by identity", func(t *testing.T) {
+		r := newFakeResource(t, fakeIdentity)
+		idSchema := identitySchema(t, r).IdentitySchema
+
+		identity := tfsdk.ResourceIdentity{
+			Schema: idSchema,
+			Raw:    tftypes.NewValue(idSchema.Type().TerraformType(ctx), nil),
+		}
+		require.False(t, identity.SetAttribute(ctx, path.Root("name"), "my-app").HasError())
+
+		resp := resource.ImportStateResponse{State: newState(map[string]tftypes.Value{})}
+		r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{Identity: &identity}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var organizationID, projectID, appName types.String
+		resp.State.GetAttribute(ctx, path.Root("organization_id"), &organizationID)
+		resp.State.GetAttribute(ctx, path.Root("project_id"), &projectID)
+		resp.State.GetAttribute(ctx, path.Root("app_name"), &appName)
+		require.Equal(t, "org", organizationID.ValueString())
+		require.Equal(t, "default-project", projectID.ValueString())
+		require.Equal(t, "my-app", appName.ValueString())
+	})
+
+	t.Run("by ID without ImportState", func(t *testing.T) {
+		r := newFakeResource(t, fakeIdentity)
+
+		resp := resource.ImportStateResponse{State: newState(map[string]tftypes.Value{})}
+		r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "my-app"}, &resp)
+		require.True(t, resp.Diagnostics.HasError())
+		require.Equal(t, "Resource Import By ID Not Implemented", resp.Diagnostics[0].Summary())
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		r := newFakeResource(t, Schema{Attributes: fakeIdentity.Attributes, ImportUnsupported: true})
+
+		resp := resource.ImportStateResponse{State: newState(map[string]tftypes.Value{})}
+		r.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "my-app"}, &resp)
+		require.True(t, resp.Diagnostics.HasError())
+		require.Equal(t, "Resource Import Not Implemented", resp.Diagnostics[0].Summary())
+	})
+}
+
+func TestIdentity_Metadata(t *testing.T) {
+	var resp resource.MetadataResponse
+	newFakeResource(t, Schema{Attributes: fakeIdentity.Attributes, Mutable: true}).Metadata(context.Background(), resource.MetadataRequest{}, &resp)
+	require.Equal(t, "hcp_fake", resp.TypeName)
+	require.True(t, resp.ResourceBehavior.MutableIdentity)
+}
+
+// fakeMovedResource is a fake resource whose state can be moved from the
+// hcp_fake_deprecated resource.
+type fakeMovedResource struct {
+	fakeResource
+}
+
+func (r *fakeMovedResource) MoveState(context.Context) []resource.StateMover {
+	return []resource.StateMover{{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != "hcp_fake_deprecated" {
+				return
+			}
+			resp.TargetState = newState(map[string]tftypes.Value{
+				"project_id": tftypes.NewValue(tftypes.String, "project"),
+				"app_name":   tftypes.NewValue(tftypes.String, "my-app"),
+			})
+		},
+	}}
+}
+
+func TestIdentity_MoveState(t *testing.T) {
+	ctx := context.Background()
+	r := NewResource(&fakeMovedResource{fakeResource{schema: fakeIdentity}}).(*identityResource)
+	idSchema := identitySchema(t, r).IdentitySchema
+
+	movers := r.MoveState(ctx)
+	require.Len(t, movers, 1)
+
+	resp := resource.MoveStateResponse{
+		TargetState: newState(map[string]tftypes.Value{}),
+		TargetIdentity: &tfsdk.ResourceIdentity{
+			Schema: idSchema,
+			Raw:    tftypes.NewValue(idSchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	movers[0].StateMover(ctx, resource.MoveStateRequest{SourceTypeName: "hcp_fake_deprecated"}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var projectID, name types.String
+	resp.TargetIdentity.GetAttribute(ctx, path.Root("project_id"), &projectID)
+	resp.TargetIdentity.GetAttribute(ctx, path.Root("name"), &name)
+	require.Equal(t, "project", projectID.ValueString())
+	require.Equal(t, "my-app", name.ValueString())
+}
@@ -0,0 +1,514 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	tfprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"golang.org/x/oauth2"
+
+	hcpConfig "github.com/hashicorp/hcp-sdk-go/config"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider"
+	"github.com/hashicorp/terraform-provider-hcp/version"
+)
+
+// CassetteModeEnvVar is the environment variable that selects whether tests
+// using cassettes record the requests they send to the HCP API, or replay
+// previously recorded requests without accessing the network. Tests run live
+// if it is unset.
+const CassetteModeEnvVar = "HCP_ACC_CASSETTE"
+
+const (
+	// CassetteModeRecord records the requests of the tests into cassettes.
+	CassetteModeRecord = "record"
+
+	// CassetteModeReplay serves the requests of the tests from cassettes.
+	CassetteModeReplay = "replay"
+)
+
+// CassetteDir is the directory, relative to the package under test, that
+// cassettes are stored in.
+const CassetteDir = "testdata/cassettes"
+
+const (
+	// CassetteOrganizationID and CassetteProjectID replace the IDs of the
+	// organization and project a cassette was recorded in, so that cassettes
+	// don't disclose them and can be replayed by anyone.
+	CassetteOrganizationID = "00000000-0000-4000-8000-000000000001"
+	CassetteProjectID      = "00000000-0000-4000-8000-000000000002"
+)
+
+// redactedPrefix prefixes the placeholders that secrets are replaced with
+// in cassettes.
+const redactedPrefix = "REDACTED-"
+
+// redactedPattern matches the placeholders of secrets.
+var redactedPattern = regexp.MustCompile(redactedPrefix + `[0-9]+`)
+
+// randomNamePattern matches the random names that tests give the resources
+// they create. A replayed test uses other names than the recorded one, so
+// requests are matched regardless of these names.
+var randomNamePattern = regexp.MustCompile(`hcp-provider-acctest-[a-z0-9]{10}`)
+
+// randomNamePlaceholder replaces random names when matching requests.
+const randomNamePlaceholder = "hcp-provider-acctest-RANDOM"
+
+// secretFields are the JSON fields whose values are scrubbed from cassettes.
+var secretFields = map[string]bool{
+	"access_token":            true,
+	"api_key_secret":          true,
+	"api_private_key":         true,
+	"auth_key":                true,
+	"client_secret":           true,
+	"cloud_api_secret":        true,
+	"gitlab_access_token":     true,
+	"hcp_terraform_api_token": true,
+	"password":                true,
+	"private_key":             true,
+	"secret_access_key":       true,
+	"service_account_key":     true,
+	"session_token":           true,
+	"token":                   true,
+	"value":                   true,
+}
+
+func init() {
+	if os.Getenv(CassetteModeEnvVar) != CassetteModeReplay {
+		return
+	}
+
+	// Replayed tests use the IDs the cassettes were recorded with. The HCP
+	// status page is not recorded, so it's not checked either.
+	_ = os.Setenv("HCP_ORGANIZATION_ID", CassetteOrganizationID)
+	_ = os.Setenv("HCP_PROJECT_ID", CassetteProjectID)
+	_ = os.Setenv("HCP_SKIP_STATUS_CHECK", "true")
+}
+
+// cassettes holds the cassettes of the running tests, keyed by test name.
+var cassettes sync.Map
+
+// cassette holds the requests a test sent to the HCP API.
+type cassette struct {
+	path string
+	mode string
+
+	// organizationID and projectID are replaced with the cassette IDs while
+	// recording.
+	organizationID string
+	projectID      string
+
+	mu   sync.Mutex
+	file cassetteFile
+
+	// redactions maps the scrubbed secrets to their placeholders while
+	// recording.
+	redactions map[string]string
+
+	// secrets maps placeholders to the secrets sent by the test while
+	// replaying, so that they can be restored in responses.
+	secrets map[string]string
+
+	// names maps the recorded random names to the names sent by the test
+	// while replaying.
+	names map[string]string
+
+	// used tracks the interactions that were already replayed.
+	used []bool
+}
+
+// cassetteFile is the format cassettes are stored in.
+type cassetteFile struct {
+	// Interactions are the requests sent by the test, in order.
+	Interactions []*interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body,omitempty"`
+}
+
+// testCassette returns the cassette of the test, which is loaded or created
+// on first use. Nil is returned if the test is run live.
+func testCassette(t *testing.T) *cassette {
+	t.Helper()
+
+	mode := os.Getenv(CassetteModeEnvVar)
+	if mode == "" {
+		return nil
+	}
+
+	if c, ok := cassettes.Load(t.Name()); ok {
+		return c.(*cassette)
+	}
+
+	c := &cassette{
+		path:       filepath.Join(CassetteDir, strings.ReplaceAll(t.Name(), "/", "_")+".json"),
+		mode:       mode,
+		redactions: make(map[string]string),
+		secrets:    make(map[string]string),
+		names:      make(map[string]string),
+	}
+
+	switch mode {
+	case CassetteModeRecord:
+		// Resolve the organization and project the test runs in, so that
+		// they can be replaced in the cassette.
+		client := configureClient(t, provider.NewFrameworkProvider(version.ProviderVersion)())
+		c.organizationID = client.Config.OrganizationID
+		c.projectID = client.Config.ProjectID
+	case CassetteModeReplay:
+		// A missing cassette fails the test rather than skipping it, so that
+		// replayed runs can't pass without running any test.
+		data, err := os.ReadFile(c.path)
+		if errors.Is(err, os.ErrNotExist) {
+			t.Fatalf("no cassette recorded at %s, record it with %s=%s", c.path, CassetteModeEnvVar, CassetteModeRecord)
+		}
+		if err != nil {
+			t.Fatalf("failed to read cassette: %v", err)
+		}
+		if err := json.Unmarshal(data, &c.file); err != nil {
+			t.Fatalf("failed to parse cassette %s: %v", c.path, err)
+		}
+		c.used = make([]bool, len(c.file.Interactions))
+	default:
+		t.Fatalf("%s must be %q or %q, got %q", CassetteModeEnvVar, CassetteModeRecord, CassetteModeReplay, mode)
+	}
+
+	cassettes.Store(t.Name(), c)
+	t.Cleanup(func() {
+		cassettes.Delete(t.Name())
+		if err := c.save(t); err != nil {
+			t.Errorf("failed to save cassette: %v", err)
+		}
+	})
+
+	return c
+}
+
+// save writes a recorded cassette. Cassettes of failed tests are discarded.
+func (c *cassette) save(t *testing.T) error {
+	if c.mode != CassetteModeRecord {
+		return nil
+	}
+	if t.Failed() {
+		t.Logf("not saving cassette %s of failed test", c.path)
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(c.file, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.path, append(data, '\n'), 0o644)
+}
+
+// newProvider returns a provider that sends its requests through the
+// cassette.
+func (c *cassette) newProvider() tfprovider.Provider {
+	if c.mode == CassetteModeReplay {
+		// Replayed requests are never sent, any token will do.
+		token := hcpConfig.WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{
+			AccessToken: "replay",
+			TokenType:   "Bearer",
+		}))
+		return provider.NewFrameworkProviderWithTransport(version.ProviderVersion, c.wrapTransport, token)()
+	}
+
+	return provider.NewFrameworkProviderWithTransport(version.ProviderVersion, c.wrapTransport)()
+}
+
+// wrapTransport returns the transport that records or replays the requests.
+func (c *cassette) wrapTransport(next http.RoundTripper) http.RoundTripper {
+	if c.mode == CassetteModeReplay {
+		return &replayTransport{cassette: c}
+	}
+	return &recordTransport{cassette: c, next: next}
+}
+
+// recordTransport records all requests and their responses.
+type recordTransport struct {
+	cassette *cassette
+	next     http.RoundTripper
+}
+
+func (t *recordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if req.Body != nil {
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	// Requests that fail without a response are not recorded.
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := readBody(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	t.cassette.record(req, reqBody, resp, respBody)
+	return resp, nil
+}
+
+// record adds the request and its response to the cassette, with IDs
+// replaced and secrets scrubbed.
+func (c *cassette) record(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.file.Interactions = append(c.file.Interactions, &interaction{
+		Request: recordedRequest{
+			Method: req.Method,
+			URL:    c.replaceIDs(req.URL.RequestURI()),
+			Body:   scrub(c.replaceIDs(string(reqBody)), c.redact),
+		},
+		Response: recordedResponse{
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        scrub(c.replaceIDs(string(respBody)), c.redact),
+		},
+	})
+}
+
+// replaceIDs replaces the organization and project IDs with the cassette IDs.
+func (c *cassette) replaceIDs(s string) string {
+	var oldnew []string
+	if c.organizationID != "" {
+		oldnew = append(oldnew, c.organizationID, CassetteOrganizationID)
+	}
+	if c.projectID != "" {
+		oldnew = append(oldnew, c.projectID, CassetteProjectID)
+	}
+	if len(oldnew) == 0 {
+		return s
+	}
+
+	return strings.NewReplacer(oldnew...).Replace(s)
+}
+
+// redact returns the placeholder of the secret. The caller must hold c.mu.
+func (c *cassette) redact(secret string) string {
+	placeholder, ok := c.redactions[secret]
+	if !ok {
+		placeholder = fmt.Sprintf("%s%d", redactedPrefix, len(c.redactions)+1)
+		c.redactions[secret] = placeholder
+	}
+	return placeholder
+}
+
+// replayTransport serves all requests from the cassette.
+type replayTransport struct {
+	cassette *cassette
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.cassette.replay(req, body)
+}
+
+// replay returns the response to the first request in the cassette that
+// matches the passed request and wasn't replayed yet. Requests match if they
+// are equal apart from their secrets and random names.
+func (c *cassette) replay(req *http.Request, body []byte) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	uri := req.URL.RequestURI()
+	scrubbed := scrub(string(body), func(string) string { return redactedPrefix })
+	sent := uri + "\n" + scrubbed
+
+	for i, in := range c.file.Interactions {
+		if c.used[i] || in.Request.Method != req.Method {
+			continue
+		}
+		recorded := in.Request.URL + "\n" + redactedPattern.ReplaceAllString(in.Request.Body, redactedPrefix)
+		if withoutRandomNames(recorded) != withoutRandomNames(sent) {
+			continue
+		}
+
+		c.used[i] = true
+		c.learnSecrets(in.Request.Body, string(body))
+		c.learnNames(recorded, sent)
+
+		return c.response(req, in.Response), nil
+	}
+
+	return nil, fmt.Errorf("no request matching %s %s was recorded in cassette %s", req.Method, uri, c.path)
+}
+
+// learnSecrets remembers the secrets of a request, which were scrubbed from
+// the recorded request. The caller must hold c.mu.
+func (c *cassette) learnSecrets(recorded, sent string) {
+	var r, s interface{}
+	if json.Unmarshal([]byte(recorded), &r) != nil || json.Unmarshal([]byte(sent), &s) != nil {
+		return
+	}
+
+	var learn func(r, s interface{})
+	learn = func(r, s interface{}) {
+		switch r := r.(type) {
+		case string:
+			if sent, ok := s.(string); ok && redactedPattern.MatchString(r) {
+				c.secrets[r] = sent
+			}
+		case map[string]interface{}:
+			if s, ok := s.(map[string]interface{}); ok {
+				for k, v := range r {
+					learn(v, s[k])
+				}
+			}
+		case []interface{}:
+			if s, ok := s.([]interface{}); ok && len(s) == len(r) {
+				for i := range r {
+					learn(r[i], s[i])
+				}
+			}
+		}
+	}
+	learn(r, s)
+}
+
+// learnNames remembers the random names of a request that matched a recorded
+// request. The caller must hold c.mu.
+func (c *cassette) learnNames(recorded, sent string) {
+	recordedNames := randomNamePattern.FindAllString(recorded, -1)
+	sentNames := randomNamePattern.FindAllString(sent, -1)
+	for i := range recordedNames {
+		c.names[recordedNames[i]] = sentNames[i]
+	}
+}
+
+// withoutRandomNames replaces the random names in s with a placeholder.
+func withoutRandomNames(s string) string {
+	return randomNamePattern.ReplaceAllString(s, randomNamePlaceholder)
+}
+
+// response builds the response of a replayed request. Secrets and random
+// names that were sent by the test are restored, other secrets remain
+// scrubbed. The caller must hold c.mu.
+func (c *cassette) response(req *http.Request, recorded recordedResponse) *http.Response {
+	body := randomNamePattern.ReplaceAllStringFunc(recorded.Body, func(name string) string {
+		if sent, ok := c.names[name]; ok {
+			return sent
+		}
+		return name
+	})
+	body = redactedPattern.ReplaceAllStringFunc(body, func(placeholder string) string {
+		secret, ok := c.secrets[placeholder]
+		if !ok {
+			return placeholder
+		}
+
+		// The placeholder is the content of a JSON string.
+		quoted, _ := json.Marshal(secret)
+		return string(quoted[1 : len(quoted)-1])
+	})
+
+	header := make(http.Header)
+	if recorded.ContentType != "" {
+		header.Set("Content-Type", recorded.ContentType)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// scrub replaces the values of secret fields in a JSON body with the
+// placeholders returned by redact. Bodies that aren't JSON are returned
+// unchanged.
+func scrub(body string, redact func(secret string) string) string {
+	var v interface{}
+	d := json.NewDecoder(strings.NewReader(body))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return body
+	}
+
+	var walk func(v interface{}) interface{}
+	walk = func(v interface{}) interface{} {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, field := range v {
+				if s, ok := field.(string); ok && s != "" && secretFields[k] {
+					v[k] = redact(s)
+					continue
+				}
+				v[k] = walk(field)
+			}
+		case []interface{}:
+			for i := range v {
+				v[i] = walk(v[i])
+			}
+		}
+		return v
+	}
+
+	scrubbed, err := json.Marshal(walk(v))
+	if err != nil {
+		return body
+	}
+	return string(scrubbed)
+}
+
+// readBody reads and closes the body of a request or response.
+func readBody(body io.ReadCloser) ([]byte, error) {
+	if body == nil || body == http.NoBody {
+		return nil, nil
+	}
+	defer body.Close()
+
+	return io.ReadAll(body)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCassette_RecordReplay(t *testing.T) {
+	const (
+		organizationID = "11111111-1111-1111-1111-111111111111"
+		projectID      = "22222222-2222-2222-2222-222222222222"
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		// Echo the secret that was sent, alongside one generated by the API.
+		_, _ = w.Write([]byte(`{"secret":` + string(body) + `,"token":"generated","project_id":"` + projectID + `"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	url := "/secrets/organizations/" + organizationID + "/projects/" + projectID + "/apps"
+
+	// Record a request.
+	recorder := &cassette{
+		path:           path,
+		mode:           CassetteModeRecord,
+		organizationID: organizationID,
+		projectID:      projectID,
+		redactions:     make(map[string]string),
+	}
+	client := &http.Client{Transport: recorder.wrapTransport(http.DefaultTransport)}
+
+	resp, err := client.Post(server.URL+url, "application/json", strings.NewReader(`{"name":"hcp-provider-acctest-abcdefghij","value":"hunter2"}`))
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Contains(t, string(body), "hunter2", "the response of a recorded request must not be scrubbed")
+	require.NoError(t, recorder.save(t))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "hunter2")
+	assert.NotContains(t, string(data), "generated")
+	assert.NotContains(t, string(data), organizationID)
+	assert.NotContains(t, string(data), projectID)
+
+	// Replay the request.
+	replayer := &cassette{
+		path:    path,
+		mode:    CassetteModeReplay,
+		secrets: make(map[string]string),
+		names:   make(map[string]string),
+	}
+	require.NoError(t, json.Unmarshal(data, &replayer.file))
+	replayer.used = make([]bool, len(replayer.file.Interactions))
+	client = &http.Client{Transport: replayer.wrapTransport(nil)}
+
+	replayURL := "http://api.invalid/secrets/organizations/" + CassetteOrganizationID + "/projects/" + CassetteProjectID + "/apps"
+	// The test generated another random name than the recorded one.
+	resp, err = client.Post(replayURL, "application/json", strings.NewReader(`{"name":"hcp-provider-acctest-0123456789","value":"hunter2"}`))
+	require.NoError(t, err)
+	body, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	var got struct {
+		Secret struct {
+			Name  string `json:"name"`
+			Value string `json:"value"`
+		} `json:"secret"`
+		Token     string `json:"token"`
+		ProjectID string `json:"project_id"`
+	}
+	require.NoError(t, json.Unmarshal(body, &got))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "hcp-provider-acctest-0123456789", got.Secret.Name, "random names sent by the test are restored")
+	assert.Equal(t, "hunter2", got.Secret.Value, "secrets sent by the test are restored")
+	assert.Equal(t, "REDACTED-2", got.Token, "secrets generated by the API remain scrubbed")
+	assert.Equal(t, CassetteProjectID, got.ProjectID)
+
+	// Every recorded request is replayed once.
+	_, err = client.Post(replayURL, "application/json", strings.NewReader(`{"name":"hcp-provider-acctest-0123456789","value":"hunter2"}`))
+	assert.ErrorContains(t, err, "no request matching POST")
+}
+
+func TestScrub(t *testing.T) {
+	redact := func(string) string { return "REDACTED" }
+
+	assert.Equal(t,
+		`{"integration":{"client_secret":"REDACTED","name":"n"},"versions":[{"value":"REDACTED"}]}`,
+		scrub(`{"integration":{"name":"n","client_secret":"s"},"versions":[{"value":"v"}]}`, redact),
+	)
+	assert.Equal(t, `{"count":12345678901234567890}`, scrub(`{"count":12345678901234567890}`, redact))
+	assert.Equal(t, "not json", scrub("not json", redact))
+	assert.Equal(t, "", scrub("", redact))
+}
@@ -10,12 +10,13 @@ import (
 
 	tfprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpfake"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/utils/location"
 	"github.com/hashicorp/terraform-provider-hcp/version"
@@ -26,13 +27,76 @@ import (
 var ProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"hcp": func() (tfprotov6.ProviderServer, error) {
 		providers := []func() tfprotov6.ProviderServer{
-			providerserver.NewProtocol6(provider.NewFrameworkProvider(version.ProviderVersion)()),
+			provider.NewProtocol6(provider.NewFrameworkProvider(version.ProviderVersion)()),
 		}
 
 		return tf6muxserver.NewMuxServer(context.Background(), providers...)
 	},
 }
 
+// ProtoV6ProviderFactoriesWithEcho provides the HCP provider alongside the echo
+// provider, which allows acceptance tests to assert on the result of ephemeral
+// resources.
+var ProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"hcp":  ProtoV6ProviderFactories["hcp"],
+	"echo": echoprovider.NewProviderServer(),
+}
+
+// ProtoV6ProviderFactoriesWithFakeAPI provides a Provider Factory that sends
+// all requests to the passed fake HCP API, which allows resources to be unit
+// tested without HCP credentials. The provider defaults to the project the
+// fake is seeded with and skips the HCP status check.
+func ProtoV6ProviderFactoriesWithFakeAPI(t *testing.T, server *hcpfake.Server) map[string]func() (tfprotov6.ProviderServer, error) {
+	t.Helper()
+
+	t.Setenv("HCP_PROJECT_ID", server.ProjectID())
+	t.Setenv("HCP_SKIP_STATUS_CHECK", "true")
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"hcp": func() (tfprotov6.ProviderServer, error) {
+			providers := []func() tfprotov6.ProviderServer{
+				provider.NewProtocol6(provider.NewFrameworkProviderWithHCPConfigOptions(version.ProviderVersion, server.HCPConfigOptions()...)()),
+			}
+
+			return tf6muxserver.NewMuxServer(context.Background(), providers...)
+		},
+	}
+}
+
+// ProtoV6ProviderFactoriesWithCassette provides a Provider Factory that
+// records the requests of the test to a cassette, or replays them from it,
+// depending on the CassetteModeEnvVar environment variable. If the variable is
+// unset, the provider sends requests to HCP, as ProtoV6ProviderFactories.
+func ProtoV6ProviderFactoriesWithCassette(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	t.Helper()
+
+	c := testCassette(t)
+	if c == nil {
+		return ProtoV6ProviderFactories
+	}
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"hcp": func() (tfprotov6.ProviderServer, error) {
+			providers := []func() tfprotov6.ProviderServer{
+				provider.NewProtocol6(c.newProvider()),
+			}
+
+			return tf6muxserver.NewMuxServer(context.Background(), providers...)
+		},
+	}
+}
+
+// ProtoV6ProviderFactoriesWithEchoAndCassette provides the providers of
+// ProtoV6ProviderFactoriesWithCassette alongside the echo provider.
+func ProtoV6ProviderFactoriesWithEchoAndCassette(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	t.Helper()
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"hcp":  ProtoV6ProviderFactoriesWithCassette(t)["hcp"],
+		"echo": echoprovider.NewProviderServer(),
+	}
+}
+
 // PreCheck verifies that the required provider testing configuration is set.
 //
 // This PreCheck function should be present in every acceptance test. It ensures
@@ -40,6 +104,11 @@ var ProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, erro
 func PreCheck(t *testing.T) {
 	t.Helper()
 
+	// Replayed tests don't need credentials.
+	if os.Getenv(CassetteModeEnvVar) == CassetteModeReplay {
+		return
+	}
+
 	if os.Getenv("HCP_CLIENT_ID") == "" {
 		t.Fatal("HCP_CLIENT_ID must be set for acceptance tests")
 	}
@@ -54,9 +123,18 @@ func PreCheck(t *testing.T) {
 	}
 }
 
-// HCPClients returns the clients from the test provider.
+// HCPClients returns the clients from the test provider. If the test uses a
+// cassette, the requests of the clients are recorded to or replayed from it.
 func HCPClients(t *testing.T) *clients.Client {
-	p := provider.NewFrameworkProvider(version.ProviderVersion)()
+	if c := testCassette(t); c != nil {
+		return configureClient(t, c.newProvider())
+	}
+
+	return configureClient(t, provider.NewFrameworkProvider(version.ProviderVersion)())
+}
+
+// configureClient configures the provider and returns its clients.
+func configureClient(t *testing.T, p tfprovider.Provider) *clients.Client {
 	var resp tfprovider.ConfigureResponse
 	p.Configure(context.Background(), tfprovider.ConfigureRequest{
 		TerraformVersion: "",
@@ -71,6 +149,10 @@ func HCPClients(t *testing.T) *clients.Client {
 		t.Fatal("configure didn't return expected clients")
 	}
 
+	if err := client.Resolve(context.Background()); err != nil {
+		t.Fatalf("failed to resolve clients: %v", err)
+	}
+
 	return client
 }
 
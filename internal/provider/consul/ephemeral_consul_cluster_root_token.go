@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package consul
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+var _ ephemeral.EphemeralResource = &ephemeralConsulClusterRootToken{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralConsulClusterRootToken{}
+
+func NewConsulClusterRootTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ephemeralConsulClusterRootToken{}
+}
+
+type ephemeralConsulClusterRootToken struct {
+	client *clients.Client
+}
+
+type ephemeralConsulClusterRootTokenModel struct {
+	ClusterID        types.String `tfsdk:"cluster_id"`
+	ProjectID        types.String `tfsdk:"project_id"`
+	AccessorID       types.String `tfsdk:"accessor_id"`
+	SecretID         types.String `tfsdk:"secret_id"`
+	ConsulConfig     types.String `tfsdk:"consul_config"`
+	ConsulCA         types.String `tfsdk:"consul_ca"`
+	ConsulConfigFile types.String `tfsdk:"consul_config_file"`
+	ConsulCAFile     types.String `tfsdk:"consul_ca_file"`
+}
+
+func (e *ephemeralConsulClusterRootToken) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_consul_cluster_root_token"
+}
+
+func (e *ephemeralConsulClusterRootToken) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Consul cluster root token ephemeral resource generates a root ACL token for the HCP Consul cluster and " +
+			"returns the cluster's client configuration, without persisting either to the Terraform state. " +
+			"Generating a new root token invalidates the previous root token of the cluster.",
+		DeprecationMessage: "HashiCorp plans to sunset HashiCorp Consul Dedicated (HCD) in November 2025, more information about the EOL will be provided to existing customers directly",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the HCP Consul cluster.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[\da-zA-Z][-a-zA-Z\d]{1,34}[\da-zA-Z]$`),
+						"must be between 3 and 36 characters in length and contains only letters, numbers or hyphens",
+					),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the HCP Consul cluster is located. " +
+					"If not specified, the project specified in the HCP Provider config block will be used, if configured.",
+				Optional: true,
+				Computed: true,
+			},
+			"accessor_id": schema.StringAttribute{
+				Description: "The accessor ID of the root ACL token.",
+				Computed:    true,
+			},
+			"secret_id": schema.StringAttribute{
+				Description: "The secret ID of the root ACL token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"consul_config": schema.StringAttribute{
+				Description: "The decoded Consul client configuration of the cluster, in JSON format.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"consul_ca": schema.StringAttribute{
+				Description: "The decoded CA certificate of the cluster, in PEM format.",
+				Computed:    true,
+			},
+			"consul_config_file": schema.StringAttribute{
+				Description: "The Consul client configuration of the cluster encoded as a Base64 string.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"consul_ca_file": schema.StringAttribute{
+				Description: "The CA certificate of the cluster encoded as a Base64 string.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralConsulClusterRootToken) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	e.client = client
+}
+
+func (e *ephemeralConsulClusterRootToken) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralConsulClusterRootTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if e.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HCP Client",
+			"Expected configured HCP client. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	clusterID := data.ClusterID.ValueString()
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: e.client.Config.OrganizationID,
+		ProjectID:      e.client.Config.ProjectID,
+	}
+	if !data.ProjectID.IsNull() {
+		loc.ProjectID = data.ProjectID.ValueString()
+	}
+
+	if _, err := clients.GetConsulClusterByID(ctx, e.client, loc, clusterID); err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Consul cluster not found",
+				fmt.Sprintf("unable to create root ACL token; Consul cluster (%s) not found", clusterID),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading Consul cluster",
+			fmt.Sprintf("unable to check for presence of an existing Consul cluster (%s): %v", clusterID, err),
+		)
+		return
+	}
+
+	clientConfigFiles, err := clients.GetConsulClientConfigFiles(ctx, e.client, loc, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Consul client configuration",
+			fmt.Sprintf("unable to retrieve Consul cluster (%s) client config files: %v", clusterID, err),
+		)
+		return
+	}
+
+	rootTokenResp, err := clients.CreateCustomerRootACLToken(ctx, e.client, loc, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Consul root ACL token",
+			fmt.Sprintf("error creating HCP Consul cluster root ACL token (cluster_id %q) (project_id %q): %v", clusterID, loc.ProjectID, err),
+		)
+		return
+	}
+
+	data.ProjectID = types.StringValue(loc.ProjectID)
+	data.AccessorID = types.StringValue(rootTokenResp.ACLToken.AccessorID)
+	data.SecretID = types.StringValue(rootTokenResp.ACLToken.SecretID)
+	data.ConsulConfig = types.StringValue(string(clientConfigFiles.ConsulConfigFile))
+	data.ConsulCA = types.StringValue(string(clientConfigFiles.CaFile))
+	data.ConsulConfigFile = types.StringValue(clientConfigFiles.ConsulConfigFile.String())
+	data.ConsulCAFile = types.StringValue(clientConfigFiles.CaFile.String())
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
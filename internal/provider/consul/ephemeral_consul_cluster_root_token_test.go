@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package consul
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-consul-service/stable/2021-02-04/client/consul_service"
+	consulmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-consul-service/stable/2021-02-04/models"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpfake"
+)
+
+func TestEphemeralConsulClusterRootToken(t *testing.T) {
+	ctx := context.Background()
+	server := hcpfake.NewServer(t)
+	client := server.NewClient(t)
+
+	params := consul_service.NewCreateParamsWithContext(ctx)
+	params.ClusterLocationOrganizationID = server.OrganizationID()
+	params.ClusterLocationProjectID = server.ProjectID()
+	params.Body = &consulmodels.HashicorpCloudConsul20210204CreateRequest{
+		Cluster: &consulmodels.HashicorpCloudConsul20210204Cluster{
+			ID: "consul-cluster-1",
+			Config: &consulmodels.HashicorpCloudConsul20210204ClusterConfig{
+				ConsulConfig: &consulmodels.HashicorpCloudConsul20210204ConsulConfig{Datacenter: "dc1"},
+			},
+		},
+	}
+	_, err := client.Consul.Create(params, nil)
+	require.NoError(t, err)
+
+	e := &ephemeralConsulClusterRootToken{client: client}
+	var schemaResp ephemeral.SchemaResponse
+	e.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	// open opens the ephemeral resource for the passed cluster.
+	open := func(t *testing.T, clusterID string) ephemeral.OpenResponse {
+		t.Helper()
+
+		config := tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+		require.False(t, config.SetAttribute(ctx, path.Root("cluster_id"), clusterID).HasError())
+
+		resp := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}}
+		e.Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw}}, &resp)
+		return resp
+	}
+
+	t.Run("root token and client config", func(t *testing.T) {
+		resp := open(t, "consul-cluster-1")
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var data ephemeralConsulClusterRootTokenModel
+		require.False(t, resp.Result.Get(ctx, &data).HasError())
+		assert.Equal(t, server.ProjectID(), data.ProjectID.ValueString())
+		assert.JSONEq(t, `{"datacenter":"dc1"}`, data.ConsulConfig.ValueString())
+		assert.Contains(t, data.ConsulCA.ValueString(), "BEGIN CERTIFICATE")
+		assert.Equal(t, "eyJkYXRhY2VudGVyIjoiZGMxIn0=", data.ConsulConfigFile.ValueString())
+		assert.NotEmpty(t, data.AccessorID.ValueString())
+		assert.NotEmpty(t, data.SecretID.ValueString())
+	})
+
+	t.Run("new root token on every open", func(t *testing.T) {
+		var secretIDs []string
+		for i := 0; i < 2; i++ {
+			resp := open(t, "consul-cluster-1")
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var data ephemeralConsulClusterRootTokenModel
+			require.False(t, resp.Result.Get(ctx, &data).HasError())
+			secretIDs = append(secretIDs, data.SecretID.ValueString())
+		}
+		assert.NotEqual(t, secretIDs[0], secretIDs[1])
+	})
+
+	t.Run("missing cluster", func(t *testing.T) {
+		resp := open(t, "missing")
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Consul cluster not found", resp.Diagnostics.Errors()[0].Summary())
+	})
+}
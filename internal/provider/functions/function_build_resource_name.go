@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &buildResourceNameFunction{}
+
+func NewBuildResourceNameFunction() function.Function {
+	return &buildResourceNameFunction{}
+}
+
+type buildResourceNameFunction struct{}
+
+func (f *buildResourceNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_resource_name"
+}
+
+func (f *buildResourceNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build an HCP resource name from its components",
+		MarkdownDescription: "Given a service and a list of `type`/`name` parts, returns the HCP resource name. " +
+			"For example, the service `iam` and the parts `[{ type = \"project\", name = \"<project_id>\" }, { type = \"service-principal\", name = \"<name>\" }]` " +
+			"build the resource name `iam/project/<project_id>/service-principal/<name>`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "service",
+				Description: "The service the resource belongs to, e.g. `iam` or `vault-secrets`. " +
+					"Set to `null` or an empty string for resource names without a service, such as `project/<project_id>`.",
+				AllowNullValue: true,
+			},
+			function.ListParameter{
+				Name:        "parts",
+				Description: "The list of `type`/`name` pairs of the resource name, starting with the outermost parent.",
+				ElementType: types.ObjectType{AttrTypes: resourceNamePartAttrTypes},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *buildResourceNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var service types.String
+	var parts []resourceNamePart
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &service, &parts))
+	if resp.Error != nil {
+		return
+	}
+
+	rn, err := buildResourceName(service.ValueString(), parts)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, rn))
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+var _ function.Function = &parseLinkFunction{}
+
+func NewParseLinkFunction() function.Function {
+	return &parseLinkFunction{}
+}
+
+type parseLinkFunction struct{}
+
+// link is a parsed HCP link URL.
+type link struct {
+	ProjectID string `tfsdk:"project_id"`
+	Type      string `tfsdk:"type"`
+	ID        string `tfsdk:"id"`
+}
+
+func (f *parseLinkFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_link"
+}
+
+func (f *parseLinkFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse an HCP link URL into its components",
+		MarkdownDescription: "Given an HCP link URL, such as the `self_link` of an HVN (`/project/<project_id>/hashicorp.network.hvn/<hvn_id>`), " +
+			"returns an object with the `project_id`, the resource `type` and the `id` of the linked resource.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "link",
+				Description: "The link URL to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"project_id": types.StringType,
+				"type":       types.StringType,
+				"id":         types.StringType,
+			},
+		},
+	}
+}
+
+func (f *parseLinkFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var linkURL string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &linkURL))
+	if resp.Error != nil {
+		return
+	}
+
+	l, err := clients.ParseLinkURL(linkURL, "")
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, link{
+		ProjectID: l.Location.ProjectID,
+		Type:      l.Type,
+		ID:        l.ID,
+	}))
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLinkFunction_Run(t *testing.T) {
+	ctx := context.Background()
+	attrTypes := map[string]attr.Type{
+		"project_id": types.StringType,
+		"type":       types.StringType,
+		"id":         types.StringType,
+	}
+
+	tcs := map[string]struct {
+		input   string
+		want    attr.Value
+		wantErr bool
+	}{
+		"valid": {
+			input: "/project/123/hashicorp.network.hvn/my-hvn",
+			want: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"project_id": types.StringValue("123"),
+				"type":       types.StringValue("hashicorp.network.hvn"),
+				"id":         types.StringValue("my-hvn"),
+			}),
+		},
+		"missing id": {
+			input:   "/project/123/hashicorp.network.hvn",
+			wantErr: true,
+		},
+		"extra segments": {
+			input:   "/project/123/hashicorp.network.hvn/my-hvn/extra",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tc.input)}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(attrTypes)),
+			}
+
+			NewParseLinkFunction().Run(ctx, req, resp)
+			if tc.wantErr {
+				require.NotNil(t, resp.Error)
+				return
+			}
+
+			require.Nil(t, resp.Error)
+			require.Equal(t, tc.want, resp.Result.Value())
+		})
+	}
+}
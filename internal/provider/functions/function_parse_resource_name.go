@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &parseResourceNameFunction{}
+
+func NewParseResourceNameFunction() function.Function {
+	return &parseResourceNameFunction{}
+}
+
+type parseResourceNameFunction struct{}
+
+func (f *parseResourceNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_resource_name"
+}
+
+func (f *parseResourceNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse an HCP resource name into its components",
+		MarkdownDescription: "Given an HCP resource name, such as `iam/project/<project_id>/service-principal/<name>`, returns an object " +
+			"with the `service` prefix, the list of `type`/`name` `parts`, the `organization_id` and `project_id` the resource " +
+			"belongs to, and the `type` and `name` of the resource itself. Attributes that are not part of the resource name are null.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "resource_name",
+				Description: "The resource name to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: resourceNameAttrTypes,
+		},
+	}
+}
+
+func (f *parseResourceNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rn string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &rn))
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := parseResourceName(rn)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, parsed))
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResourceNameFunction_Run(t *testing.T) {
+	ctx := context.Background()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("vault-secrets/project/123/app/my-app")}),
+	}
+	resp := &function.RunResponse{
+		Result: function.NewResultData(types.ObjectUnknown(resourceNameAttrTypes)),
+	}
+
+	NewParseResourceNameFunction().Run(ctx, req, resp)
+	require.Nil(t, resp.Error)
+
+	partType := types.ObjectType{AttrTypes: resourceNamePartAttrTypes}
+	want := types.ObjectValueMust(resourceNameAttrTypes, map[string]attr.Value{
+		"service": types.StringValue("vault-secrets"),
+		"parts": types.ListValueMust(partType, []attr.Value{
+			types.ObjectValueMust(resourceNamePartAttrTypes, map[string]attr.Value{
+				"type": types.StringValue("project"),
+				"name": types.StringValue("123"),
+			}),
+			types.ObjectValueMust(resourceNamePartAttrTypes, map[string]attr.Value{
+				"type": types.StringValue("app"),
+				"name": types.StringValue("my-app"),
+			}),
+		}),
+		"organization_id": types.StringNull(),
+		"project_id":      types.StringValue("123"),
+		"type":            types.StringValue("app"),
+		"name":            types.StringValue("my-app"),
+	})
+	require.Equal(t, want, resp.Result.Value())
+}
+
+func TestParseResourceNameFunction_RunInvalid(t *testing.T) {
+	ctx := context.Background()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("not a resource name")}),
+	}
+	resp := &function.RunResponse{
+		Result: function.NewResultData(types.ObjectUnknown(resourceNameAttrTypes)),
+	}
+
+	NewParseResourceNameFunction().Run(ctx, req, resp)
+	require.NotNil(t, resp.Error)
+	require.NotNil(t, resp.Error.FunctionArgument)
+	require.EqualValues(t, 0, *resp.Error.FunctionArgument)
+}
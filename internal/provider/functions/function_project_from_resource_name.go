@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &projectFromResourceNameFunction{}
+
+func NewProjectFromResourceNameFunction() function.Function {
+	return &projectFromResourceNameFunction{}
+}
+
+type projectFromResourceNameFunction struct{}
+
+func (f *projectFromResourceNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "project_from_resource_name"
+}
+
+func (f *projectFromResourceNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Return the project ID of an HCP resource name",
+		MarkdownDescription: "Given an HCP resource name, such as `vault-secrets/project/<project_id>/app/<name>`, returns the ID of the " +
+			"project the resource belongs to. Returns an error if the resource name does not contain a project.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "resource_name",
+				Description: "The resource name to extract the project ID from.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *projectFromResourceNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rn string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &rn))
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := parseResourceName(rn)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if parsed.ProjectID.IsNull() {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("resource name %q does not contain a project", rn))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, parsed.ProjectID))
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+)
+
+const (
+	organizationPartType = "organization"
+	projectPartType      = "project"
+)
+
+// resourceNameSegmentRegex matches the service and the type segments of a
+// resource name, e.g. `iam` or `service-principal`.
+var resourceNameSegmentRegex = regexp.MustCompile(`^[a-z][a-z-]*$`)
+
+// resourceNamePartAttrTypes are the attribute types of a single type/name pair
+// of a resource name.
+var resourceNamePartAttrTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"name": types.StringType,
+}
+
+// resourceNameAttrTypes are the attribute types of a parsed resource name.
+var resourceNameAttrTypes = map[string]attr.Type{
+	"service":         types.StringType,
+	"parts":           types.ListType{ElemType: types.ObjectType{AttrTypes: resourceNamePartAttrTypes}},
+	"organization_id": types.StringType,
+	"project_id":      types.StringType,
+	"type":            types.StringType,
+	"name":            types.StringType,
+}
+
+// resourceNamePart is a single type/name pair of a resource name, e.g.
+// `project/<project_id>`.
+type resourceNamePart struct {
+	Type string `tfsdk:"type"`
+	Name string `tfsdk:"name"`
+}
+
+// resourceName is a parsed HCP resource name. A resource name consists of an
+// optional service followed by one or more type/name pairs, e.g.
+// `iam/project/<project_id>/service-principal/<name>` or `project/<project_id>`.
+type resourceName struct {
+	Service        types.String       `tfsdk:"service"`
+	Parts          []resourceNamePart `tfsdk:"parts"`
+	OrganizationID types.String       `tfsdk:"organization_id"`
+	ProjectID      types.String       `tfsdk:"project_id"`
+	Type           types.String       `tfsdk:"type"`
+	Name           types.String       `tfsdk:"name"`
+}
+
+// parseResourceName parses the passed resource name. If the resource name is
+// malformed, an error is returned.
+func parseResourceName(rn string) (*resourceName, error) {
+	if rn == "" {
+		return nil, fmt.Errorf("resource name must not be empty")
+	}
+
+	segments := strings.Split(rn, "/")
+
+	result := &resourceName{
+		Service:        types.StringNull(),
+		OrganizationID: types.StringNull(),
+		ProjectID:      types.StringNull(),
+	}
+
+	// An odd number of segments means that the resource name is prefixed
+	// with the service.
+	if len(segments)%2 == 1 {
+		if !resourceNameSegmentRegex.MatchString(segments[0]) {
+			return nil, fmt.Errorf("resource name %q has an invalid service %q: must consist only of lowercase alphabetic characters and dashes", rn, segments[0])
+		}
+
+		result.Service = types.StringValue(segments[0])
+		segments = segments[1:]
+	}
+
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("resource name %q is not in the correct format: [{service}/]{type}/{name}[/{type}/{name}...]", rn)
+	}
+
+	parts := make([]resourceNamePart, 0, len(segments)/2)
+	for i := 0; i < len(segments); i += 2 {
+		part := resourceNamePart{
+			Type: segments[i],
+			Name: segments[i+1],
+		}
+
+		if err := validateResourceNamePart(part); err != nil {
+			return nil, fmt.Errorf("resource name %q is invalid: %w", rn, err)
+		}
+
+		switch part.Type {
+		case organizationPartType:
+			result.OrganizationID = types.StringValue(part.Name)
+		case projectPartType:
+			result.ProjectID = types.StringValue(part.Name)
+		}
+
+		parts = append(parts, part)
+	}
+
+	last := parts[len(parts)-1]
+	result.Parts = parts
+	result.Type = types.StringValue(last.Type)
+	result.Name = types.StringValue(last.Name)
+
+	return result, nil
+}
+
+// buildResourceName builds a resource name from the passed service and parts.
+// The service may be empty, in which case the resource name is not prefixed.
+func buildResourceName(service string, parts []resourceNamePart) (string, error) {
+	if len(parts) == 0 {
+		return "", fmt.Errorf("at least one resource name part is required")
+	}
+
+	segments := make([]string, 0, len(parts)*2+1)
+	if service != "" {
+		if !resourceNameSegmentRegex.MatchString(service) {
+			return "", fmt.Errorf("invalid service %q: must consist only of lowercase alphabetic characters and dashes", service)
+		}
+		segments = append(segments, service)
+	}
+
+	for _, part := range parts {
+		if err := validateResourceNamePart(part); err != nil {
+			return "", err
+		}
+		segments = append(segments, part.Type, part.Name)
+	}
+
+	return strings.Join(segments, "/"), nil
+}
+
+func validateResourceNamePart(part resourceNamePart) error {
+	if !resourceNameSegmentRegex.MatchString(part.Type) {
+		return fmt.Errorf("invalid type %q: must consist only of lowercase alphabetic characters and dashes", part.Type)
+	}
+
+	if !hcpvalidator.IsResourceNamePart(part.Name) {
+		return fmt.Errorf("invalid name %q for type %q: must consist only of alphanumeric characters, dashes, underscores or dots", part.Name, part.Type)
+	}
+
+	return nil
+}
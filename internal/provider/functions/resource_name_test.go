@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseResourceName(t *testing.T) {
+	tcs := map[string]struct {
+		input   string
+		want    *resourceName
+		wantErr bool
+	}{
+		"project": {
+			input: "project/123",
+			want: &resourceName{
+				Service:        types.StringNull(),
+				Parts:          []resourceNamePart{{Type: "project", Name: "123"}},
+				OrganizationID: types.StringNull(),
+				ProjectID:      types.StringValue("123"),
+				Type:           types.StringValue("project"),
+				Name:           types.StringValue("123"),
+			},
+		},
+		"service principal": {
+			input: "iam/project/123/service-principal/my-sp",
+			want: &resourceName{
+				Service: types.StringValue("iam"),
+				Parts: []resourceNamePart{
+					{Type: "project", Name: "123"},
+					{Type: "service-principal", Name: "my-sp"},
+				},
+				OrganizationID: types.StringNull(),
+				ProjectID:      types.StringValue("123"),
+				Type:           types.StringValue("service-principal"),
+				Name:           types.StringValue("my-sp"),
+			},
+		},
+		"group": {
+			input: "iam/organization/456/group/my_group",
+			want: &resourceName{
+				Service: types.StringValue("iam"),
+				Parts: []resourceNamePart{
+					{Type: "organization", Name: "456"},
+					{Type: "group", Name: "my_group"},
+				},
+				OrganizationID: types.StringValue("456"),
+				ProjectID:      types.StringNull(),
+				Type:           types.StringValue("group"),
+				Name:           types.StringValue("my_group"),
+			},
+		},
+		"empty": {
+			input:   "",
+			wantErr: true,
+		},
+		"service only": {
+			input:   "iam",
+			wantErr: true,
+		},
+		"empty name": {
+			input:   "iam/project/",
+			wantErr: true,
+		},
+		"invalid service": {
+			input:   "IAM/project/123/service-principal/my-sp",
+			wantErr: true,
+		},
+		"invalid type": {
+			input:   "project/123/Service_Principal/my-sp",
+			wantErr: true,
+		},
+		"invalid name": {
+			input:   "iam/project/123/service-principal/my sp",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseResourceName(tc.input)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func Test_buildResourceName(t *testing.T) {
+	tcs := map[string]struct {
+		service string
+		parts   []resourceNamePart
+		want    string
+		wantErr bool
+	}{
+		"without service": {
+			parts: []resourceNamePart{{Type: "project", Name: "123"}},
+			want:  "project/123",
+		},
+		"with service": {
+			service: "vault-secrets",
+			parts: []resourceNamePart{
+				{Type: "project", Name: "123"},
+				{Type: "app", Name: "my-app"},
+			},
+			want: "vault-secrets/project/123/app/my-app",
+		},
+		"no parts": {
+			service: "iam",
+			wantErr: true,
+		},
+		"invalid service": {
+			service: "iam/project",
+			parts:   []resourceNamePart{{Type: "project", Name: "123"}},
+			wantErr: true,
+		},
+		"invalid name": {
+			parts:   []resourceNamePart{{Type: "project", Name: "12/3"}},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			got, err := buildResourceName(tc.service, tc.parts)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+
+			// Round trip the built resource name
+			parsed, err := parseResourceName(got)
+			require.NoError(t, err)
+			require.Equal(t, tc.parts, parsed.Parts)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
+)
+
+// NewGroupListResource returns the list resource of the groups of the
+// organization of the provider.
+func NewGroupListResource() list.ListResource {
+	return identity.NewListResource(NewGroupResource(), schema.Schema{
+		MarkdownDescription: "Lists the groups of the organization of the provider.",
+	}, listGroups)
+}
+
+func listGroups(ctx context.Context, client *clients.Client, _ tfsdk.Config) ([]identity.ListResult, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parent := fmt.Sprintf("organization/%s", client.Config.OrganizationID)
+	groups, err := clients.ListGroups(ctx, client, parent)
+	if err != nil {
+		diags.AddError("Unable to list groups", fmt.Sprintf("Unable to list the groups of %q: %v", parent, err))
+		return nil, diags
+	}
+
+	results := make([]identity.ListResult, 0, len(groups))
+	for _, group := range groups {
+		results = append(results, identity.ListResult{
+			DisplayName: group.DisplayName,
+			Identity: map[string]string{
+				"resource_name": group.ResourceName,
+			},
+		})
+	}
+	return results, diags
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
+)
+
+// NewServicePrincipalListResource returns the list resource of the service
+// principals of a project or an organization.
+func NewServicePrincipalListResource() list.ListResource {
+	return identity.NewListResource(NewServicePrincipalResource(), schema.Schema{
+		MarkdownDescription: "Lists the service principals of a project or an organization.",
+		Attributes: map[string]schema.Attribute{
+			"parent": schema.StringAttribute{
+				Description: "The parent location to list the service principals of. " +
+					"If unspecified, the service principals of the project the provider is configured with are listed. " +
+					"If specified, the accepted values are \"project/<project_id>\" or \"organization/<organization_id>\"",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^(organization|project)/.+$`),
+						"must reference a project or organization resource_name",
+					),
+				},
+			},
+		},
+	}, listServicePrincipals)
+}
+
+func listServicePrincipals(ctx context.Context, client *clients.Client, config tfsdk.Config) ([]identity.ListResult, diag.Diagnostics) {
+	var parent types.String
+	diags := config.GetAttribute(ctx, path.Root("parent"), &parent)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	parentResourceName := parent.ValueString()
+	if parentResourceName == "" {
+		parentResourceName = fmt.Sprintf("project/%s", client.Config.ProjectID)
+	}
+
+	servicePrincipals, err := clients.ListServicePrincipals(ctx, client, parentResourceName)
+	if err != nil {
+		diags.AddError("Unable to list service principals", fmt.Sprintf("Unable to list the service principals of %q: %v", parentResourceName, err))
+		return nil, diags
+	}
+
+	results := make([]identity.ListResult, 0, len(servicePrincipals))
+	for _, sp := range servicePrincipals {
+		results = append(results, identity.ListResult{
+			DisplayName: sp.Name,
+			Identity: map[string]string{
+				"resource_name": sp.ResourceName,
+			},
+		})
+	}
+	return results, diags
+}
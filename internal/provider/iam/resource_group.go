@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-iam/stable/2019-12-10/client/groups_service"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-iam/stable/2019-12-10/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	clients "github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 func NewGroupResource() resource.Resource {
@@ -36,7 +38,7 @@ func (r *resourceGroup) Metadata(_ context.Context, req resource.MetadataRequest
 	resp.TypeName = req.ProviderTypeName + "_group"
 }
 
-func (r *resourceGroup) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceGroup) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: fmt.Sprintf(`The group resource manages a HCP Group.
 
@@ -75,6 +77,17 @@ The user or service account that is running Terraform when creating an %s resour
 				Default: stringdefault.StaticString(""),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceGroup) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ResourceName("The resource name of the group."),
+		},
 	}
 }
 
@@ -94,10 +107,11 @@ func (r *resourceGroup) Configure(_ context.Context, req resource.ConfigureReque
 }
 
 type Group struct {
-	ResourceID   types.String `tfsdk:"resource_id"`
-	ResourceName types.String `tfsdk:"resource_name"`
-	DisplayName  types.String `tfsdk:"display_name"`
-	Description  types.String `tfsdk:"description"`
+	ResourceID   types.String   `tfsdk:"resource_id"`
+	ResourceName types.String   `tfsdk:"resource_name"`
+	DisplayName  types.String   `tfsdk:"display_name"`
+	Description  types.String   `tfsdk:"description"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceGroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -107,6 +121,15 @@ func (r *resourceGroup) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	orgID := r.client.Config.OrganizationID
 	parent := fmt.Sprintf("organization/%s", orgID)
 
@@ -117,7 +140,7 @@ func (r *resourceGroup) Create(ctx context.Context, req resource.CreateRequest,
 		Description: plan.Description.ValueString(),
 	}
 
-	res, err := clients.CreateGroupRetry(r.client, createParams)
+	res, err := clients.CreateGroup(r.client, createParams)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating group", err.Error())
@@ -140,6 +163,16 @@ func (r *resourceGroup) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	timeout, diags := state.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	getParams := groups_service.NewGroupsServiceGetGroupParams().WithContext(ctx)
 	getParams.ResourceName = state.ResourceName.ValueString()
 	res, err := r.client.Groups.GroupsServiceGetGroup(getParams, nil)
@@ -173,6 +206,15 @@ func (r *resourceGroup) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Update(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	updateParams := groups_service.NewGroupsServiceUpdateGroup2Params().WithContext(ctx)
 	updateParams.ResourceName = state.ResourceName.ValueString()
 	updateParams.Group = &models.HashicorpCloudIamGroup{}
@@ -198,7 +240,7 @@ func (r *resourceGroup) Update(ctx context.Context, req resource.UpdateRequest,
 	updateMaskStr := strings.Join(updateMask, ",")
 	updateParams.SetUpdateMask(&updateMaskStr)
 
-	_, err := clients.UpdateGroupRetry(r.client, updateParams)
+	_, err := clients.UpdateGroup(r.client, updateParams)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating group", err.Error())
 		return
@@ -215,10 +257,19 @@ func (r *resourceGroup) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	timeout, diags := state.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	deleteParams := groups_service.NewGroupsServiceDeleteGroupParams().WithContext(ctx)
 	deleteParams.ResourceName = state.ResourceName.ValueString()
 
-	_, err := clients.DeleteGroupRetry(r.client, deleteParams)
+	_, err := clients.DeleteGroup(r.client, deleteParams)
 
 	if err != nil {
 		var getErr *groups_service.GroupsServiceDeleteGroupDefault
@@ -87,7 +87,7 @@ func (u *groupIAMPolicyUpdater) GetMutexKey() string {
 // Fetch the existing IAM policy attached to a resource.
 func (u *groupIAMPolicyUpdater) GetResourceIamPolicy(ctx context.Context) (*models.HashicorpCloudResourcemanagerPolicy, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	params := resource_service.NewResourceServiceGetIamPolicyParams()
+	params := resource_service.NewResourceServiceGetIamPolicyParamsWithContext(ctx)
 	params.ResourceName = &u.resourceName
 
 	res, err := u.client.ResourceService.ResourceServiceGetIamPolicy(params, nil)
@@ -111,7 +111,7 @@ func (u *groupIAMPolicyUpdater) GetResourceIamPolicy(ctx context.Context) (*mode
 // Replaces the existing IAM Policy attached to a resource.
 func (u *groupIAMPolicyUpdater) SetResourceIamPolicy(ctx context.Context, policy *models.HashicorpCloudResourcemanagerPolicy) (*models.HashicorpCloudResourcemanagerPolicy, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	params := resource_service.NewResourceServiceSetIamPolicyParams()
+	params := resource_service.NewResourceServiceSetIamPolicyParamsWithContext(ctx)
 	params.Body = &models.HashicorpCloudResourcemanagerResourceSetIamPolicyRequest{
 		ResourceName: u.resourceName,
 		Policy:       policy,
@@ -119,12 +119,7 @@ func (u *groupIAMPolicyUpdater) SetResourceIamPolicy(ctx context.Context, policy
 
 	res, err := u.client.ResourceService.ResourceServiceSetIamPolicy(params, nil)
 	if err != nil {
-		serviceErr, ok := err.(*resource_service.ResourceServiceSetIamPolicyDefault)
-		if !ok {
-			diags.AddError("failed to cast resource IAM policy error", err.Error())
-			return nil, diags
-		}
-		diags.Append(customdiags.NewErrorHTTPStatusCode("failed to set group IAM policy", err.Error(), serviceErr.Code()))
+		diags.Append(customdiags.NewAPIError("failed to set group IAM policy", err))
 		return nil, diags
 	}
 
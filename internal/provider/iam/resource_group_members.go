@@ -10,12 +10,14 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-iam/stable/2019-12-10/client/groups_service"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 func NewGroupMembersResource() resource.Resource {
@@ -27,15 +29,16 @@ type resourceGroupMembers struct {
 }
 
 type GroupMembers struct {
-	Group   types.String   `tfsdk:"group"`
-	Members []types.String `tfsdk:"members"`
+	Group    types.String   `tfsdk:"group"`
+	Members  []types.String `tfsdk:"members"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceGroupMembers) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_group_members"
 }
 
-func (r *resourceGroupMembers) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceGroupMembers) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: fmt.Sprintf(`The group members resource manages the members of an HCP Group.
 
@@ -53,6 +56,21 @@ The user or service account that is running Terraform when creating an %s resour
 				Description: "A list of user principal IDs to add to the group.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceGroupMembers) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "group",
+				Description: "The resource name of the group.",
+			},
+		},
+		Mutable: true,
 	}
 }
 
@@ -80,6 +98,15 @@ func (r *resourceGroupMembers) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	listParams := groups_service.NewGroupsServiceListGroupMembersParams().WithContext(ctx)
 	listParams.SetResourceName(plan.Group.ValueString())
 	res, err := r.client.Groups.GroupsServiceListGroupMembers(listParams, nil)
@@ -105,7 +132,7 @@ func (r *resourceGroupMembers) Create(ctx context.Context, req resource.CreateRe
 		MemberPrincipalIdsToAdd: members,
 	})
 
-	_, err = clients.UpdateGroupMembersRetry(r.client, updateParams)
+	_, err = clients.UpdateGroupMembers(r.client, updateParams)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update group members", err.Error())
 		return
@@ -121,6 +148,15 @@ func (r *resourceGroupMembers) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	timeout, diags := state.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	listParams := groups_service.NewGroupsServiceListGroupMembersParams().WithContext(ctx)
 	listParams.SetResourceName(state.Group.ValueString())
 	res, err := r.client.Groups.GroupsServiceListGroupMembers(listParams, nil)
@@ -153,6 +189,15 @@ func (r *resourceGroupMembers) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Update(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	planMembers := make(map[string]bool)
 	for _, member := range plan.Members {
 		planMembers[member.ValueString()] = true
@@ -187,7 +232,7 @@ func (r *resourceGroupMembers) Update(ctx context.Context, req resource.UpdateRe
 			MemberPrincipalIdsToRemove: membersToRemove,
 		})
 
-		_, err := clients.UpdateGroupMembersRetry(r.client, updateParams)
+		_, err := clients.UpdateGroupMembers(r.client, updateParams)
 		if err != nil {
 			resp.Diagnostics.AddError("Failed to update group members", err.Error())
 			return
@@ -205,6 +250,15 @@ func (r *resourceGroupMembers) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	timeout, diags := state.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	members := make([]string, len(state.Members))
 	for i, member := range state.Members {
 		members[i] = member.ValueString()
@@ -216,7 +270,7 @@ func (r *resourceGroupMembers) Delete(ctx context.Context, req resource.DeleteRe
 		MemberPrincipalIdsToRemove: members,
 	})
 
-	_, err := clients.UpdateGroupMembersRetry(r.client, updateParams)
+	_, err := clients.UpdateGroupMembers(r.client, updateParams)
 	if err != nil {
 		var errResp *groups_service.GroupsServiceUpdateGroupMembersDefault
 		if errors.As(err, &errResp) && !errResp.IsCode(http.StatusNotFound) {
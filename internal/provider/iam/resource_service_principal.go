@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-iam/stable/2019-12-10/client/service_principals_service"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	clients "github.com/hashicorp/terraform-provider-hcp/internal/clients"
 	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 func NewServicePrincipalResource() resource.Resource {
@@ -37,7 +39,7 @@ func (r *resourceServicePrincipal) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_service_principal"
 }
 
-func (r *resourceServicePrincipal) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceServicePrincipal) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: fmt.Sprintf(`The service principal resource manages a HCP Service Principal.
 
@@ -87,6 +89,21 @@ The user or service account that is running Terraform when creating a %s resourc
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceServicePrincipal) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ResourceName("The resource name of the service principal."),
+		},
 	}
 }
 
@@ -106,10 +123,11 @@ func (r *resourceServicePrincipal) Configure(_ context.Context, req resource.Con
 }
 
 type ServicePrincipal struct {
-	ResourceID   types.String `tfsdk:"resource_id"`
-	ResourceName types.String `tfsdk:"resource_name"`
-	Name         types.String `tfsdk:"name"`
-	Parent       types.String `tfsdk:"parent"`
+	ResourceID   types.String   `tfsdk:"resource_id"`
+	ResourceName types.String   `tfsdk:"resource_name"`
+	Name         types.String   `tfsdk:"name"`
+	Parent       types.String   `tfsdk:"parent"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceServicePrincipal) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -119,12 +137,21 @@ func (r *resourceServicePrincipal) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	parent := plan.Parent.ValueString()
 	if parent == "" {
 		parent = fmt.Sprintf("project/%s", r.client.Config.ProjectID)
 	}
 
-	createParams := service_principals_service.NewServicePrincipalsServiceCreateServicePrincipalParams()
+	createParams := service_principals_service.NewServicePrincipalsServiceCreateServicePrincipalParamsWithContext(ctx)
 	createParams.ParentResourceName = parent
 	createParams.Body = service_principals_service.ServicePrincipalsServiceCreateServicePrincipalBody{
 		Name: plan.Name.ValueString(),
@@ -171,7 +198,16 @@ func (r *resourceServicePrincipal) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	getParams := service_principals_service.NewServicePrincipalsServiceGetServicePrincipalParams()
+	timeout, diags := state.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	getParams := service_principals_service.NewServicePrincipalsServiceGetServicePrincipalParamsWithContext(ctx)
 	getParams.ResourceName = state.ResourceName.ValueString()
 	res, err := r.client.ServicePrincipals.ServicePrincipalsServiceGetServicePrincipal(getParams, nil)
 	if err != nil {
@@ -200,7 +236,11 @@ func (r *resourceServicePrincipal) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *resourceServicePrincipal) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// In-place update is not supported
+	// In-place update is not supported, except for the timeouts which only
+	// exist in the state.
+	var t timeouts.Value
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), t)...)
 }
 
 func (r *resourceServicePrincipal) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -210,7 +250,16 @@ func (r *resourceServicePrincipal) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	deleteParams := service_principals_service.NewServicePrincipalsServiceDeleteServicePrincipalParams()
+	timeout, diags := state.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	deleteParams := service_principals_service.NewServicePrincipalsServiceDeleteServicePrincipalParamsWithContext(ctx)
 	deleteParams.ResourceName = state.ResourceName.ValueString()
 	_, err := r.client.ServicePrincipals.ServicePrincipalsServiceDeleteServicePrincipal(deleteParams, nil)
 	if err != nil {
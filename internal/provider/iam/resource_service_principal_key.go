@@ -12,7 +12,9 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-iam/stable/2019-12-10/client/service_principals_service"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
@@ -21,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	clients "github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 func NewServicePrincipalKeyResource() resource.Resource {
@@ -35,7 +38,7 @@ func (r *resourceServicePrincipalKey) Metadata(_ context.Context, req resource.M
 	resp.TypeName = req.ProviderTypeName + "_service_principal_key"
 }
 
-func (r *resourceServicePrincipalKey) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceServicePrincipalKey) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: fmt.Sprintf(`The service principal key resource manages a service principal key.
 
@@ -89,6 +92,22 @@ The user or service account that is running Terraform when creating a %s resourc
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceServicePrincipalKey) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ResourceName("The resource name of the service principal key."),
+		},
+		ImportUnsupported: true,
 	}
 }
 
@@ -108,11 +127,12 @@ func (r *resourceServicePrincipalKey) Configure(_ context.Context, req resource.
 }
 
 type ServicePrincipalKey struct {
-	ResourceName     types.String `tfsdk:"resource_name"`
-	ClientID         types.String `tfsdk:"client_id"`
-	ClientSecret     types.String `tfsdk:"client_secret"`
-	ServicePrincipal types.String `tfsdk:"service_principal"`
-	RotateTriggers   types.Map    `tfsdk:"rotate_triggers"`
+	ResourceName     types.String   `tfsdk:"resource_name"`
+	ClientID         types.String   `tfsdk:"client_id"`
+	ClientSecret     types.String   `tfsdk:"client_secret"`
+	ServicePrincipal types.String   `tfsdk:"service_principal"`
+	RotateTriggers   types.Map      `tfsdk:"rotate_triggers"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceServicePrincipalKey) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -123,7 +143,16 @@ func (r *resourceServicePrincipalKey) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	createParams := service_principals_service.NewServicePrincipalsServiceCreateServicePrincipalKeyParams()
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	createParams := service_principals_service.NewServicePrincipalsServiceCreateServicePrincipalKeyParamsWithContext(ctx)
 	createParams.ParentResourceName = plan.ServicePrincipal.ValueString()
 	res, err := r.client.ServicePrincipals.ServicePrincipalsServiceCreateServicePrincipalKey(createParams, nil)
 	if err != nil {
@@ -145,7 +174,16 @@ func (r *resourceServicePrincipalKey) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	getParams := service_principals_service.NewServicePrincipalsServiceGetServicePrincipalParams()
+	timeout, diags := state.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	getParams := service_principals_service.NewServicePrincipalsServiceGetServicePrincipalParamsWithContext(ctx)
 	getParams.ResourceName = state.ServicePrincipal.ValueString()
 	res, err := r.client.ServicePrincipals.ServicePrincipalsServiceGetServicePrincipal(getParams, nil)
 	if err != nil {
@@ -175,7 +213,11 @@ func (r *resourceServicePrincipalKey) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *resourceServicePrincipalKey) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// In-place update is not supported
+	// In-place update is not supported, except for the timeouts which only
+	// exist in the state.
+	var t timeouts.Value
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), t)...)
 }
 
 func (r *resourceServicePrincipalKey) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -185,7 +227,16 @@ func (r *resourceServicePrincipalKey) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	deleteParams := service_principals_service.NewServicePrincipalsServiceDeleteServicePrincipalKeyParams()
+	timeout, diags := state.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	deleteParams := service_principals_service.NewServicePrincipalsServiceDeleteServicePrincipalKeyParamsWithContext(ctx)
 	deleteParams.ResourceName2 = state.ResourceName.ValueString()
 	_, err := r.client.ServicePrincipals.ServicePrincipalsServiceDeleteServicePrincipalKey(deleteParams, nil)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	sso "github.com/hashicorp/hcp-sdk-go/clients/cloud-iam/stable/2019-12-10/client/service_principals_service"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-iam/stable/2019-12-10/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -29,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	clients "github.com/hashicorp/terraform-provider-hcp/internal/clients"
 	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 func NewWorkloadIdentityProviderResource() resource.Resource {
@@ -43,7 +45,7 @@ func (r *resourceWorkloadIdentityProvider) Metadata(_ context.Context, req resou
 	resp.TypeName = req.ProviderTypeName + "_iam_workload_identity_provider"
 }
 
-func (r *resourceWorkloadIdentityProvider) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceWorkloadIdentityProvider) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The workload identity provider resource allows federating an external identity to a HCP Service Principal.",
 		Attributes: map[string]schema.Attribute{
@@ -155,6 +157,17 @@ func (r *resourceWorkloadIdentityProvider) Schema(_ context.Context, _ resource.
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceWorkloadIdentityProvider) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ResourceName("The resource name of the workload identity provider."),
+		},
 	}
 }
 
@@ -174,14 +187,15 @@ func (r *resourceWorkloadIdentityProvider) Configure(_ context.Context, req reso
 }
 
 type WorkloadIdentityProvider struct {
-	Name              types.String `tfsdk:"name"`
-	ServicePrincipal  types.String `tfsdk:"service_principal"`
-	Description       types.String `tfsdk:"description"`
-	ConditionalAccess types.String `tfsdk:"conditional_access"`
-	AWS               types.Object `tfsdk:"aws"`
-	OIDC              types.Object `tfsdk:"oidc"`
-	ResourceID        types.String `tfsdk:"resource_id"`
-	ResourceName      types.String `tfsdk:"resource_name"`
+	Name              types.String   `tfsdk:"name"`
+	ServicePrincipal  types.String   `tfsdk:"service_principal"`
+	Description       types.String   `tfsdk:"description"`
+	ConditionalAccess types.String   `tfsdk:"conditional_access"`
+	AWS               types.Object   `tfsdk:"aws"`
+	OIDC              types.Object   `tfsdk:"oidc"`
+	ResourceID        types.String   `tfsdk:"resource_id"`
+	ResourceName      types.String   `tfsdk:"resource_name"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 
 	aws  *AWSProvider  `tfsdk:"-"`
 	oidc *OIDCProvider `tfsdk:"-"`
@@ -276,7 +290,16 @@ func (r *resourceWorkloadIdentityProvider) Create(ctx context.Context, req resou
 		return
 	}
 
-	createParams := sso.NewServicePrincipalsServiceCreateWorkloadIdentityProviderParams()
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	createParams := sso.NewServicePrincipalsServiceCreateWorkloadIdentityProviderParamsWithContext(ctx)
 	createParams.ParentResourceName = plan.ServicePrincipal.ValueString()
 	createParams.Body = sso.ServicePrincipalsServiceCreateWorkloadIdentityProviderBody{
 		Name: plan.Name.ValueString(),
@@ -315,7 +338,16 @@ func (r *resourceWorkloadIdentityProvider) Read(ctx context.Context, req resourc
 		return
 	}
 
-	getParams := sso.NewServicePrincipalsServiceGetWorkloadIdentityProviderParams()
+	timeout, diags := state.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	getParams := sso.NewServicePrincipalsServiceGetWorkloadIdentityProviderParamsWithContext(ctx)
 	getParams.ResourceName2 = state.ResourceName.ValueString()
 	res, err := r.client.ServicePrincipals.ServicePrincipalsServiceGetWorkloadIdentityProvider(getParams, nil)
 	if err != nil {
@@ -339,7 +371,7 @@ func (r *resourceWorkloadIdentityProvider) Update(ctx context.Context, req resou
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(plan.extract(ctx)...)
 
-	updateParams := sso.NewServicePrincipalsServiceUpdateWorkloadIdentityProviderParams()
+	updateParams := sso.NewServicePrincipalsServiceUpdateWorkloadIdentityProviderParamsWithContext(ctx)
 	updateParams.ProviderResourceName = plan.ResourceName.ValueString()
 	updateParams.Provider = sso.ServicePrincipalsServiceUpdateWorkloadIdentityProviderBody{
 		ConditionalAccess: plan.ConditionalAccess.ValueString(),
@@ -363,6 +395,15 @@ func (r *resourceWorkloadIdentityProvider) Update(ctx context.Context, req resou
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Update(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	wip := res.GetPayload().Provider
 	resp.Diagnostics.Append(plan.fromModel(ctx, wip)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -375,7 +416,16 @@ func (r *resourceWorkloadIdentityProvider) Delete(ctx context.Context, req resou
 		return
 	}
 
-	deleteParams := sso.NewServicePrincipalsServiceDeleteWorkloadIdentityProviderParams()
+	timeout, diags := state.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	deleteParams := sso.NewServicePrincipalsServiceDeleteWorkloadIdentityProviderParamsWithContext(ctx)
 	deleteParams.ResourceName4 = state.ResourceName.ValueString()
 	_, err := r.client.ServicePrincipals.ServicePrincipalsServiceDeleteWorkloadIdentityProvider(deleteParams, nil)
 	if err != nil {
@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-log-service/preview/2021-03-30/client/log_service"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-log-service/preview/2021-03-30/client/streaming_service"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-log-service/preview/2021-03-30/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -23,10 +25,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 const TFProviderSourceChannel = "TERRAFORM"
@@ -43,7 +47,7 @@ func (r *resourceHCPLogStreamingDestination) Metadata(_ context.Context, req res
 	resp.TypeName = req.ProviderTypeName + "_log_streaming_destination"
 }
 
-func (r *resourceHCPLogStreamingDestination) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceHCPLogStreamingDestination) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Streaming Destination resource allows users to configure an external log system to stream HCP logs to.",
 		Attributes: map[string]schema.Attribute{
@@ -71,9 +75,25 @@ func (r *resourceHCPLogStreamingDestination) Schema(_ context.Context, _ resourc
 						Required:    true,
 					},
 					"token": schema.StringAttribute{
-						Description: "The authentication token that will be used by the platform to access Splunk Cloud.",
-						Required:    true,
+						Description: "The authentication token that will be used by the platform to access Splunk Cloud. Exactly one of `token` or `token_wo` must be set.",
+						Optional:    true,
 						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("token_wo")),
+						},
+					},
+					"token_wo": schema.StringAttribute{
+						Description: "The authentication token that will be used by the platform to access Splunk Cloud. This value is write-only and is not stored in the Terraform state. Requires Terraform 1.11 or later.",
+						Optional:    true,
+						Sensitive:   true,
+						WriteOnly:   true,
+					},
+					"token_wo_version": schema.Int64Attribute{
+						Description: "Version of the `token_wo` value. Terraform cannot detect changes to write-only values, change this version to update the token.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("token_wo")),
+						},
 					},
 				},
 				PlanModifiers: []planmodifier.Object{
@@ -128,9 +148,25 @@ func (r *resourceHCPLogStreamingDestination) Schema(_ context.Context, _ resourc
 						Required:    true,
 					},
 					"api_key": schema.StringAttribute{
-						Description: "The value for the DD-API-KEY to send when making requests to DataDog.",
-						Required:    true,
+						Description: "The value for the DD-API-KEY to send when making requests to DataDog. Exactly one of `api_key` or `api_key_wo` must be set.",
+						Optional:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("api_key_wo")),
+						},
+					},
+					"api_key_wo": schema.StringAttribute{
+						Description: "The value for the DD-API-KEY to send when making requests to DataDog. This value is write-only and is not stored in the Terraform state. Requires Terraform 1.11 or later.",
+						Optional:    true,
 						Sensitive:   true,
+						WriteOnly:   true,
+					},
+					"api_key_wo_version": schema.Int64Attribute{
+						Description: "Version of the `api_key_wo` value. Terraform cannot detect changes to write-only values, change this version to update the API key.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("api_key_wo")),
+						},
 					},
 					"application_key": schema.StringAttribute{
 						Description: "The value for the DD-APPLICATION-KEY to send when making requests to DataDog.",
@@ -151,6 +187,21 @@ func (r *resourceHCPLogStreamingDestination) Schema(_ context.Context, _ resourc
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceHCPLogStreamingDestination) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			{
+				Name:        "streaming_destination_id",
+				Description: "The ID of the log streaming destination.",
+			},
+		},
+		ImportUnsupported: true,
 	}
 }
 
@@ -170,11 +221,12 @@ func (r *resourceHCPLogStreamingDestination) Configure(_ context.Context, req re
 }
 
 type HCPLogStreamingDestination struct {
-	Name                   types.String `tfsdk:"name"`
-	StreamingDestinationID types.String `tfsdk:"streaming_destination_id"`
-	SplunkCloud            types.Object `tfsdk:"splunk_cloud"`
-	CloudWatch             types.Object `tfsdk:"cloudwatch"`
-	Datadog                types.Object `tfsdk:"datadog"`
+	Name                   types.String   `tfsdk:"name"`
+	StreamingDestinationID types.String   `tfsdk:"streaming_destination_id"`
+	SplunkCloud            types.Object   `tfsdk:"splunk_cloud"`
+	CloudWatch             types.Object   `tfsdk:"cloudwatch"`
+	Datadog                types.Object   `tfsdk:"datadog"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 
 	splunkCloud *SplunkCloudProvider `tfsdk:"-"`
 	cloudwatch  *CloudWatchProvider  `tfsdk:"-"`
@@ -182,28 +234,36 @@ type HCPLogStreamingDestination struct {
 }
 
 type DataDogProvider struct {
-	Endpoint       types.String `tfsdk:"endpoint"`
-	APIKey         types.String `tfsdk:"api_key"`
-	ApplicationKey types.String `tfsdk:"application_key"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	APIKey          types.String `tfsdk:"api_key"`
+	APIKeyWO        types.String `tfsdk:"api_key_wo"`
+	APIKeyWOVersion types.Int64  `tfsdk:"api_key_wo_version"`
+	ApplicationKey  types.String `tfsdk:"application_key"`
 }
 
 func (d DataDogProvider) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"endpoint":        types.StringType,
-		"api_key":         types.StringType,
-		"application_key": types.StringType,
+		"endpoint":           types.StringType,
+		"api_key":            types.StringType,
+		"api_key_wo":         types.StringType,
+		"api_key_wo_version": types.Int64Type,
+		"application_key":    types.StringType,
 	}
 }
 
 type SplunkCloudProvider struct {
-	HecEndpoint types.String `tfsdk:"endpoint"`
-	Token       types.String `tfsdk:"token"`
+	HecEndpoint    types.String `tfsdk:"endpoint"`
+	Token          types.String `tfsdk:"token"`
+	TokenWO        types.String `tfsdk:"token_wo"`
+	TokenWOVersion types.Int64  `tfsdk:"token_wo_version"`
 }
 
 func (s SplunkCloudProvider) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"endpoint": types.StringType,
-		"token":    types.StringType,
+		"endpoint":         types.StringType,
+		"token":            types.StringType,
+		"token_wo":         types.StringType,
+		"token_wo_version": types.Int64Type,
 	}
 }
 
@@ -245,6 +305,30 @@ func (h *HCPLogStreamingDestination) extract(ctx context.Context) diag.Diagnosti
 	return diags
 }
 
+// extractWriteOnly extracts the write-only values from the configuration, as
+// Terraform does not include them in the plan. It must be called after extract.
+func (h *HCPLogStreamingDestination) extractWriteOnly(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if h.splunkCloud != nil {
+		var tokenWO types.String
+		diags.Append(config.GetAttribute(ctx, path.Root("splunk_cloud").AtName("token_wo"), &tokenWO)...)
+		if !tokenWO.IsNull() {
+			h.splunkCloud.Token = tokenWO
+		}
+	}
+
+	if h.datadog != nil {
+		var apiKeyWO types.String
+		diags.Append(config.GetAttribute(ctx, path.Root("datadog").AtName("api_key_wo"), &apiKeyWO)...)
+		if !apiKeyWO.IsNull() {
+			h.datadog.APIKey = apiKeyWO
+		}
+	}
+
+	return diags
+}
+
 // fromModel encodes the values from a Log Streaming Destination model into the
 // Terraform values, such that they can be saved to state.
 func (h *HCPLogStreamingDestination) fromModel(ctx context.Context, logSD *models.LogService20210330StreamingDestination) diag.Diagnostics {
@@ -269,8 +353,10 @@ func (h *HCPLogStreamingDestination) fromModel(ctx context.Context, logSD *model
 		}
 
 		h.SplunkCloud = types.ObjectValueMust(h.SplunkCloud.AttributeTypes(ctx), map[string]attr.Value{
-			"endpoint": types.StringValue(logSD.SplunkCloudProvider.HecEndpoint),
-			"token":    splunkState.Token,
+			"endpoint":         types.StringValue(logSD.SplunkCloudProvider.HecEndpoint),
+			"token":            splunkState.Token,
+			"token_wo":         types.StringNull(),
+			"token_wo_version": splunkState.TokenWOVersion,
 		})
 	}
 
@@ -292,9 +378,11 @@ func (h *HCPLogStreamingDestination) fromModel(ctx context.Context, logSD *model
 		}
 
 		h.Datadog = types.ObjectValueMust(h.Datadog.AttributeTypes(ctx), map[string]attr.Value{
-			"endpoint":        types.StringValue(logSD.DatadogProvider.Endpoint),
-			"api_key":         dataDogState.APIKey,
-			"application_key": applicationKeyValue,
+			"endpoint":           types.StringValue(logSD.DatadogProvider.Endpoint),
+			"api_key":            dataDogState.APIKey,
+			"api_key_wo":         types.StringNull(),
+			"api_key_wo_version": dataDogState.APIKeyWOVersion,
+			"application_key":    applicationKeyValue,
 		})
 	}
 
@@ -305,10 +393,20 @@ func (r *resourceHCPLogStreamingDestination) Create(ctx context.Context, req res
 	var plan HCPLogStreamingDestination
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(plan.extract(ctx)...)
+	resp.Diagnostics.Append(plan.extractWriteOnly(ctx, req.Config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	orgID := r.client.Config.OrganizationID
 
 	createParams := streaming_service.NewStreamingServiceCreateDestinationParams()
@@ -397,6 +495,15 @@ func (r *resourceHCPLogStreamingDestination) Read(ctx context.Context, req resou
 		return
 	}
 
+	timeout, diags := state.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	orgID := r.client.Config.OrganizationID
 
 	res, err := clients.GetLogStreamingDestination(ctx, r.client, orgID, state.StreamingDestinationID.ValueString())
@@ -420,12 +527,22 @@ func (r *resourceHCPLogStreamingDestination) Update(ctx context.Context, req res
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	resp.Diagnostics.Append(plan.extract(ctx)...)
+	resp.Diagnostics.Append(plan.extractWriteOnly(ctx, req.Config)...)
 	resp.Diagnostics.Append(state.extract(ctx)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Update(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	var fieldMaskPaths []string
 	destination := &models.LogService20210330StreamingDestination{
 		OrganizationID: r.client.Config.OrganizationID,
@@ -514,6 +631,15 @@ func (r *resourceHCPLogStreamingDestination) Delete(ctx context.Context, req res
 		return
 	}
 
+	timeout, diags := state.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	err := clients.DeleteLogStreamingDestination(ctx, r.client, r.client.Config.OrganizationID, state.StreamingDestinationID.ValueString())
 	if err != nil {
 		var getErr *log_service.LogServiceGetStreamingDestinationDefault
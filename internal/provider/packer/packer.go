@@ -5,6 +5,7 @@ package packer
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/datasources/artifact"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer/datasources/version"
@@ -25,3 +26,10 @@ var DataSourceSchemaBuilders []func() datasource.DataSource = []func() datasourc
 	version.NewDataSource,
 	artifact.NewDataSource,
 }
+
+// ListResourceSchemaBuilders is a list of all HCP Packer list resources exposed
+// by the Framework provider. To add a new list resource, add a new function to
+// this list.
+var ListResourceSchemaBuilders []func() list.ListResource = []func() list.ListResource{
+	bucket.NewPackerBucketListResource,
+}
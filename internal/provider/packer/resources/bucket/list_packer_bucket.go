@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bucket
+
+import (
+	"context"
+	"fmt"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients/packerv2"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
+)
+
+// NewPackerBucketListResource returns the list resource of the buckets of
+// the HCP Packer registry of a project.
+func NewPackerBucketListResource() list.ListResource {
+	return identity.NewListResource(NewPackerBucketResource(), schema.Schema{
+		MarkdownDescription: "Lists the buckets of the HCP Packer registry of a project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": identity.ProjectIDListAttribute(),
+		},
+	}, listPackerBuckets)
+}
+
+func listPackerBuckets(ctx context.Context, client *clients.Client, config tfsdk.Config) ([]identity.ListResult, diag.Diagnostics) {
+	projectID, diags := identity.ListProjectID(ctx, client, config)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: client.Config.OrganizationID,
+		ProjectID:      projectID,
+	}
+	buckets, err := packerv2.ListBuckets(ctx, client, loc)
+	if err != nil {
+		diags.AddError("Unable to list Packer buckets", fmt.Sprintf("Unable to list the buckets of project %q: %v", projectID, err))
+		return nil, diags
+	}
+
+	results := make([]identity.ListResult, 0, len(buckets))
+	for _, bucket := range buckets {
+		results = append(results, identity.ListResult{
+			DisplayName: bucket.Name,
+			Identity: map[string]string{
+				"project_id": projectID,
+				"name":       bucket.Name,
+			},
+		})
+	}
+	return results, diags
+}
@@ -13,6 +13,7 @@ import (
 
 	packerservice "github.com/hashicorp/hcp-sdk-go/clients/cloud-packer-service/stable/2023-01-01/client/packer_service"
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients/packerv2"
 	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 )
 
@@ -31,6 +33,7 @@ var _ resource.Resource = &resourcePackerBucket{}
 var _ resource.ResourceWithImportState = &resourcePackerBucket{}
 var _ resource.ResourceWithConfigure = &resourcePackerBucket{}
 var _ resource.ResourceWithModifyPlan = &resourcePackerBucket{}
+var _ identity.ResourceWithIdentity = &resourcePackerBucket{}
 
 func NewPackerBucketResource() resource.Resource {
 	return &resourcePackerBucket{}
@@ -44,7 +47,7 @@ func (r *resourcePackerBucket) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_packer_bucket"
 }
 
-func (r *resourcePackerBucket) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourcePackerBucket) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "The Packer Bucket resource allows you to manage a bucket within an active HCP Packer Registry.",
 
@@ -86,14 +89,31 @@ func (r *resourcePackerBucket) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
-// This function is required by the interface but should be unreachable
-func (r *resourcePackerBucket) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// In-place update is not supported, the bucket must be re-created to change any user modifable fields
-	resp.Diagnostics.AddError("Unexpected provider error", "This is an internal error, please report this issue to the provider developers")
+func (r *resourcePackerBucket) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			identity.Name("The name of the bucket."),
+		},
+	}
+}
 
+// Update is only reachable if the timeouts changed, as all other user modifiable
+// fields require the bucket to be re-created.
+func (r *resourcePackerBucket) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var t timeouts.Value
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), t)...)
 }
 
 func (r *resourcePackerBucket) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -120,11 +140,12 @@ func (r *resourcePackerBucket) ModifyPlan(ctx context.Context, req resource.Modi
 }
 
 type bucket struct {
-	ProjectID      types.String `tfsdk:"project_id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
-	Name           types.String `tfsdk:"name"`
-	ResourceName   types.String `tfsdk:"resource_name"`
-	CreatedAt      types.String `tfsdk:"created_at"`
+	ProjectID      types.String   `tfsdk:"project_id"`
+	OrganizationID types.String   `tfsdk:"organization_id"`
+	Name           types.String   `tfsdk:"name"`
+	ResourceName   types.String   `tfsdk:"resource_name"`
+	CreatedAt      types.String   `tfsdk:"created_at"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourcePackerBucket) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -136,6 +157,15 @@ func (r *resourcePackerBucket) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !plan.ProjectID.IsUnknown() {
 		projectID = plan.ProjectID.ValueString()
@@ -171,7 +201,16 @@ func (r *resourcePackerBucket) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	params := packerservice.NewPackerServiceGetBucketParams()
+	timeout, diags := state.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	params := packerservice.NewPackerServiceGetBucketParamsWithContext(ctx)
 	params.SetLocationOrganizationID(state.OrganizationID.ValueString())
 	params.SetLocationProjectID(state.ProjectID.ValueString())
 
@@ -204,7 +243,17 @@ func (r *resourcePackerBucket) Delete(ctx context.Context, req resource.DeleteRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	params := packerservice.NewPackerServiceDeleteBucketParams()
+
+	timeout, diags := state.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	params := packerservice.NewPackerServiceDeleteBucketParamsWithContext(ctx)
 	params.SetLocationOrganizationID(state.OrganizationID.ValueString())
 	params.SetLocationProjectID(state.ProjectID.ValueString())
 	params.SetBucketName(state.Name.ValueString())
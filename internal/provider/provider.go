@@ -6,27 +6,42 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"regexp"
+	"sync/atomic"
+	"time"
 
-	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/project_service"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 
+	hcpConfig "github.com/hashicorp/hcp-sdk-go/config"
 	"github.com/hashicorp/hcp-sdk-go/config/geography"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/consul"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/functions"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/iam"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/logstreaming"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/packer"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/resourcemanager"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/vault"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/vaultradar"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/vaultsecrets"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/waypoint"
@@ -40,16 +55,53 @@ import (
 // providersdkv2 folder at the same level
 type ProviderFramework struct {
 	version string
+
+	// hcpConfigOptions are passed on to the HCP client, see
+	// clients.ClientConfig.HCPConfigOptions.
+	hcpConfigOptions []hcpConfig.HCPConfigOption
+
+	// wrapTransport is passed on to the HCP client, see
+	// clients.ClientConfig.WrapTransport.
+	wrapTransport func(http.RoundTripper) http.RoundTripper
+
+	// client is the client the provider was configured with, see Client.
+	client atomic.Pointer[clients.Client]
 }
 
+var _ provider.ProviderWithEphemeralResources = &ProviderFramework{}
+var _ provider.ProviderWithListResources = &ProviderFramework{}
+var _ provider.ProviderWithFunctions = &ProviderFramework{}
+var _ provider.ProviderWithMetaSchema = &ProviderFramework{}
+
 type ProviderFrameworkModel struct {
-	ClientSecret     types.String `tfsdk:"client_secret"`
-	ClientID         types.String `tfsdk:"client_id"`
-	CredentialFile   types.String `tfsdk:"credential_file"`
-	ProjectID        types.String `tfsdk:"project_id"`
-	WorkloadIdentity types.List   `tfsdk:"workload_identity"`
-	SkipStatusCheck  types.Bool   `tfsdk:"skip_status_check"`
-	Geography        types.String `tfsdk:"geography"`
+	ClientSecret       types.String `tfsdk:"client_secret"`
+	ClientID           types.String `tfsdk:"client_id"`
+	CredentialFile     types.String `tfsdk:"credential_file"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	Profile            types.String `tfsdk:"profile"`
+	TokenCacheDir      types.String `tfsdk:"token_cache_dir"`
+	TokenCacheKey      types.String `tfsdk:"token_cache_key"`
+	WorkloadIdentity   types.List   `tfsdk:"workload_identity"`
+	SkipStatusCheck    types.Bool   `tfsdk:"skip_status_check"`
+	StatusPageURL      types.String `tfsdk:"status_page_url"`
+	StatusCheckTimeout types.Int64  `tfsdk:"status_check_timeout"`
+	Geography          types.String `tfsdk:"geography"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	RateLimits         types.List   `tfsdk:"rate_limits"`
+}
+
+type RateLimitsFrameworkModel struct {
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	Burst                 types.Int64   `tfsdk:"burst"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	Service               types.List    `tfsdk:"service"`
+}
+
+type ServiceRateLimitFrameworkModel struct {
+	Name                  types.String  `tfsdk:"name"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	Burst                 types.Int64   `tfsdk:"burst"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
 }
 
 type WorkloadIdentityFrameworkModel struct {
@@ -86,6 +138,24 @@ func (p *ProviderFramework) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:    true,
 				Description: "The default project in which resources should be created.",
 			},
+			"profile": schema.StringAttribute{
+				Optional: true,
+				Description: "The name of the HCP CLI profile to use. The project of the profile is used if `project_id` isn't set, " +
+					"and the login cached by the HCP CLI if no other credentials are configured. " +
+					"You can alternatively set the HCP_PROFILE environment variable.",
+			},
+			"token_cache_dir": schema.StringAttribute{
+				Optional: true,
+				Description: "The directory in which the tokens of service principals and workload identities are cached, encrypted with " +
+					"`token_cache_key`, such that provider runs with the same credentials reuse them instead of exchanging new ones. " +
+					"You can alternatively set the HCP_TOKEN_CACHE_DIR environment variable. Tokens are not cached if unset.",
+			},
+			"token_cache_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "The key the tokens in the `token_cache_dir` are encrypted with, e.g. a long random string. " +
+					"Required if `token_cache_dir` is set. You can alternatively set the HCP_TOKEN_CACHE_KEY environment variable.",
+			},
 			"credential_file": schema.StringAttribute{
 				Optional: true,
 				Description: "The path to an HCP credential file to use to authenticate the provider to HCP. " +
@@ -100,6 +170,19 @@ func (p *ProviderFramework) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:    true,
 				Description: "When set to true, the provider will skip checking the HCP status page for service outages or returning warnings.",
 			},
+			"status_page_url": schema.StringAttribute{
+				Optional: true,
+				Description: "The URL of the HCP status page summary that is checked for service outages, e.g. a mirror reachable from an air-gapped network. " +
+					"You can alternatively set the HCP_STATUS_PAGE_URL environment variable. Default is the status page of the `geography`.",
+			},
+			"status_check_timeout": schema.Int64Attribute{
+				Optional: true,
+				Description: "The timeout, in seconds, of the request to the HCP status page. " +
+					"You can alternatively set the HCP_STATUS_CHECK_TIMEOUT environment variable. Default is `1`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"geography": schema.StringAttribute{
 				Optional:    true,
 				Description: "The geography in which HCP resources should be created. Default is `us`.",
@@ -107,6 +190,14 @@ func (p *ProviderFramework) Schema(ctx context.Context, req provider.SchemaReque
 					stringvalidator.OneOf(string(geography.US), string(geography.EU)),
 				},
 			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Description: "The maximum number of times a request to the HCP API is retried if it fails with a transient error, " +
+					"such as a rate limit or a temporarily unavailable service. Set to `0` to disable retries. Default is `5`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			// TODO migrate to SingleNestedAttribute once the providersdkv2 is
@@ -147,12 +238,86 @@ func (p *ProviderFramework) Schema(ctx context.Context, req provider.SchemaReque
 					listvalidator.SizeBetween(1, 1),
 				},
 			},
+			"rate_limits": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: rateLimitAttributes(),
+					Blocks: map[string]schema.Block{
+						"service": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: withServiceName(rateLimitAttributes()),
+							},
+							Description: "Overrides the limits for requests to a single HCP service. " +
+								"Arguments that are not set default to the limits of the enclosing `rate_limits` block.",
+						},
+					},
+				},
+				Description: "Limits the rate and the concurrency of requests the provider sends to each HCP service. " +
+					"The limits apply to every service separately, unless overridden in a `service` block.",
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 1),
+				},
+			},
+		},
+	}
+}
+
+// rateLimitAttributes returns the attributes configuring the limits of a
+// service in the rate_limits block.
+func rateLimitAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"requests_per_second": schema.Float64Attribute{
+			Optional:    true,
+			Description: "The sustained number of requests per second sent to the service. Rate limiting is disabled if unset.",
+			Validators: []validator.Float64{
+				float64validator.AtLeast(0),
+			},
+		},
+		"burst": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The number of requests that may be sent at once before `requests_per_second` applies. Default is `1`.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"max_concurrent_requests": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The maximum number of requests to the service that may be in flight at once. Concurrency is not limited if unset.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+}
+
+// withServiceName adds the name of the service to the passed rate limit
+// attributes.
+func withServiceName(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["name"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The name of the service. Valid values are " + clients.RateLimitServicesMarkdown() + ".",
+		Validators: []validator.String{
+			stringvalidator.OneOf(clients.RateLimitServices...),
+		},
+	}
+	return attributes
+}
+
+// MetaSchema is the schema of the provider_meta block of modules, it has to
+// match the one of the SDKv2 provider. The module name is read from the
+// requests by clients.NewProviderServer.
+func (p *ProviderFramework) MetaSchema(ctx context.Context, req provider.MetaSchemaRequest, resp *provider.MetaSchemaResponse) {
+	resp.Schema = metaschema.Schema{
+		Attributes: map[string]metaschema.Attribute{
+			"module_name": metaschema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the module used with the provider. Should be set in the terraform config block of the module.",
+			},
 		},
 	}
 }
 
 func (p *ProviderFramework) Resources(ctx context.Context) []func() resource.Resource {
-	return append([]func() resource.Resource{
+	return identity.Resources(append([]func() resource.Resource{
 		// Resource Manager
 		resourcemanager.NewOrganizationIAMPolicyResource,
 		resourcemanager.NewOrganizationIAMBindingResource,
@@ -164,6 +329,7 @@ func (p *ProviderFramework) Resources(ctx context.Context) []func() resource.Res
 		// Vault Secrets
 		vaultsecrets.NewVaultSecretsAppResource,
 		vaultsecrets.NewVaultSecretsSecretResource,
+		vaultsecrets.NewVaultSecretsAppSecretsResource,
 		vaultsecrets.NewVaultSecretsAppIAMPolicyResource,
 		vaultsecrets.NewVaultSecretsAppIAMBindingResource,
 		vaultsecrets.NewVaultSecretsIntegrationResource,
@@ -207,7 +373,7 @@ func (p *ProviderFramework) Resources(ctx context.Context) []func() resource.Res
 		vaultradar.NewRadarResourceIAMPolicyResource,
 		vaultradar.NewRadarResourceIAMBindingResource,
 		vaultradar.NewRadarSecretManagerVaultDedicatedResource,
-	}, packer.ResourceSchemaBuilders...)
+	}, packer.ResourceSchemaBuilders...))
 }
 
 func (p *ProviderFramework) DataSources(ctx context.Context) []func() datasource.DataSource {
@@ -219,6 +385,7 @@ func (p *ProviderFramework) DataSources(ctx context.Context) []func() datasource
 		// Vault Secrets
 		vaultsecrets.NewVaultSecretsAppDataSource,
 		vaultsecrets.NewVaultSecretsSecretDataSource,
+		vaultsecrets.NewVaultSecretsSecretVersionsDataSource,
 		vaultsecrets.NewVaultSecretsRotatingSecretDataSource,
 		vaultsecrets.NewVaultSecretsDynamicSecretDataSource,
 		// IAM
@@ -237,6 +404,43 @@ func (p *ProviderFramework) DataSources(ctx context.Context) []func() datasource
 	}, packer.DataSourceSchemaBuilders...)
 }
 
+func (p *ProviderFramework) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		// Consul
+		consul.NewConsulClusterRootTokenEphemeralResource,
+		// Vault
+		vault.NewVaultClusterAdminTokenEphemeralResource,
+		// Vault Secrets
+		vaultsecrets.NewVaultSecretsAppEphemeralResource,
+		vaultsecrets.NewVaultSecretsSecretEphemeralResource,
+	}
+}
+
+func (p *ProviderFramework) ListResources(ctx context.Context) []func() list.ListResource {
+	return append([]func() list.ListResource{
+		// Resource Manager
+		resourcemanager.NewProjectListResource,
+		// Vault Secrets
+		vaultsecrets.NewVaultSecretsAppListResource,
+		vaultsecrets.NewVaultSecretsSecretListResource,
+		// IAM
+		iam.NewServicePrincipalListResource,
+		iam.NewGroupListResource,
+		// Waypoint
+		waypoint.NewApplicationListResource,
+		waypoint.NewTemplateListResource,
+	}, packer.ListResourceSchemaBuilders...)
+}
+
+func (p *ProviderFramework) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewParseResourceNameFunction,
+		functions.NewBuildResourceNameFunction,
+		functions.NewProjectFromResourceNameFunction,
+		functions.NewParseLinkFunction,
+	}
+}
+
 func NewFrameworkProvider(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &ProviderFramework{
@@ -245,26 +449,90 @@ func NewFrameworkProvider(version string) func() provider.Provider {
 	}
 }
 
+// NewFrameworkProviderWithHCPConfigOptions returns a provider whose HCP client
+// is created with the passed options. It allows tests to run the provider
+// against a fake HCP API.
+func NewFrameworkProviderWithHCPConfigOptions(version string, opts ...hcpConfig.HCPConfigOption) func() provider.Provider {
+	return func() provider.Provider {
+		return &ProviderFramework{
+			version:          version,
+			hcpConfigOptions: opts,
+		}
+	}
+}
+
+// NewFrameworkProviderWithTransport returns a provider whose HCP client sends
+// all requests through the transport returned by wrap, and is created with the
+// passed options. It allows tests to record and replay the requests of the
+// provider.
+func NewFrameworkProviderWithTransport(version string, wrap func(http.RoundTripper) http.RoundTripper, opts ...hcpConfig.HCPConfigOption) func() provider.Provider {
+	return func() provider.Provider {
+		return &ProviderFramework{
+			version:          version,
+			hcpConfigOptions: opts,
+			wrapTransport:    wrap,
+		}
+	}
+}
+
+// NewProtocol6 returns a protocol 6 server for the provider, that resolves
+// the HCP client of the provider once resources use it, see
+// clients.NewProviderServer.
+func NewProtocol6(p provider.Provider) func() tfprotov6.ProviderServer {
+	server := providerserver.NewProtocol6(p)
+
+	pf, ok := p.(*ProviderFramework)
+	if !ok {
+		return server
+	}
+	return clients.NewProviderServer(server, pf.Client)
+}
+
+// Client returns the client the provider was configured with, or nil if it
+// isn't configured yet. The client may not be resolved, see
+// clients.NewDeferredClient.
+func (p *ProviderFramework) Client() *clients.Client {
+	return p.client.Load()
+}
+
 func (p *ProviderFramework) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	// Sets up HCP SDK client.
 	var data ProviderFrameworkModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	clientConfig := clients.ClientConfig{
-		ClientID:       data.ClientID.ValueString(),
-		ClientSecret:   data.ClientSecret.ValueString(),
-		CredentialFile: data.CredentialFile.ValueString(),
-		ProjectID:      data.ProjectID.ValueString(),
-		SourceChannel:  "terraform-provider-hcp",
-		Geography:      data.Geography.ValueString(),
+		ClientID:         data.ClientID.ValueString(),
+		ClientSecret:     data.ClientSecret.ValueString(),
+		CredentialFile:   data.CredentialFile.ValueString(),
+		ProjectID:        data.ProjectID.ValueString(),
+		Profile:          data.Profile.ValueString(),
+		TokenCacheDir:    data.TokenCacheDir.ValueString(),
+		TokenCacheKey:    data.TokenCacheKey.ValueString(),
+		SourceChannel:    "terraform-provider-hcp",
+		Geography:        data.Geography.ValueString(),
+		MaxRetries:       clients.DefaultMaxRetries,
+		HCPConfigOptions: p.hcpConfigOptions,
+		WrapTransport:    p.wrapTransport,
+	}
+	if !data.MaxRetries.IsNull() {
+		clientConfig.MaxRetries = int(data.MaxRetries.ValueInt64())
 	}
 
 	// Determine if status check should be skipped via provider configuration or environment variable.
 	// Previously, skipping depended on the value of HCP_API_HOST but is now controlled explicitly by users.
 	skipStatusCheck := data.SkipStatusCheck.ValueBool() || os.Getenv("HCP_SKIP_STATUS_CHECK") == "true"
 	if !skipStatusCheck {
-		// This helper verifies HCP's status and returns a warning for degraded performance.
-		resp.Diagnostics.Append(statuspage.IsHCPOperationalFramework(clientConfig.Geography)...)
+		// This helper fetches HCP's status, incidents are reported as warnings
+		// once resources of the affected services are used.
+		err := statuspage.Check(statuspage.Options{
+			Geography: clientConfig.Geography,
+			URL:       data.StatusPageURL.ValueString(),
+			Timeout:   time.Duration(data.StatusCheckTimeout.ValueInt64()) * time.Second,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid status check configuration", err.Error())
+			return
+		}
 	}
 
 	// Read the workload_identity configuration.
@@ -283,10 +551,20 @@ func (p *ProviderFramework) Configure(ctx context.Context, req provider.Configur
 		}
 	}
 
-	client, err := clients.NewClient(clientConfig)
-	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("unable to create HCP api client: %v", err), "")
-		return
+	// Read the rate_limits configuration.
+	if len(data.RateLimits.Elements()) == 1 {
+		elements := make([]RateLimitsFrameworkModel, 0, 1)
+		resp.Diagnostics.Append(data.RateLimits.ElementsAs(ctx, &elements, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var diags diag.Diagnostics
+		clientConfig, diags = readRateLimits(ctx, elements[0], clientConfig)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
 	}
 
 	// Attempt to source from the environment if unset.
@@ -294,40 +572,19 @@ func (p *ProviderFramework) Configure(ctx context.Context, req provider.Configur
 		clientConfig.ProjectID = os.Getenv("HCP_PROJECT_ID")
 	}
 
-	if clientConfig.ProjectID != "" {
-		getProjParams := project_service.NewProjectServiceGetParams()
-		getProjParams.ID = clientConfig.ProjectID
-		project, err := clients.RetryProjectServiceGet(client, getProjParams)
-		if err != nil {
-			resp.Diagnostics.AddError(fmt.Sprintf("unable to fetch project %q: %v", clientConfig.ProjectID, err), "")
-			return
-		}
-
-		client.Config.ProjectID = project.Payload.Project.ID
-		client.Config.OrganizationID = project.Payload.Project.Parent.ID
-
-	} else {
-		// For the initial release of the HCP TFP, since only one project was allowed per organization at the time,
-		// the provider handled used the single organization's single project by default, instead of requiring the
-		// user to set it. Once multiple projects are available, this helper issues a warning: when multiple projects exist within the org,
-		// a project ID should be set on the provider or on each resource. Otherwise, the oldest project will be used by default.
-		// This helper will eventually be deprecated after a migration period.
-		project, projDiags := getProjectFromCredentialsFramework(ctx, client)
-		if projDiags != nil {
-			if !projDiags.HasError() {
-				resp.Diagnostics.Append(projDiags...)
-			} else {
-				resp.Diagnostics.AddError("unable to get project from credentials", "")
-				return
-			}
-		}
-
-		client.Config.OrganizationID = project.Parent.ID
-		client.Config.ProjectID = project.ID
+	// The client is only resolved once resources use it, such that the
+	// provider can validate configurations without network access.
+	client, err := clients.NewDeferredClient(clientConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("unable to create HCP api client: %v", err), "")
+		return
 	}
+	p.client.Store(client)
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
 }
 
 func readWorkloadIdentity(model WorkloadIdentityFrameworkModel, clientConfig clients.ClientConfig) (clients.ClientConfig, diag.Diagnostics) {
@@ -343,3 +600,36 @@ func readWorkloadIdentity(model WorkloadIdentityFrameworkModel, clientConfig cli
 	}
 	return clientConfig, diags
 }
+
+func readRateLimits(ctx context.Context, model RateLimitsFrameworkModel, clientConfig clients.ClientConfig) (clients.ClientConfig, diag.Diagnostics) {
+	services := make([]ServiceRateLimitFrameworkModel, 0, len(model.Service.Elements()))
+	diags := model.Service.ElementsAs(ctx, &services, false)
+	if diags.HasError() {
+		return clientConfig, diags
+	}
+
+	serviceConfigs := make([]clients.ServiceRateLimitConfig, 0, len(services))
+	for _, service := range services {
+		serviceConfigs = append(serviceConfigs, clients.ServiceRateLimitConfig{
+			Name: service.Name.ValueString(),
+			RateLimitConfig: clients.RateLimitConfig{
+				RequestsPerSecond:     service.RequestsPerSecond.ValueFloat64Pointer(),
+				Burst:                 service.Burst.ValueInt64Pointer(),
+				MaxConcurrentRequests: service.MaxConcurrentRequests.ValueInt64Pointer(),
+			},
+		})
+	}
+
+	rateLimits, err := clients.NewRateLimits(clients.RateLimitConfig{
+		RequestsPerSecond:     model.RequestsPerSecond.ValueFloat64Pointer(),
+		Burst:                 model.Burst.ValueInt64Pointer(),
+		MaxConcurrentRequests: model.MaxConcurrentRequests.ValueInt64Pointer(),
+	}, serviceConfigs)
+	if err != nil {
+		diags.AddError("invalid rate_limits", err.Error())
+		return clientConfig, diags
+	}
+	clientConfig.RateLimits = rateLimits
+
+	return clientConfig, diags
+}
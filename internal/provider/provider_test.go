@@ -4,13 +4,21 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/require"
 
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 func Test_readWorkloadIdentity(t *testing.T) {
@@ -75,3 +83,143 @@ func Test_readWorkloadIdentity(t *testing.T) {
 		})
 	}
 }
+
+func Test_readRateLimits(t *testing.T) {
+	serviceType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":                    types.StringType,
+		"requests_per_second":     types.Float64Type,
+		"burst":                   types.Int64Type,
+		"max_concurrent_requests": types.Int64Type,
+	}}
+	service := func(name string, rps attr.Value, burst attr.Value, mcr attr.Value) attr.Value {
+		return types.ObjectValueMust(serviceType.AttrTypes, map[string]attr.Value{
+			"name":                    types.StringValue(name),
+			"requests_per_second":     rps,
+			"burst":                   burst,
+			"max_concurrent_requests": mcr,
+		})
+	}
+
+	tcs := map[string]struct {
+		model     RateLimitsFrameworkModel
+		want      clients.RateLimits
+		wantDiags bool
+	}{
+		"defaults only": {
+			model: RateLimitsFrameworkModel{
+				RequestsPerSecond:     types.Float64Value(10),
+				Burst:                 types.Int64Value(5),
+				MaxConcurrentRequests: types.Int64Null(),
+				Service:               types.ListNull(serviceType),
+			},
+			want: clients.RateLimits{
+				Default:  clients.RateLimit{RequestsPerSecond: 10, Burst: 5},
+				Services: map[string]clients.RateLimit{},
+			},
+		},
+		"service overrides": {
+			model: RateLimitsFrameworkModel{
+				RequestsPerSecond:     types.Float64Value(10),
+				Burst:                 types.Int64Value(5),
+				MaxConcurrentRequests: types.Int64Value(8),
+				Service: types.ListValueMust(serviceType, []attr.Value{
+					service(clients.ServiceVaultSecrets, types.Float64Value(2), types.Int64Null(), types.Int64Value(4)),
+					service(clients.ServiceIAM, types.Float64Value(0), types.Int64Null(), types.Int64Null()),
+				}),
+			},
+			want: clients.RateLimits{
+				Default: clients.RateLimit{RequestsPerSecond: 10, Burst: 5, MaxConcurrentRequests: 8},
+				Services: map[string]clients.RateLimit{
+					clients.ServiceVaultSecrets: {RequestsPerSecond: 2, Burst: 5, MaxConcurrentRequests: 4},
+					clients.ServiceIAM:          {RequestsPerSecond: 0, Burst: 5, MaxConcurrentRequests: 8},
+				},
+			},
+		},
+		"duplicate service": {
+			model: RateLimitsFrameworkModel{
+				RequestsPerSecond:     types.Float64Null(),
+				Burst:                 types.Int64Null(),
+				MaxConcurrentRequests: types.Int64Null(),
+				Service: types.ListValueMust(serviceType, []attr.Value{
+					service(clients.ServiceIAM, types.Float64Value(1), types.Int64Null(), types.Int64Null()),
+					service(clients.ServiceIAM, types.Float64Value(2), types.Int64Null(), types.Int64Null()),
+				}),
+			},
+			wantDiags: true,
+		},
+	}
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			got, gotDiags := readRateLimits(context.Background(), tc.model, clients.ClientConfig{})
+			if tc.wantDiags {
+				if !gotDiags.HasError() {
+					t.Fatal("expected error diagnostics")
+				}
+				return
+			}
+			if gotDiags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", gotDiags)
+			}
+			if diff := cmp.Diff(tc.want, got.RateLimits); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourcesIdentity(t *testing.T) {
+	ctx := context.Background()
+	p := NewFrameworkProvider("test")()
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "hcp"}, &metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			withIdentity, ok := r.(identity.ResourceWithIdentity)
+			require.True(t, ok, "resource has no identity")
+			require.Implements(t, (*resource.ResourceWithIdentity)(nil), r)
+
+			var schema resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schema)
+			require.False(t, schema.Diagnostics.HasError(), schema.Diagnostics)
+
+			attrs := schema.Schema.GetAttributes()
+			for _, attr := range withIdentity.Identity().Attributes {
+				stateAttr := attr.StateAttribute
+				if stateAttr == "" {
+					stateAttr = attr.Name
+				}
+				if _, ok := attrs[stateAttr]; !ok {
+					require.NotNil(t, attr.Default, "identity attribute %q has no state attribute %q", attr.Name, stateAttr)
+				}
+			}
+		})
+	}
+}
+
+func TestListResources(t *testing.T) {
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(NewFrameworkProvider("test")())()
+
+	resp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	for _, d := range resp.Diagnostics {
+		require.NotEqual(t, tfprotov6.DiagnosticSeverityError, d.Severity, "%s: %s", d.Summary, d.Detail)
+	}
+
+	// Every list resource emits the identity of a managed resource of the
+	// same type.
+	require.Len(t, resp.ListResourceSchemas, 8)
+	for typeName := range resp.ListResourceSchemas {
+		require.Contains(t, resp.ResourceSchemas, typeName)
+	}
+
+	identities, err := server.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	require.NoError(t, err)
+	for typeName := range resp.ListResourceSchemas {
+		require.Contains(t, identities.IdentitySchemas, typeName)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcemanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
+)
+
+// NewProjectListResource returns the list resource of the projects of the
+// organization of the provider.
+func NewProjectListResource() list.ListResource {
+	return identity.NewListResource(NewProjectResource(), schema.Schema{
+		MarkdownDescription: "Lists the projects of the organization of the provider.",
+	}, listProjects)
+}
+
+func listProjects(ctx context.Context, client *clients.Client, _ tfsdk.Config) ([]identity.ListResult, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	projects, err := clients.ListProjects(ctx, client, client.Config.OrganizationID)
+	if err != nil {
+		diags.AddError("Unable to list projects", fmt.Sprintf("Unable to list the projects of organization %q: %v", client.Config.OrganizationID, err))
+		return nil, diags
+	}
+
+	results := make([]identity.ListResult, 0, len(projects))
+	for _, project := range projects {
+		results = append(results, identity.ListResult{
+			DisplayName: project.Name,
+			Identity: map[string]string{
+				"project_id": project.ID,
+			},
+		})
+	}
+	return results, diags
+}
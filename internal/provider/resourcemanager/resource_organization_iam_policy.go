@@ -61,16 +61,11 @@ func (u *orgIAMPolicyUpdater) GetMutexKey() string {
 // Fetch the existing IAM policy attached to a resource.
 func (u *orgIAMPolicyUpdater) GetResourceIamPolicy(ctx context.Context) (*models.HashicorpCloudResourcemanagerPolicy, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	params := organization_service.NewOrganizationServiceGetIamPolicyParams()
+	params := organization_service.NewOrganizationServiceGetIamPolicyParamsWithContext(ctx)
 	params.ID = u.client.Config.OrganizationID
 	res, err := u.client.Organization.OrganizationServiceGetIamPolicy(params, nil)
 	if err != nil {
-		serviceErr, ok := err.(*organization_service.OrganizationServiceGetIamPolicyDefault)
-		if !ok {
-			diags.AddError("failed to cast organization IAM policy error", err.Error())
-			return nil, diags
-		}
-		diags.Append(customdiags.NewErrorHTTPStatusCode("failed to retrieve organization IAM policy", err.Error(), serviceErr.Code()))
+		diags.Append(customdiags.NewAPIError("failed to retrieve organization IAM policy", err))
 		return nil, diags
 	}
 
@@ -80,7 +75,7 @@ func (u *orgIAMPolicyUpdater) GetResourceIamPolicy(ctx context.Context) (*models
 // Replaces the existing IAM Policy attached to a resource.
 func (u *orgIAMPolicyUpdater) SetResourceIamPolicy(ctx context.Context, policy *models.HashicorpCloudResourcemanagerPolicy) (*models.HashicorpCloudResourcemanagerPolicy, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	params := organization_service.NewOrganizationServiceSetIamPolicyParams()
+	params := organization_service.NewOrganizationServiceSetIamPolicyParamsWithContext(ctx)
 	params.ID = u.client.Config.OrganizationID
 	params.Body = &models.HashicorpCloudResourcemanagerOrganizationServiceSetIamPolicyBody{
 		Policy: policy,
@@ -88,12 +83,7 @@ func (u *orgIAMPolicyUpdater) SetResourceIamPolicy(ctx context.Context, policy *
 
 	res, err := u.client.Organization.OrganizationServiceSetIamPolicy(params, nil)
 	if err != nil {
-		serviceErr, ok := err.(*organization_service.OrganizationServiceSetIamPolicyDefault)
-		if !ok {
-			diags.AddError("failed to cast organization IAM policy error", err.Error())
-			return nil, diags
-		}
-		diags.Append(customdiags.NewErrorHTTPStatusCode("failed to update organization IAM policy", err.Error(), serviceErr.Code()))
+		diags.Append(customdiags.NewAPIError("failed to update organization IAM policy", err))
 		return nil, diags
 	}
 
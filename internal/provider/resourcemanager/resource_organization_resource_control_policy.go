@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/organization_service"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,15 +20,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/customdiags"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"google.golang.org/grpc/codes"
 )
 
 // resourceControlPolicyModel is the Terraform state/plan model for hcp_resource_control_policy.
 type resourceControlPolicyModel struct {
-	ID                 types.String `tfsdk:"id"`
-	OrganizationID     types.String `tfsdk:"organization_id"`
-	EnabledConstraints types.Set    `tfsdk:"enabled_constraints"`
-	Etag               types.String `tfsdk:"etag"`
+	ID                 types.String   `tfsdk:"id"`
+	OrganizationID     types.String   `tfsdk:"organization_id"`
+	EnabledConstraints types.Set      `tfsdk:"enabled_constraints"`
+	Etag               types.String   `tfsdk:"etag"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewOrganizationResourceControlPolicyResource creates a new resource instance.
@@ -42,12 +46,13 @@ type resourceOrganizationResourceControlPolicy struct {
 var _ resource.Resource = &resourceOrganizationResourceControlPolicy{}
 var _ resource.ResourceWithConfigure = &resourceOrganizationResourceControlPolicy{}
 var _ resource.ResourceWithImportState = &resourceOrganizationResourceControlPolicy{}
+var _ identity.ResourceWithIdentity = &resourceOrganizationResourceControlPolicy{}
 
 func (r *resourceOrganizationResourceControlPolicy) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_resource_control_policy"
 }
 
-func (r *resourceOrganizationResourceControlPolicy) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceOrganizationResourceControlPolicy) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages the organization-level resource control policy constraints for an HCP organization. " +
 			"Enabled constraints define restrictions applied to resources within the organization.",
@@ -77,6 +82,17 @@ func (r *resourceOrganizationResourceControlPolicy) Schema(_ context.Context, _
 				Description: "The etag of the current resource control policy. Used internally for concurrency control.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceOrganizationResourceControlPolicy) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.OrganizationID(),
+		},
 	}
 }
 
@@ -103,6 +119,15 @@ func (r *resourceOrganizationResourceControlPolicy) Create(ctx context.Context,
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	orgID := plan.OrganizationID.ValueString()
 
 	// Extract desired constraint IDs from plan.
@@ -162,6 +187,15 @@ func (r *resourceOrganizationResourceControlPolicy) Read(ctx context.Context, re
 		return
 	}
 
+	timeout, diags := state.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	orgID := state.OrganizationID.ValueString()
 
 	policy, diags := r.getPolicy(ctx, orgID)
@@ -187,6 +221,15 @@ func (r *resourceOrganizationResourceControlPolicy) Update(ctx context.Context,
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Update(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	var state resourceControlPolicyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -245,6 +288,15 @@ func (r *resourceOrganizationResourceControlPolicy) Delete(ctx context.Context,
 		return
 	}
 
+	timeout, diags := state.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	orgID := state.OrganizationID.ValueString()
 
 	// Use the etag captured in state at plan time, not a freshly-fetched one.
@@ -315,15 +367,7 @@ func (r *resourceOrganizationResourceControlPolicy) listAllConstraints(ctx conte
 
 		res, err := r.client.Organization.OrganizationServiceListConstraints(params, nil)
 		if err != nil {
-			serviceErr, ok := err.(*organization_service.OrganizationServiceListConstraintsDefault)
-			if !ok {
-				diags.AddError("Failed to list organization constraints", err.Error())
-				return nil, diags
-			}
-			diags.AddError(
-				"Failed to list organization constraints",
-				fmt.Sprintf("API error %d: %s", serviceErr.Code(), err.Error()),
-			)
+			diags.Append(customdiags.NewAPIError("Failed to list organization constraints", err))
 			return nil, diags
 		}
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/project_service"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	clients "github.com/hashicorp/terraform-provider-hcp/internal/clients"
 	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 func NewProjectResource() resource.Resource {
@@ -36,7 +38,7 @@ func (r *resourceProject) Metadata(_ context.Context, req resource.MetadataReque
 	resp.TypeName = req.ProviderTypeName + "_project"
 }
 
-func (r *resourceProject) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceProject) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: fmt.Sprintf(`The project resource manages a HCP Project.
 
@@ -75,6 +77,21 @@ The user or service account that is running Terraform when creating a %s resourc
 				Default: stringdefault.StaticString(""),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceProject) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			{
+				Name:           "project_id",
+				Description:    "The ID of the project.",
+				StateAttribute: "resource_id",
+			},
+		},
 	}
 }
 
@@ -94,10 +111,11 @@ func (r *resourceProject) Configure(_ context.Context, req resource.ConfigureReq
 }
 
 type Project struct {
-	ResourceID   types.String `tfsdk:"resource_id"`
-	ResourceName types.String `tfsdk:"resource_name"`
-	Name         types.String `tfsdk:"name"`
-	Description  types.String `tfsdk:"description"`
+	ResourceID   types.String   `tfsdk:"resource_id"`
+	ResourceName types.String   `tfsdk:"resource_name"`
+	Name         types.String   `tfsdk:"name"`
+	Description  types.String   `tfsdk:"description"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceProject) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -107,6 +125,15 @@ func (r *resourceProject) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	parentType := models.HashicorpCloudResourcemanagerResourceIDResourceTypeORGANIZATION
 	createParams := project_service.NewProjectServiceCreateParams()
 	createParams.SetBody(&models.HashicorpCloudResourcemanagerProjectCreateRequest{
@@ -119,7 +146,7 @@ func (r *resourceProject) Create(ctx context.Context, req resource.CreateRequest
 	})
 	createParams.SetContext(ctx)
 
-	res, err := clients.CreateProjectWithRetry(r.client, createParams)
+	res, err := clients.CreateProjectAndWait(r.client, createParams)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating project", err.Error())
 		return
@@ -140,7 +167,16 @@ func (r *resourceProject) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	getParams := project_service.NewProjectServiceGetParams()
+	timeout, diags := state.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	getParams := project_service.NewProjectServiceGetParamsWithContext(ctx)
 	getParams.ID = state.ResourceID.ValueString()
 	res, err := r.client.Project.ProjectServiceGet(getParams, nil)
 	if err != nil {
@@ -169,6 +205,15 @@ func (r *resourceProject) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Update(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	// Check if the name was updated
 	if !plan.Name.Equal(state.Name) {
 		setNameReq := project_service.NewProjectServiceSetNameParams()
@@ -178,7 +223,7 @@ func (r *resourceProject) Update(ctx context.Context, req resource.UpdateRequest
 		})
 		setNameReq.SetContext(ctx)
 
-		_, err := clients.SetProjectNameWithRetry(r.client, setNameReq)
+		_, err := clients.SetProjectName(r.client, setNameReq)
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating project name", err.Error())
 			return
@@ -194,7 +239,7 @@ func (r *resourceProject) Update(ctx context.Context, req resource.UpdateRequest
 		})
 		setDescReq.SetContext(ctx)
 
-		_, err := clients.SetProjectDescriptionWithRetry(r.client, setDescReq)
+		_, err := clients.SetProjectDescription(r.client, setDescReq)
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating project description", err.Error())
 			return
@@ -213,11 +258,20 @@ func (r *resourceProject) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	timeout, diags := state.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	deleteParams := project_service.NewProjectServiceDeleteParams()
 	deleteParams.SetID(state.ResourceID.ValueString())
 	deleteParams.SetContext(ctx)
 
-	_, err := clients.DeleteProjectWithRetry(r.client, deleteParams)
+	_, err := clients.DeleteProject(r.client, deleteParams)
 	if err != nil {
 		var deleteErr *project_service.ProjectServiceDeleteDefault
 		if errors.As(err, &deleteErr) && deleteErr.IsCode(http.StatusNotFound) {
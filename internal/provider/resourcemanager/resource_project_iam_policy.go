@@ -83,16 +83,11 @@ func (u *projectIAMPolicyUpdater) GetMutexKey() string {
 // Fetch the existing IAM policy attached to a resource.
 func (u *projectIAMPolicyUpdater) GetResourceIamPolicy(ctx context.Context) (*models.HashicorpCloudResourcemanagerPolicy, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	params := project_service.NewProjectServiceGetIamPolicyParams()
+	params := project_service.NewProjectServiceGetIamPolicyParamsWithContext(ctx)
 	params.ID = u.projectID
 	res, err := u.client.Project.ProjectServiceGetIamPolicy(params, nil)
 	if err != nil {
-		serviceErr, ok := err.(*project_service.ProjectServiceGetIamPolicyDefault)
-		if !ok {
-			diags.AddError("failed to cast project IAM policy error", err.Error())
-			return nil, diags
-		}
-		diags.Append(customdiags.NewErrorHTTPStatusCode("failed to retrieve project IAM policy", err.Error(), serviceErr.Code()))
+		diags.Append(customdiags.NewAPIError("failed to retrieve project IAM policy", err))
 		return nil, diags
 	}
 
@@ -102,7 +97,7 @@ func (u *projectIAMPolicyUpdater) GetResourceIamPolicy(ctx context.Context) (*mo
 // Replaces the existing IAM Policy attached to a resource.
 func (u *projectIAMPolicyUpdater) SetResourceIamPolicy(ctx context.Context, policy *models.HashicorpCloudResourcemanagerPolicy) (*models.HashicorpCloudResourcemanagerPolicy, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	params := project_service.NewProjectServiceSetIamPolicyParams()
+	params := project_service.NewProjectServiceSetIamPolicyParamsWithContext(ctx)
 	params.ID = u.projectID
 	params.Body = &models.HashicorpCloudResourcemanagerProjectServiceSetIamPolicyBody{
 		Policy: policy,
@@ -110,12 +105,7 @@ func (u *projectIAMPolicyUpdater) SetResourceIamPolicy(ctx context.Context, poli
 
 	res, err := u.client.Project.ProjectServiceSetIamPolicy(params, nil)
 	if err != nil {
-		serviceErr, ok := err.(*project_service.ProjectServiceSetIamPolicyDefault)
-		if !ok {
-			diags.AddError("failed to cast project IAM policy error", err.Error())
-			return nil, diags
-		}
-		diags.Append(customdiags.NewErrorHTTPStatusCode("failed to update project IAM policy", err.Error(), serviceErr.Code()))
+		diags.Append(customdiags.NewAPIError("failed to update project IAM policy", err))
 		return nil, diags
 	}
 
@@ -4,6 +4,7 @@
 package resourcemanager_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpfake"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
 )
 
@@ -61,6 +64,49 @@ func TestAccProjectResource(t *testing.T) {
 	})
 }
 
+// TestProjectResource_FakeAPI runs the lifecycle of a project against the
+// fake HCP API, so that it doesn't require credentials.
+func TestProjectResource_FakeAPI(t *testing.T) {
+	server := hcpfake.NewServer(t)
+	client := server.NewClient(t)
+
+	var p models.HashicorpCloudResourcemanagerProject
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactoriesWithFakeAPI(t, server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProject("example", "An example project"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hcp_project.example", "name", "example"),
+					testProjectExists(client, "hcp_project.example", &p),
+					testAccCheckProjectValues(&p, "example", "An example project"),
+				),
+			},
+			{
+				ResourceName:                         "hcp_project.example",
+				ImportState:                          true,
+				ImportStateVerifyIdentifierAttribute: "resource_id",
+				ImportStateIdFunc:                    testAccProjectImportID,
+				ImportStateVerify:                    true,
+			},
+			{
+				Config: testAccProject("renamed", "A renamed project"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hcp_project.example", "name", "renamed"),
+					testProjectExists(client, "hcp_project.example", &p),
+					testAccCheckProjectValues(&p, "renamed", "A renamed project"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if _, err := clients.GetProjectByID(context.Background(), client, p.ID); !clients.IsResponseCodeNotFound(err) {
+				return fmt.Errorf("project %q was not deleted: %v", p.ID, err)
+			}
+			return nil
+		},
+	})
+}
+
 // testAccProjectImportID retrieves the resource_id so that it can be imported.
 func testAccProjectImportID(s *terraform.State) (string, error) {
 	rs, ok := s.RootModule().Resources["hcp_project.example"]
@@ -116,6 +162,25 @@ func testAccProjectResourceExists(t *testing.T, resourceName string, project *mo
 	}
 }
 
+// testProjectExists retrieves the project of the resource with the passed
+// client.
+func testProjectExists(client *clients.Client, resourceName string, project *models.HashicorpCloudResourcemanagerProject) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		p, err := clients.GetProjectByID(context.Background(), client, rs.Primary.Attributes["resource_id"])
+		if err != nil {
+			return err
+		}
+
+		*project = *p
+		return nil
+	}
+}
+
 func testAccCheckProjectValues(project *models.HashicorpCloudResourcemanagerProject, name, description string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if project.Name != name {
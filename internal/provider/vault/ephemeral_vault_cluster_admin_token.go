@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+var _ ephemeral.EphemeralResource = &ephemeralVaultClusterAdminToken{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralVaultClusterAdminToken{}
+
+func NewVaultClusterAdminTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ephemeralVaultClusterAdminToken{}
+}
+
+type ephemeralVaultClusterAdminToken struct {
+	client *clients.Client
+}
+
+type ephemeralVaultClusterAdminTokenModel struct {
+	ClusterID types.String `tfsdk:"cluster_id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Token     types.String `tfsdk:"token"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+func (e *ephemeralVaultClusterAdminToken) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_cluster_admin_token"
+}
+
+func (e *ephemeralVaultClusterAdminToken) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Vault cluster admin token ephemeral resource generates an admin-level token for the HCP Vault cluster " +
+			"without persisting it to the Terraform state. The token is valid for six hours.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the HCP Vault cluster.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[\da-zA-Z][-a-zA-Z\d]{1,34}[\da-zA-Z]$`),
+						"must be between 3 and 36 characters in length and contains only letters, numbers or hyphens",
+					),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the HCP Vault cluster is located. " +
+					"If not specified, the project specified in the HCP Provider config block will be used, if configured.",
+				Optional: true,
+				Computed: true,
+			},
+			"token": schema.StringAttribute{
+				Description: "The admin token of this HCP Vault cluster.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The time that the admin token was created, in RFC3339 format.",
+				Computed:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "The time that the admin token expires, in RFC3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralVaultClusterAdminToken) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	e.client = client
+}
+
+func (e *ephemeralVaultClusterAdminToken) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralVaultClusterAdminTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if e.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HCP Client",
+			"Expected configured HCP client. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	clusterID := data.ClusterID.ValueString()
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: e.client.Config.OrganizationID,
+		ProjectID:      e.client.Config.ProjectID,
+	}
+	if !data.ProjectID.IsNull() {
+		loc.ProjectID = data.ProjectID.ValueString()
+	}
+
+	cluster, err := clients.GetVaultClusterByID(ctx, e.client, loc, clusterID)
+	if err != nil {
+		if clients.IsResponseCodeNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Vault cluster not found",
+				fmt.Sprintf("unable to create admin token; Vault cluster (%s) not found", clusterID),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading Vault cluster",
+			fmt.Sprintf("unable to check for presence of an existing Vault cluster (%s): %v", clusterID, err),
+		)
+		return
+	}
+
+	loc.Region = &sharedmodels.HashicorpCloudLocationRegion{
+		Provider: cluster.Location.Region.Provider,
+		Region:   cluster.Location.Region.Region,
+	}
+
+	createdAt := time.Now()
+	tokenResp, err := clients.CreateVaultClusterAdminToken(ctx, e.client, loc, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Vault cluster admin token",
+			fmt.Sprintf("error creating HCP Vault cluster admin token (cluster_id %q) (project_id %q): %v", clusterID, loc.ProjectID, err),
+		)
+		return
+	}
+
+	data.ProjectID = types.StringValue(loc.ProjectID)
+	data.Token = types.StringValue(tokenResp.Token)
+	data.CreatedAt = types.StringValue(createdAt.Format(time.RFC3339))
+	data.ExpiresAt = types.StringValue(createdAt.Add(clients.VaultClusterAdminTokenExpiry).Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
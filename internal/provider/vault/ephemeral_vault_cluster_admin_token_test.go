@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/client/vault_service"
+	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpfake"
+)
+
+func TestEphemeralVaultClusterAdminToken(t *testing.T) {
+	ctx := context.Background()
+	server := hcpfake.NewServer(t)
+	client := server.NewClient(t)
+
+	params := vault_service.NewCreateParamsWithContext(ctx)
+	params.ClusterLocationOrganizationID = server.OrganizationID()
+	params.ClusterLocationProjectID = server.ProjectID()
+	params.Body = &vaultmodels.HashicorpCloudVault20201125CreateRequest{
+		Cluster: &vaultmodels.HashicorpCloudVault20201125InputCluster{
+			ID: "vault-cluster-1",
+			Location: &vaultmodels.HashicorpCloudInternalLocationLocation{
+				Region: &vaultmodels.HashicorpCloudInternalLocationRegion{Provider: "aws", Region: "us-west-2"},
+			},
+		},
+	}
+	_, err := client.Vault.Create(params, nil)
+	require.NoError(t, err)
+
+	e := &ephemeralVaultClusterAdminToken{client: client}
+	var schemaResp ephemeral.SchemaResponse
+	e.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	// open opens the ephemeral resource for the passed cluster.
+	open := func(t *testing.T, clusterID string) ephemeral.OpenResponse {
+		t.Helper()
+
+		config := tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+		require.False(t, config.SetAttribute(ctx, path.Root("cluster_id"), clusterID).HasError())
+
+		resp := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}}
+		e.Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw}}, &resp)
+		return resp
+	}
+
+	t.Run("admin token", func(t *testing.T) {
+		resp := open(t, "vault-cluster-1")
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var data ephemeralVaultClusterAdminTokenModel
+		require.False(t, resp.Result.Get(ctx, &data).HasError())
+		assert.Equal(t, server.ProjectID(), data.ProjectID.ValueString())
+		assert.NotEmpty(t, data.Token.ValueString())
+
+		createdAt, err := time.Parse(time.RFC3339, data.CreatedAt.ValueString())
+		require.NoError(t, err)
+		expiresAt, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString())
+		require.NoError(t, err)
+		assert.Equal(t, clients.VaultClusterAdminTokenExpiry, expiresAt.Sub(createdAt))
+	})
+
+	t.Run("new token on every open", func(t *testing.T) {
+		var tokens []string
+		for i := 0; i < 2; i++ {
+			resp := open(t, "vault-cluster-1")
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var data ephemeralVaultClusterAdminTokenModel
+			require.False(t, resp.Result.Get(ctx, &data).HasError())
+			tokens = append(tokens, data.Token.ValueString())
+		}
+		assert.NotEqual(t, tokens[0], tokens[1])
+	})
+
+	t.Run("missing cluster", func(t *testing.T) {
+		resp := open(t, "missing")
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Vault cluster not found", resp.Diagnostics.Errors()[0].Summary())
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 
 	service "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-radar/preview/2023-05-01/client/integration_connection_service"
@@ -22,6 +24,7 @@ import (
 var (
 	_ resource.Resource              = &integrationConnectionResource{}
 	_ resource.ResourceWithConfigure = &integrationConnectionResource{}
+	_ identity.ResourceWithIdentity  = &integrationConnectionResource{}
 )
 
 // integrationConnectionResource is an implementation for configuring specific types of integration connections.
@@ -54,8 +57,24 @@ func (r *integrationConnectionResource) Metadata(_ context.Context, req resource
 	resp.TypeName = req.ProviderTypeName + r.TypeName
 }
 
-func (r *integrationConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *integrationConnectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = r.ConnectionSchema
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": timeouts.BlockAll(ctx),
+	}
+}
+
+func (r *integrationConnectionResource) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			{
+				Name:        "id",
+				Description: "The ID of the Radar integration connection.",
+			},
+		},
+		ImportUnsupported: true,
+	}
 }
 
 func (r *integrationConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -78,6 +97,13 @@ func (r *integrationConnectionResource) ModifyPlan(ctx context.Context, req reso
 }
 
 func (r *integrationConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Create, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	conn, diags := r.GetConnectionFromPlan(ctx, req.Plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -134,6 +160,13 @@ func (r *integrationConnectionResource) Create(ctx context.Context, req resource
 }
 
 func (r *integrationConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Read, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	conn, diags := r.GetConnectionFromState(ctx, req.State)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -168,6 +201,13 @@ func (r *integrationConnectionResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *integrationConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Delete, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	conn, diags := r.GetConnectionFromState(ctx, req.State)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -198,6 +238,13 @@ func (r *integrationConnectionResource) Delete(ctx context.Context, req resource
 }
 
 func (r *integrationConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Update, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	plan, planDiags := r.GetConnectionFromPlan(ctx, req.Plan)
 	resp.Diagnostics.Append(planDiags...)
 
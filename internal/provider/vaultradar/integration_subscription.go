@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 
 	service "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-radar/preview/2023-05-01/client/integration_subscription_service"
@@ -22,6 +24,7 @@ import (
 var (
 	_ resource.Resource              = &integrationSubscriptionResource{}
 	_ resource.ResourceWithConfigure = &integrationSubscriptionResource{}
+	_ identity.ResourceWithIdentity  = &integrationSubscriptionResource{}
 )
 
 // integrationSubscriptionResource is an implementation for configuring specific types of integration subscriptions.
@@ -54,8 +57,23 @@ func (r *integrationSubscriptionResource) Metadata(_ context.Context, req resour
 	resp.TypeName = req.ProviderTypeName + r.TypeName
 }
 
-func (r *integrationSubscriptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *integrationSubscriptionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = r.SubscriptionSchema
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": timeouts.BlockAll(ctx),
+	}
+}
+
+func (r *integrationSubscriptionResource) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			{
+				Name:        "id",
+				Description: "The ID of the Radar integration subscription.",
+			},
+		},
+	}
 }
 
 func (r *integrationSubscriptionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -78,6 +96,13 @@ func (r *integrationSubscriptionResource) ModifyPlan(ctx context.Context, req re
 }
 
 func (r *integrationSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Create, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	subscription, diags := r.GetSubscriptionFromPlan(ctx, req.Plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -123,6 +148,13 @@ func (r *integrationSubscriptionResource) Create(ctx context.Context, req resour
 }
 
 func (r *integrationSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Read, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	subscription, diags := r.GetSubscriptionFromState(ctx, req.State)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -160,6 +192,13 @@ func (r *integrationSubscriptionResource) Read(ctx context.Context, req resource
 }
 
 func (r *integrationSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Delete, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	subscription, diags := r.GetSubscriptionFromState(ctx, req.State)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -190,6 +229,13 @@ func (r *integrationSubscriptionResource) Delete(ctx context.Context, req resour
 }
 
 func (r *integrationSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Update, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	plan, planDiags := r.GetSubscriptionFromPlan(ctx, req.Plan)
 	resp.Diagnostics.Append(planDiags...)
 
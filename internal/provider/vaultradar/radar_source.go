@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 
 	service "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-radar/preview/2023-05-01/client/data_source_registration_service"
@@ -26,6 +28,7 @@ import (
 var (
 	_ resource.Resource              = &radarSourceResource{}
 	_ resource.ResourceWithConfigure = &radarSourceResource{}
+	_ identity.ResourceWithIdentity  = &radarSourceResource{}
 )
 
 var (
@@ -75,10 +78,13 @@ func (r *radarSourceResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + r.TypeName
 }
 
-func (r *radarSourceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *radarSourceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: r.ResourceSchema.MarkdownDescription,
 		Attributes:          r.ResourceSchema.Attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 
 	for k, v := range baseSourceSchema {
@@ -90,6 +96,19 @@ func (r *radarSourceResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *radarSourceResource) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			{
+				Name:        "id",
+				Description: "The ID of the Radar source.",
+			},
+		},
+		ImportUnsupported: true,
+	}
+}
+
 // radarSource is the minimal plan/state that a Radar source must have.
 type radarSource interface {
 	GetProjectID() types.String
@@ -105,9 +124,10 @@ type radarSource interface {
 
 // base abstraction of Radar datasource model, partially implements radarSource interface
 type abstractSourceModel struct {
-	ProjectID    types.String `tfsdk:"project_id"`
-	ID           types.String `tfsdk:"id"`
-	DetectorType types.String `tfsdk:"detector_type"`
+	ProjectID    types.String   `tfsdk:"project_id"`
+	ID           types.String   `tfsdk:"id"`
+	DetectorType types.String   `tfsdk:"detector_type"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (b *abstractSourceModel) GetProjectID() types.String { return b.ProjectID }
@@ -140,6 +160,13 @@ func (r *radarSourceResource) ModifyPlan(ctx context.Context, req resource.Modif
 }
 
 func (r *radarSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Create, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	src, diags := r.GetSourceFromPlan(ctx, req.Plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -184,6 +211,13 @@ func (r *radarSourceResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *radarSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Read, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	src, diags := r.GetSourceFromState(ctx, req.State)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -219,6 +253,13 @@ func (r *radarSourceResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *radarSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Delete, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	src, diags := r.GetSourceFromState(ctx, req.State)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -263,6 +304,13 @@ func (r *radarSourceResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *radarSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Update, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	plan, planDiags := r.GetSourceFromPlan(ctx, req.Plan)
 	resp.Diagnostics.Append(planDiags...)
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -98,12 +99,13 @@ var integrationJiraConnectionSchema = schema.Schema{
 }
 
 type jiraConnectionResourceData struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Email     types.String `tfsdk:"email"`
-	Token     types.String `tfsdk:"token"`
-	BaseURL   types.String `tfsdk:"base_url"`
-	ProjectID types.String `tfsdk:"project_id"`
+	ID        types.String   `tfsdk:"id"`
+	Name      types.String   `tfsdk:"name"`
+	Email     types.String   `tfsdk:"email"`
+	Token     types.String   `tfsdk:"token"`
+	BaseURL   types.String   `tfsdk:"base_url"`
+	ProjectID types.String   `tfsdk:"project_id"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 type jiraAuthKey struct {
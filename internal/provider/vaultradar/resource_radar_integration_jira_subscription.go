@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -117,14 +118,15 @@ var integrationJiraSubscriptionSchema = schema.Schema{
 }
 
 type jiraSubscriptionResourceData struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	ConnectionID   types.String `tfsdk:"connection_id"`
-	JiraProjectKey types.String `tfsdk:"jira_project_key"`
-	IssueType      types.String `tfsdk:"issue_type"`
-	Assignee       types.String `tfsdk:"assignee"`
-	Message        types.String `tfsdk:"message"`
-	ProjectID      types.String `tfsdk:"project_id"`
+	ID             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	ConnectionID   types.String   `tfsdk:"connection_id"`
+	JiraProjectKey types.String   `tfsdk:"jira_project_key"`
+	IssueType      types.String   `tfsdk:"issue_type"`
+	Assignee       types.String   `tfsdk:"assignee"`
+	Message        types.String   `tfsdk:"message"`
+	ProjectID      types.String   `tfsdk:"project_id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 type jiraSubscriptionDetails struct {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -87,11 +88,12 @@ var integrationSlackSubscriptionSchema = schema.Schema{
 }
 
 type slackSubscriptionResourceData struct {
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	ConnectionID types.String `tfsdk:"connection_id"`
-	Channel      types.String `tfsdk:"channel"`
-	ProjectID    types.String `tfsdk:"project_id"`
+	ID           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	ConnectionID types.String   `tfsdk:"connection_id"`
+	Channel      types.String   `tfsdk:"channel"`
+	ProjectID    types.String   `tfsdk:"project_id"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 type slackSubscriptionDetails struct {
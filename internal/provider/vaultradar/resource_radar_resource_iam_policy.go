@@ -96,20 +96,15 @@ func (u *radarResourceIAMPolicyUpdater) GetMutexKey() string {
 // GetResourceIamPolicy Fetch the existing IAM policy attached to a resource.
 func (u *radarResourceIAMPolicyUpdater) GetResourceIamPolicy(ctx context.Context) (*models.HashicorpCloudResourcemanagerPolicy, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	params := resource_service.NewResourceServiceGetIamPolicyParams()
+	params := resource_service.NewResourceServiceGetIamPolicyParamsWithContext(ctx)
 	params.ResourceName = &u.resourceName
 
 	res, err := u.client.ResourceService.ResourceServiceGetIamPolicy(params, nil)
 	if err != nil {
-		serviceErr, ok := err.(*resource_service.ResourceServiceGetIamPolicyDefault)
-		if !ok {
-			diags.AddError("failed to cast resource IAM policy error", err.Error())
-			return nil, diags
-		}
-		if serviceErr.Code() == http.StatusNotFound {
+		if apiErr := customdiags.ParseAPIError(err); apiErr != nil && apiErr.HTTPStatusCode == http.StatusNotFound {
 			return &models.HashicorpCloudResourcemanagerPolicy{}, diags
 		}
-		diags.Append(customdiags.NewErrorHTTPStatusCode("failed to retrieve resource IAM policy", err.Error(), serviceErr.Code()))
+		diags.Append(customdiags.NewAPIError("failed to retrieve resource IAM policy", err))
 		return nil, diags
 	}
 
@@ -136,7 +131,7 @@ func (u *radarResourceIAMPolicyUpdater) SetResourceIamPolicy(ctx context.Context
 		return nil, diags
 	}
 
-	params := resource_service.NewResourceServiceSetIamPolicyParams()
+	params := resource_service.NewResourceServiceSetIamPolicyParamsWithContext(ctx)
 
 	params.Body = &models.HashicorpCloudResourcemanagerResourceSetIamPolicyRequest{
 		Policy:       policy,
@@ -145,12 +140,7 @@ func (u *radarResourceIAMPolicyUpdater) SetResourceIamPolicy(ctx context.Context
 
 	res, err := u.client.ResourceService.ResourceServiceSetIamPolicy(params, nil)
 	if err != nil {
-		serviceErr, ok := err.(*resource_service.ResourceServiceSetIamPolicyDefault)
-		if !ok {
-			diags.AddError("failed to cast resource IAM policy error", err.Error())
-			return nil, diags
-		}
-		diags.Append(customdiags.NewErrorHTTPStatusCode("failed to update resource IAM policy", err.Error(), serviceErr.Code()))
+		diags.Append(customdiags.NewAPIError("failed to update resource IAM policy", err))
 		return nil, diags
 	}
 
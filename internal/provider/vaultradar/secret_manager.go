@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 
 	service "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-radar/preview/2023-05-01/client/secret_manager_service"
@@ -26,6 +28,7 @@ import (
 var (
 	_ resource.Resource              = &secretManagerResource{}
 	_ resource.ResourceWithConfigure = &secretManagerResource{}
+	_ identity.ResourceWithIdentity  = &secretManagerResource{}
 )
 
 var (
@@ -65,10 +68,13 @@ func (r *secretManagerResource) Metadata(_ context.Context, req resource.Metadat
 	resp.TypeName = req.ProviderTypeName + r.TypeName
 }
 
-func (r *secretManagerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *secretManagerResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: r.ResourceSchema.MarkdownDescription,
 		Attributes:          r.ResourceSchema.Attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 
 	for k, v := range baseSecretManagerSchema {
@@ -80,6 +86,19 @@ func (r *secretManagerResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+func (r *secretManagerResource) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			{
+				Name:        "id",
+				Description: "The ID of the Radar secret manager.",
+			},
+		},
+		ImportUnsupported: true,
+	}
+}
+
 // secretManager is the minimal plan/state that a Radar secret manager must have.
 type secretManager interface {
 	GetProjectID() types.String
@@ -95,8 +114,9 @@ type secretManager interface {
 
 // base abstraction of Radar secret manager, partially implements secretManager interface
 type abstractSecretManagerModel struct {
-	ProjectID types.String `tfsdk:"project_id"`
-	ID        types.String `tfsdk:"id"`
+	ProjectID types.String   `tfsdk:"project_id"`
+	ID        types.String   `tfsdk:"id"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (b *abstractSecretManagerModel) GetProjectID() types.String { return b.ProjectID }
@@ -127,6 +147,13 @@ func (r *secretManagerResource) ModifyPlan(ctx context.Context, req resource.Mod
 }
 
 func (r *secretManagerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Create, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	sm, diags := r.GetSecretManagerFromPlan(ctx, req.Plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -193,6 +220,13 @@ func (r *secretManagerResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *secretManagerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Read, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	sm, diags := r.GetSecretManagerFromState(ctx, req.State)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -235,6 +269,13 @@ func (r *secretManagerResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *secretManagerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Delete, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	sm, diags := r.GetSecretManagerFromState(ctx, req.State)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -278,6 +319,13 @@ func (r *secretManagerResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *secretManagerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Update, clients.RadarOffboardTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	plan, planDiags := r.GetSecretManagerFromPlan(ctx, req.Plan)
 	resp.Diagnostics.Append(planDiags...)
 
@@ -5,12 +5,13 @@ package vaultsecrets
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
 )
@@ -26,6 +27,7 @@ type DataSourceVaultSecretsSecretModel struct {
 	OrgID       types.String `tfsdk:"organization_id"`
 	SecretName  types.String `tfsdk:"secret_name"`
 	SecretValue types.String `tfsdk:"secret_value"`
+	Version     types.Int64  `tfsdk:"version"`
 }
 
 func NewVaultSecretsSecretDataSource() datasource.DataSource {
@@ -38,7 +40,7 @@ func (d *DataSourceVaultSecretsSecret) Metadata(_ context.Context, req datasourc
 
 func (d *DataSourceVaultSecretsSecret) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "The Vault Secrets secret data source retrieves a singular secret and its latest version.",
+		MarkdownDescription: "The Vault Secrets secret data source retrieves a singular secret and its latest version, or the version set with `version`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -52,6 +54,13 @@ func (d *DataSourceVaultSecretsSecret) Schema(_ context.Context, _ datasource.Sc
 				Description: "The name of the Vault Secrets secret.",
 				Required:    true,
 			},
+			"version": schema.Int64Attribute{
+				Description: "The version of the secret to retrieve. Defaults to the latest version.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"secret_value": schema.StringAttribute{
 				Description: "The secret value corresponding to the secret name input.",
 				Computed:    true,
@@ -103,7 +112,7 @@ func (d *DataSourceVaultSecretsSecret) Read(ctx context.Context, req datasource.
 		ProjectID:      client.Config.ProjectID,
 	}
 
-	openSecret, err := clients.OpenVaultSecretsAppSecret(ctx, client, loc, data.AppName.ValueString(), data.SecretName.ValueString())
+	openSecret, err := openSecretVersion(ctx, client, loc, data.AppName.ValueString(), data.SecretName.ValueString(), data.Version)
 	if err != nil {
 		resp.Diagnostics.AddError(err.Error(), "Unable to open secret")
 		return
@@ -111,38 +120,23 @@ func (d *DataSourceVaultSecretsSecret) Read(ctx context.Context, req datasource.
 
 	// NOTE: for backwards compatibility purposes, if the secret is not a static secret (a string)
 	// encode the complex secret as a JSON string
-	var secretValue string
+	secretValue, err := openSecretValue(openSecret)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read secret value", err.Error())
+		return
+	}
+
 	switch {
-	case openSecret.StaticVersion != nil:
-		secretValue = openSecret.StaticVersion.Value
 	case openSecret.RotatingVersion != nil:
-		secretData, err := json.Marshal(openSecret.RotatingVersion.Values)
-		if err != nil {
-			resp.Diagnostics.AddError(err.Error(), "could not encode rotating secret as json")
-			return
-		}
 		resp.Diagnostics.AddWarning(
 			"HCP Vault Secrets mismatched type",
 			"Attempted to get a rotating secret in a KV secret data source, encoding the secret values as JSON",
 		)
-		secretValue = string(secretData)
 	case openSecret.DynamicInstance != nil:
-		secretData, err := json.Marshal(openSecret.DynamicInstance.Values)
-		if err != nil {
-			resp.Diagnostics.AddError(err.Error(), "could not encode dynamic secret as json")
-			return
-		}
 		resp.Diagnostics.AddWarning(
 			"HCP Vault Secrets mismatched type",
 			"Attempted to get a dynamic secret in a KV secret data source, encoding the secret values as JSON",
 		)
-		secretValue = string(secretData)
-	default:
-		resp.Diagnostics.AddError(
-			"Unsupported HCP Secret type",
-			fmt.Sprintf("HCP Secrets secret type %q is not currently supported by terraform-provider-hcp", openSecret.Type),
-		)
-		return
 	}
 
 	data.ID = data.AppName
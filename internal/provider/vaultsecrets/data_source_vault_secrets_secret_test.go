@@ -40,6 +40,27 @@ func TestAcc_dataSourceVaultSecretsSecret(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceAddress, "secret_value", testSecretValue),
 				),
 			},
+			{
+				Config: fmt.Sprintf(`
+					data "hcp_vault_secrets_secret" "foo" {
+						app_name    = %q
+						secret_name = %q
+						version     = 1
+					}
+
+					data "hcp_vault_secrets_secret_versions" "foo" {
+						app_name    = %q
+						secret_name = %q
+					}`, testAppName, testSecretName, testAppName, testSecretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceAddress, "secret_value", "this shouldn't show up!"),
+					resource.TestCheckResourceAttr("data.hcp_vault_secrets_secret_versions.foo", "secret_type", "kv"),
+					resource.TestCheckResourceAttr("data.hcp_vault_secrets_secret_versions.foo", "versions.#", "2"),
+					resource.TestCheckResourceAttr("data.hcp_vault_secrets_secret_versions.foo", "versions.0.version", "1"),
+					resource.TestCheckResourceAttr("data.hcp_vault_secrets_secret_versions.foo", "versions.1.version", "2"),
+					resource.TestCheckResourceAttrSet("data.hcp_vault_secrets_secret_versions.foo", "versions.1.created_at"),
+				),
+			},
 		},
 		CheckDestroy: func(s *terraform.State) error {
 			deleteTestAppSecret(t, testAppName, testSecretName)
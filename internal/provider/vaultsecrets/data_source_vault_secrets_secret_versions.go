@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/customdiags"
+)
+
+type DataSourceVaultSecretsSecretVersions struct {
+	client *clients.Client
+}
+
+type DataSourceVaultSecretsSecretVersionsModel struct {
+	ID         types.String         `tfsdk:"id"`
+	AppName    types.String         `tfsdk:"app_name"`
+	SecretName types.String         `tfsdk:"secret_name"`
+	ProjectID  types.String         `tfsdk:"project_id"`
+	OrgID      types.String         `tfsdk:"organization_id"`
+	SecretType types.String         `tfsdk:"secret_type"`
+	Versions   []SecretVersionModel `tfsdk:"versions"`
+}
+
+type SecretVersionModel struct {
+	Version   types.Int64          `tfsdk:"version"`
+	CreatedAt types.String         `tfsdk:"created_at"`
+	CreatedBy *SecretVersionAuthor `tfsdk:"created_by"`
+}
+
+type SecretVersionAuthor struct {
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+	Type  types.String `tfsdk:"type"`
+}
+
+func NewVaultSecretsSecretVersionsDataSource() datasource.DataSource {
+	return &DataSourceVaultSecretsSecretVersions{}
+}
+
+func (d *DataSourceVaultSecretsSecretVersions) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_secrets_secret_versions"
+}
+
+func (d *DataSourceVaultSecretsSecretVersions) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Vault Secrets secret versions data source retrieves the versions of a secret, without their values.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this resource.",
+			},
+			"app_name": schema.StringAttribute{
+				Description: "The name of the Vault Secrets application.",
+				Required:    true,
+			},
+			"secret_name": schema.StringAttribute{
+				Description: "The name of the Vault Secrets secret.",
+				Required:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the HCP organization where the Vault Secrets app is located.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the Vault Secrets app is located.",
+				Computed:    true,
+			},
+			"secret_type": schema.StringAttribute{
+				Description: "The type of the secret as reported by Vault Secrets, for example `kv` or `rotating`.",
+				Computed:    true,
+			},
+			"versions": schema.ListNestedAttribute{
+				Description: "The versions of the secret, from the oldest to the latest.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.Int64Attribute{
+							Description: "The version number.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The time the version was created, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"created_by": schema.SingleNestedAttribute{
+							Description: "The principal that created the version.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description: "The name of the principal.",
+									Computed:    true,
+								},
+								"email": schema.StringAttribute{
+									Description: "The email of the principal, if it's a user.",
+									Computed:    true,
+								},
+								"type": schema.StringAttribute{
+									Description: "The type of the principal.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DataSourceVaultSecretsSecretVersions) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *DataSourceVaultSecretsSecretVersions) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DataSourceVaultSecretsSecretVersionsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.client
+	if client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HCP Client",
+			"Expected configured HCP client. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: client.Config.OrganizationID,
+		ProjectID:      client.Config.ProjectID,
+	}
+
+	res, err := clients.ListVaultSecretsAppSecretVersions(ctx, client, loc, data.AppName.ValueString(), data.SecretName.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(customdiags.NewAPIError("Unable to list secret versions", err))
+		return
+	}
+
+	versions := make([]SecretVersionModel, 0, len(res.StaticVersions.Versions)+len(res.RotatingVersions.Versions))
+	for _, v := range res.StaticVersions.Versions {
+		versions = append(versions, secretVersion(v.Version, v.CreatedAt, v.CreatedBy))
+	}
+	for _, v := range res.RotatingVersions.Versions {
+		versions = append(versions, secretVersion(v.Version, v.CreatedAt, v.CreatedBy))
+	}
+	slices.SortFunc(versions, func(a, b SecretVersionModel) int {
+		return cmp.Compare(a.Version.ValueInt64(), b.Version.ValueInt64())
+	})
+
+	data.ID = data.AppName
+	data.OrgID = types.StringValue(loc.OrganizationID)
+	data.ProjectID = types.StringValue(loc.ProjectID)
+	data.SecretType = types.StringValue(res.Type)
+	data.Versions = versions
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// secretVersion returns the model of a version of a secret.
+func secretVersion(version int64, createdAt strfmt.DateTime, createdBy *secretmodels.Secrets20231128Principal) SecretVersionModel {
+	model := SecretVersionModel{
+		Version:   types.Int64Value(version),
+		CreatedAt: types.StringValue(time.Time(createdAt).Format(time.RFC3339)),
+	}
+	if createdBy != nil {
+		model.CreatedBy = &SecretVersionAuthor{
+			Name:  types.StringValue(createdBy.Name),
+			Email: types.StringValue(createdBy.Email),
+			Type:  types.StringValue(createdBy.Type),
+		}
+	}
+	return model
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"context"
+	"fmt"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+var _ ephemeral.EphemeralResource = &ephemeralVaultSecretsApp{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralVaultSecretsApp{}
+
+func NewVaultSecretsAppEphemeralResource() ephemeral.EphemeralResource {
+	return &ephemeralVaultSecretsApp{}
+}
+
+type ephemeralVaultSecretsApp struct {
+	client *clients.Client
+}
+
+type ephemeralVaultSecretsAppModel struct {
+	AppName        types.String `tfsdk:"app_name"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Secrets        types.Map    `tfsdk:"secrets"`
+}
+
+func (e *ephemeralVaultSecretsApp) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_secrets_app"
+}
+
+func (e *ephemeralVaultSecretsApp) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Vault Secrets app ephemeral resource opens the latest version of every secret in a given application without persisting their values to the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"app_name": schema.StringAttribute{
+				Description: "The name of the Vault Secrets application.",
+				Required:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the Vault Secrets app is located. Inferred from the provider configuration if omitted.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the HCP organization where the Vault Secrets app is located.",
+				Computed:    true,
+			},
+			"secrets": schema.MapAttribute{
+				Description: "A map of all secrets in the Vault Secrets app. Key is the secret name, value is the latest secret version value. " +
+					"The values of rotating and dynamic secrets are keyed by the secret name and value name joined by an underscore.",
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (e *ephemeralVaultSecretsApp) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	e.client = client
+}
+
+func (e *ephemeralVaultSecretsApp) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralVaultSecretsAppModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if e.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HCP Client",
+			"Expected configured HCP client. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: e.client.Config.OrganizationID,
+		ProjectID:      e.client.Config.ProjectID,
+	}
+	if !data.ProjectID.IsNull() {
+		loc.ProjectID = data.ProjectID.ValueString()
+	}
+
+	appSecrets, err := clients.OpenVaultSecretsAppSecrets(ctx, e.client, loc, data.AppName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(err.Error(), "Unable to open app secrets")
+		return
+	}
+
+	openAppSecrets, err := flattenOpenAppSecrets(appSecrets)
+	if err != nil {
+		resp.Diagnostics.AddError("Unsupported HCP Secret type", err.Error())
+		return
+	}
+
+	secrets, diags := types.MapValueFrom(ctx, types.StringType, openAppSecrets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrganizationID = types.StringValue(loc.OrganizationID)
+	data.ProjectID = types.StringValue(loc.ProjectID)
+	data.Secrets = secrets
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
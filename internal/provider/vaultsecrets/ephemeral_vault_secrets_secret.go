@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"context"
+	"fmt"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+var _ ephemeral.EphemeralResource = &ephemeralVaultSecretsSecret{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralVaultSecretsSecret{}
+
+func NewVaultSecretsSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &ephemeralVaultSecretsSecret{}
+}
+
+type ephemeralVaultSecretsSecret struct {
+	client *clients.Client
+}
+
+type ephemeralVaultSecretsSecretModel struct {
+	AppName        types.String `tfsdk:"app_name"`
+	SecretName     types.String `tfsdk:"secret_name"`
+	Version        types.Int64  `tfsdk:"version"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	SecretType     types.String `tfsdk:"secret_type"`
+	SecretValue    types.String `tfsdk:"secret_value"`
+	SecretValues   types.Map    `tfsdk:"secret_values"`
+}
+
+func (e *ephemeralVaultSecretsSecret) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_secrets_secret"
+}
+
+func (e *ephemeralVaultSecretsSecret) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Vault Secrets secret ephemeral resource opens the latest version of a static, rotating or dynamic secret, or the version set with `version`, without persisting its value to the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"app_name": schema.StringAttribute{
+				Description: "The name of the Vault Secrets application.",
+				Required:    true,
+			},
+			"secret_name": schema.StringAttribute{
+				Description: "The name of the Vault Secrets secret.",
+				Required:    true,
+			},
+			"version": schema.Int64Attribute{
+				Description: "The version of the secret to open. Defaults to the latest version.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the Vault Secrets app is located. Inferred from the provider configuration if omitted.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the HCP organization where the Vault Secrets app is located.",
+				Computed:    true,
+			},
+			"secret_type": schema.StringAttribute{
+				Description: "The type of the secret as reported by Vault Secrets, for example `kv`, `rotating` or `dynamic`.",
+				Computed:    true,
+			},
+			"secret_value": schema.StringAttribute{
+				Description: "The secret value corresponding to the secret name input. The values of rotating and dynamic secrets are encoded as a JSON object.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"secret_values": schema.MapAttribute{
+				Description: "The key/value pairs of a rotating or dynamic secret. Empty for static secrets.",
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (e *ephemeralVaultSecretsSecret) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	e.client = client
+}
+
+func (e *ephemeralVaultSecretsSecret) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralVaultSecretsSecretModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if e.client == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured HCP Client",
+			"Expected configured HCP client. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: e.client.Config.OrganizationID,
+		ProjectID:      e.client.Config.ProjectID,
+	}
+	if !data.ProjectID.IsNull() {
+		loc.ProjectID = data.ProjectID.ValueString()
+	}
+
+	openSecret, err := openSecretVersion(ctx, e.client, loc, data.AppName.ValueString(), data.SecretName.ValueString(), data.Version)
+	if err != nil {
+		resp.Diagnostics.AddError(err.Error(), "Unable to open secret")
+		return
+	}
+
+	secretValue, err := openSecretValue(openSecret)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read secret value", err.Error())
+		return
+	}
+
+	secretValues, diags := types.MapValueFrom(ctx, types.StringType, openSecretValues(openSecret))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrganizationID = types.StringValue(loc.OrganizationID)
+	data.ProjectID = types.StringValue(loc.ProjectID)
+	data.SecretType = types.StringValue(openSecret.Type)
+	data.SecretValue = types.StringValue(secretValue)
+	data.SecretValues = secretValues
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
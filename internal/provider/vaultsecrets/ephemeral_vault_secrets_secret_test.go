@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
+)
+
+func TestAcc_ephemeralVaultSecretsSecret(t *testing.T) {
+	testAppName := generateRandomSlug()
+	testSecretName := "secret_one"
+	testSecretValue := "some value"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactoriesWithEchoAndCassette(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					createTestApp(t, testAppName)
+
+					createTestAppSecret(t, testAppName, testSecretName, "this shouldn't show up!")
+					createTestAppSecret(t, testAppName, testSecretName, testSecretValue)
+				},
+				Config: fmt.Sprintf(`
+					ephemeral "hcp_vault_secrets_secret" "foo" {
+						app_name    = %q
+						secret_name = %q
+					}
+
+					provider "echo" {
+						data = ephemeral.hcp_vault_secrets_secret.foo
+					}
+
+					resource "echo" "test" {}`, testAppName, testSecretName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("secret_value"), knownvalue.StringExact(testSecretValue)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("project_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("organization_id"), knownvalue.NotNull()),
+				},
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			deleteTestAppSecret(t, testAppName, testSecretName)
+			deleteTestApp(t, testAppName)
+			return nil
+		},
+	})
+}
+
+func TestAcc_ephemeralVaultSecretsApp(t *testing.T) {
+	testAppName := generateRandomSlug()
+
+	firstSecretName := "secret_one"
+	secondSecretName := "secret_two"
+	firstSecretValue := "hey, this is version 1!"
+	secondSecretValue := "hey, this is version 2!"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactoriesWithEchoAndCassette(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					createTestApp(t, testAppName)
+
+					createTestAppSecret(t, testAppName, firstSecretName, firstSecretValue)
+					createTestAppSecret(t, testAppName, secondSecretName, secondSecretValue)
+				},
+				Config: fmt.Sprintf(`
+					ephemeral "hcp_vault_secrets_app" "foo" {
+						app_name = %q
+					}
+
+					provider "echo" {
+						data = ephemeral.hcp_vault_secrets_app.foo.secrets
+					}
+
+					resource "echo" "test" {}`, testAppName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey(firstSecretName), knownvalue.StringExact(firstSecretValue)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey(secondSecretName), knownvalue.StringExact(secondSecretValue)),
+				},
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			deleteTestAppSecret(t, testAppName, firstSecretName)
+			deleteTestAppSecret(t, testAppName, secondSecretName)
+			deleteTestApp(t, testAppName)
+			return nil
+		},
+	})
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"context"
+	"fmt"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
+)
+
+// NewVaultSecretsAppListResource returns the list resource of the Vault
+// Secrets apps of a project.
+func NewVaultSecretsAppListResource() list.ListResource {
+	return identity.NewListResource(NewVaultSecretsAppResource(), schema.Schema{
+		MarkdownDescription: "Lists the Vault Secrets apps of a project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": identity.ProjectIDListAttribute(),
+		},
+	}, listVaultSecretsApps)
+}
+
+func listVaultSecretsApps(ctx context.Context, client *clients.Client, config tfsdk.Config) ([]identity.ListResult, diag.Diagnostics) {
+	projectID, diags := identity.ListProjectID(ctx, client, config)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: client.Config.OrganizationID,
+		ProjectID:      projectID,
+	}
+	apps, err := clients.ListVaultSecretsApps(ctx, client, loc)
+	if err != nil {
+		diags.AddError("Unable to list Vault Secrets apps", fmt.Sprintf("Unable to list the Vault Secrets apps of project %q: %v", projectID, err))
+		return nil, diags
+	}
+
+	results := make([]identity.ListResult, 0, len(apps))
+	for _, app := range apps {
+		results = append(results, identity.ListResult{
+			DisplayName: app.Name,
+			Identity: map[string]string{
+				"project_id": projectID,
+				"app_name":   app.Name,
+			},
+		})
+	}
+	return results, diags
+}
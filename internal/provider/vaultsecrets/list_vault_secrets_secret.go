@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"context"
+	"fmt"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
+)
+
+// NewVaultSecretsSecretListResource returns the list resource of the static
+// secrets of a Vault Secrets app. Secret values aren't listed.
+func NewVaultSecretsSecretListResource() list.ListResource {
+	return identity.NewListResource(NewVaultSecretsSecretResource(), schema.Schema{
+		MarkdownDescription: "Lists the static secrets of a Vault Secrets app. Rotating and dynamic secrets are omitted.",
+		Attributes: map[string]schema.Attribute{
+			"app_name": schema.StringAttribute{
+				Description: "The name of the Vault Secrets app to list the secrets of.",
+				Required:    true,
+			},
+			"project_id": identity.ProjectIDListAttribute(),
+		},
+	}, listVaultSecretsSecrets)
+}
+
+func listVaultSecretsSecrets(ctx context.Context, client *clients.Client, config tfsdk.Config) ([]identity.ListResult, diag.Diagnostics) {
+	projectID, diags := identity.ListProjectID(ctx, client, config)
+	var appName types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("app_name"), &appName)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: client.Config.OrganizationID,
+		ProjectID:      projectID,
+	}
+	secrets, err := clients.ListVaultSecretsAppSecrets(ctx, client, loc, appName.ValueString())
+	if err != nil {
+		diags.AddError("Unable to list Vault Secrets secrets", fmt.Sprintf("Unable to list the secrets of Vault Secrets app %q: %v", appName.ValueString(), err))
+		return nil, diags
+	}
+
+	results := make([]identity.ListResult, 0, len(secrets))
+	for _, secret := range secrets {
+		// Rotating and dynamic secrets have their own resources.
+		if secret.Type != staticSecretType {
+			continue
+		}
+
+		results = append(results, identity.ListResult{
+			DisplayName: secret.Name,
+			Identity: map[string]string{
+				"project_id":  projectID,
+				"app_name":    appName.ValueString(),
+				"secret_name": secret.Name,
+			},
+		})
+	}
+	return results, diags
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -22,17 +23,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 )
 
 type App struct {
-	ID             types.String `tfsdk:"id"`
-	AppName        types.String `tfsdk:"app_name"`
-	Description    types.String `tfsdk:"description"`
-	ProjectID      types.String `tfsdk:"project_id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
-	ResourceName   types.String `tfsdk:"resource_name"`
-	SyncNames      types.Set    `tfsdk:"sync_names"`
+	ID             types.String   `tfsdk:"id"`
+	AppName        types.String   `tfsdk:"app_name"`
+	Description    types.String   `tfsdk:"description"`
+	ProjectID      types.String   `tfsdk:"project_id"`
+	OrganizationID types.String   `tfsdk:"organization_id"`
+	ResourceName   types.String   `tfsdk:"resource_name"`
+	SyncNames      types.Set      `tfsdk:"sync_names"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 
 	syncNames []string `tfsdk:"-"`
 }
@@ -41,6 +44,7 @@ var _ resource.Resource = &resourceVaultSecretsApp{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsApp{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsApp{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsApp{}
+var _ identity.ResourceWithIdentity = &resourceVaultSecretsApp{}
 
 func NewVaultSecretsAppResource() resource.Resource {
 	return &resourceVaultSecretsApp{}
@@ -54,7 +58,7 @@ func (r *resourceVaultSecretsApp) Metadata(_ context.Context, req resource.Metad
 	resp.TypeName = req.ProviderTypeName + "_vault_secrets_app"
 }
 
-func (r *resourceVaultSecretsApp) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceVaultSecretsApp) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets app resource manages an application.",
 		Attributes: map[string]schema.Attribute{
@@ -105,6 +109,21 @@ func (r *resourceVaultSecretsApp) Schema(_ context.Context, _ resource.SchemaReq
 					),
 				},
 			}},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceVaultSecretsApp) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			{
+				Name:        "app_name",
+				Description: "The name of the Vault Secrets app.",
+			},
+		},
 	}
 }
 
@@ -128,6 +147,13 @@ func (r *resourceVaultSecretsApp) ModifyPlan(ctx context.Context, req resource.M
 }
 
 func (r *resourceVaultSecretsApp) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Create, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*App](ctx, r.client, &resp.State, req.Plan.Get, "creating", func(i hvsResource) (any, error) {
 		app, ok := i.(*App)
 		if !ok {
@@ -135,6 +161,7 @@ func (r *resourceVaultSecretsApp) Create(ctx context.Context, req resource.Creat
 		}
 
 		response, err := r.client.VaultSecrets.CreateApp(&secret_service.CreateAppParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceCreateAppBody{
 				Name:        app.AppName.ValueString(),
 				Description: app.Description.ValueString(),
@@ -154,6 +181,13 @@ func (r *resourceVaultSecretsApp) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *resourceVaultSecretsApp) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Read, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*App](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		app, ok := i.(*App)
 		if !ok {
@@ -176,6 +210,13 @@ func (r *resourceVaultSecretsApp) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *resourceVaultSecretsApp) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Update, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*App](ctx, r.client, &resp.State, req.Plan.Get, "updating", func(i hvsResource) (any, error) {
 		app, ok := i.(*App)
 		if !ok {
@@ -183,6 +224,7 @@ func (r *resourceVaultSecretsApp) Update(ctx context.Context, req resource.Updat
 		}
 
 		response, err := r.client.VaultSecrets.UpdateApp(&secret_service.UpdateAppParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceUpdateAppBody{
 				Description: app.Description.ValueString(),
 				SyncNames:   app.syncNames,
@@ -202,6 +244,13 @@ func (r *resourceVaultSecretsApp) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *resourceVaultSecretsApp) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Delete, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*App](ctx, r.client, &resp.State, req.State.Get, "deleting", func(i hvsResource) (any, error) {
 		app, ok := i.(*App)
 		if !ok {
@@ -255,17 +304,18 @@ func (a *App) fromModel(_ context.Context, orgID, projID string, model any) diag
 	a.ID = types.StringValue(appModel.ResourceID)
 	a.ResourceName = types.StringValue(appModel.ResourceName)
 
-	var syncs []attr.Value
-	for _, c := range appModel.SyncNames {
-		syncs = append(syncs, types.StringValue(c))
+	// Syncs that were added or removed outside of Terraform show up as a diff
+	// of sync_names. An app without syncs keeps sync_names null, unless it's
+	// set to an empty set.
+	if len(appModel.SyncNames) == 0 && a.SyncNames.IsNull() {
+		return diags
 	}
 
-	if len(syncs) > 0 {
-		a.SyncNames, diags = types.SetValue(types.StringType, syncs)
-		if diags.HasError() {
-			return diags
-		}
+	syncs := make([]attr.Value, 0, len(appModel.SyncNames))
+	for _, c := range appModel.SyncNames {
+		syncs = append(syncs, types.StringValue(c))
 	}
 
+	a.SyncNames, diags = types.SetValue(types.StringType, syncs)
 	return diags
 }
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"context"
+	"testing"
+
+	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_fromModel_SyncNames(t *testing.T) {
+	ctx := context.Background()
+
+	syncNames := func(names ...string) types.Set {
+		values := make([]attr.Value, 0, len(names))
+		for _, name := range names {
+			values = append(values, types.StringValue(name))
+		}
+		return types.SetValueMust(types.StringType, values)
+	}
+
+	tests := []struct {
+		name      string
+		state     types.Set
+		remote    []string
+		syncNames types.Set
+	}{
+		{
+			name:      "no syncs",
+			state:     types.SetNull(types.StringType),
+			syncNames: types.SetNull(types.StringType),
+		},
+		{
+			name:      "empty syncs",
+			state:     syncNames(),
+			syncNames: syncNames(),
+		},
+		{
+			name:      "sync added outside of Terraform",
+			state:     types.SetNull(types.StringType),
+			remote:    []string{"github"},
+			syncNames: syncNames("github"),
+		},
+		{
+			name:      "sync removed outside of Terraform",
+			state:     syncNames("github", "vercel"),
+			remote:    []string{"github"},
+			syncNames: syncNames("github"),
+		},
+		{
+			name:      "all syncs removed outside of Terraform",
+			state:     syncNames("github"),
+			syncNames: syncNames(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &App{SyncNames: tt.state}
+			diags := app.fromModel(ctx, "org", "project", &secretmodels.Secrets20231128App{
+				Name:      "example",
+				SyncNames: tt.remote,
+			})
+			require.False(t, diags.HasError(), diags)
+			assert.True(t, tt.syncNames.Equal(app.SyncNames), "expected %s, got %s", tt.syncNames, app.SyncNames)
+		})
+	}
+}
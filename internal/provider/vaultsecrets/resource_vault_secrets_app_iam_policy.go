@@ -80,20 +80,15 @@ func (u *vaultSecretsAppResourceIAMPolicyUpdater) GetMutexKey() string {
 // GetResourceIamPolicy Fetch the existing IAM policy attached to a resource.
 func (u *vaultSecretsAppResourceIAMPolicyUpdater) GetResourceIamPolicy(ctx context.Context) (*models.HashicorpCloudResourcemanagerPolicy, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	params := resource_service.NewResourceServiceGetIamPolicyParams()
+	params := resource_service.NewResourceServiceGetIamPolicyParamsWithContext(ctx)
 	params.ResourceName = &u.resourceName
 
 	res, err := u.client.ResourceService.ResourceServiceGetIamPolicy(params, nil)
 	if err != nil {
-		serviceErr, ok := err.(*resource_service.ResourceServiceGetIamPolicyDefault)
-		if !ok {
-			diags.AddError("failed to cast resource IAM policy error", err.Error())
-			return nil, diags
-		}
-		if serviceErr.Code() == http.StatusNotFound {
+		if apiErr := customdiags.ParseAPIError(err); apiErr != nil && apiErr.HTTPStatusCode == http.StatusNotFound {
 			return &models.HashicorpCloudResourcemanagerPolicy{}, diags
 		}
-		diags.Append(customdiags.NewErrorHTTPStatusCode("failed to retrieve resource IAM policy", err.Error(), serviceErr.Code()))
+		diags.Append(customdiags.NewAPIError("failed to retrieve resource IAM policy", err))
 		return nil, diags
 	}
 
@@ -103,7 +98,7 @@ func (u *vaultSecretsAppResourceIAMPolicyUpdater) GetResourceIamPolicy(ctx conte
 // SetResourceIamPolicy Replaces the existing IAM Policy attached to a resource.
 func (u *vaultSecretsAppResourceIAMPolicyUpdater) SetResourceIamPolicy(ctx context.Context, policy *models.HashicorpCloudResourcemanagerPolicy) (*models.HashicorpCloudResourcemanagerPolicy, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	params := resource_service.NewResourceServiceSetIamPolicyParams()
+	params := resource_service.NewResourceServiceSetIamPolicyParamsWithContext(ctx)
 
 	params.Body = &models.HashicorpCloudResourcemanagerResourceSetIamPolicyRequest{
 		Policy:       policy,
@@ -112,12 +107,7 @@ func (u *vaultSecretsAppResourceIAMPolicyUpdater) SetResourceIamPolicy(ctx conte
 
 	res, err := u.client.ResourceService.ResourceServiceSetIamPolicy(params, nil)
 	if err != nil {
-		serviceErr, ok := err.(*resource_service.ResourceServiceSetIamPolicyDefault)
-		if !ok {
-			diags.AddError("failed to cast resource IAM policy error", err.Error())
-			return nil, diags
-		}
-		diags.Append(customdiags.NewErrorHTTPStatusCode("failed to update resource IAM policy", err.Error(), serviceErr.Code()))
+		diags.Append(customdiags.NewAPIError("failed to update resource IAM policy", err))
 		return nil, diags
 	}
 
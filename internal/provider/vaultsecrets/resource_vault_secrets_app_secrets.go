@@ -0,0 +1,467 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/customdiags"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
+	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
+)
+
+// appSecretsParallelism is the maximum number of secrets of an app that are
+// written or deleted concurrently by hcp_vault_secrets_app_secrets.
+const appSecretsParallelism = 8
+
+var _ resource.Resource = &resourceVaultSecretsAppSecrets{}
+var _ resource.ResourceWithConfigure = &resourceVaultSecretsAppSecrets{}
+var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsAppSecrets{}
+var _ resource.ResourceWithImportState = &resourceVaultSecretsAppSecrets{}
+var _ identity.ResourceWithIdentity = &resourceVaultSecretsAppSecrets{}
+
+func NewVaultSecretsAppSecretsResource() resource.Resource {
+	return &resourceVaultSecretsAppSecrets{}
+}
+
+type resourceVaultSecretsAppSecrets struct {
+	client *clients.Client
+}
+
+type AppSecrets struct {
+	ID             types.String   `tfsdk:"id"`
+	AppName        types.String   `tfsdk:"app_name"`
+	Secrets        types.Map      `tfsdk:"secrets"`
+	Authoritative  types.Bool     `tfsdk:"authoritative"`
+	ProjectID      types.String   `tfsdk:"project_id"`
+	OrganizationID types.String   `tfsdk:"organization_id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *resourceVaultSecretsAppSecrets) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vault_secrets_app_secrets"
+}
+
+func (r *resourceVaultSecretsAppSecrets) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Vault Secrets app secrets resource manages the static secrets of an application as a single map.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The id of the resource",
+				Computed:    true,
+			},
+			"app_name": schema.StringAttribute{
+				Description: "The name of the application the secrets can be found in",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(3),
+					stringvalidator.LengthAtMost(36),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9\-]+[a-zA-Z0-9]$`),
+						"must contain only ASCII letters, numbers, and hyphens; must not start or end with a hyphen",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"secrets": schema.MapAttribute{
+				Description: "A map of secret names to their values.",
+				Required:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.LengthAtLeast(1),
+						stringvalidator.LengthAtMost(64),
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`),
+							"must contain only ASCII letters, numbers, and underscores; must not start with a number",
+						),
+					),
+				},
+			},
+			"authoritative": schema.BoolAttribute{
+				Description: "Whether `secrets` holds all the static secrets of the application. If true, static secrets of the application missing from `secrets` are deleted. If false, they are left untouched. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the HCP project where the HCP Vault Secrets app is located.",
+				Computed:    true,
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the HCP organization where the project the HCP Vault Secrets app is located.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceVaultSecretsAppSecrets) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			{
+				Name:        "app_name",
+				Description: "The name of the Vault Secrets app of the secrets.",
+			},
+		},
+	}
+}
+
+func (r *resourceVaultSecretsAppSecrets) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *clients.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *resourceVaultSecretsAppSecrets) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifiers.ModifyPlanForDefaultProjectChange(ctx, r.client.Config.ProjectID, req.State, req.Config, req.Plan, resp)
+}
+
+func (r *resourceVaultSecretsAppSecrets) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AppSecrets
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// The secrets that were written are kept in the state even if others
+	// failed, such that they are deleted if the resource is replaced.
+	resp.Diagnostics.Append(r.apply(ctx, nil, &plan)...)
+	if plan.ID.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceVaultSecretsAppSecrets) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AppSecrets
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	loc := r.location(state.ProjectID)
+	secrets, err := clients.OpenVaultSecretsAppSecrets(ctx, r.client, loc, state.AppName.ValueString())
+	if err != nil {
+		// The HVS API returns 403 if the app doesn't exist even if the
+		// principal has the correct permissions.
+		if clients.IsResponseCodeNotFound(err) || clients.IsResponseForbidden(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(customdiags.NewAPIError("Error reading secrets", err))
+		return
+	}
+
+	// Secrets that were just imported have no secrets in their state yet, so
+	// all static secrets of the app are read.
+	authoritative := state.Authoritative.ValueBool()
+	readAll := authoritative || state.Secrets.IsNull()
+	managed := make(map[string]string, len(state.Secrets.Elements()))
+	resp.Diagnostics.Append(state.Secrets.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := make(map[string]string, len(secrets))
+	for _, secret := range secrets {
+		// Rotating and dynamic secrets have their own resources.
+		if secret.StaticVersion == nil {
+			continue
+		}
+		if _, ok := managed[secret.Name]; !readAll && !ok {
+			continue
+		}
+		values[secret.Name] = secret.StaticVersion.Value
+	}
+
+	state.ID = state.AppName
+	state.Authoritative = types.BoolValue(authoritative)
+	state.OrganizationID = types.StringValue(loc.OrganizationID)
+	state.ProjectID = types.StringValue(loc.ProjectID)
+	state.Secrets, diags = types.MapValueFrom(ctx, types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceVaultSecretsAppSecrets) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state AppSecrets
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &state, &plan)...)
+	if plan.ID.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceVaultSecretsAppSecrets) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AppSecrets
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	current := make(map[string]string, len(state.Secrets.Elements()))
+	resp.Diagnostics.Append(state.Secrets.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deletes := make([]string, 0, len(current))
+	for name := range current {
+		deletes = append(deletes, name)
+	}
+
+	_, diags = applyAppSecrets(ctx, r.client, r.location(state.ProjectID), state.AppName.ValueString(), current, nil, deletes)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState imports all static secrets of an app. The ID is the name of
+// the app, followed by ":authoritative" if the imported secrets are managed
+// authoritatively.
+func (r *resourceVaultSecretsAppSecrets) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	appName, authoritative := strings.CutSuffix(req.ID, ":authoritative")
+	if appName == "" || strings.Contains(appName, ":") {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Import ID must be the name of the app, optionally followed by \":authoritative\", got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), r.client.Config.OrganizationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), r.client.Config.ProjectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_name"), appName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authoritative"), authoritative)...)
+}
+
+// apply writes the secrets of plan that are new or changed since state, which
+// is nil if the resource is created, and deletes the secrets that were
+// removed from it. If plan is authoritative, the static secrets of the app
+// missing from plan are deleted as well. Once the changes are applied, plan
+// is set to the resulting state, which only holds the changes that succeeded.
+// plan is left as is if no change was attempted.
+func (r *resourceVaultSecretsAppSecrets) apply(ctx context.Context, state, plan *AppSecrets) diag.Diagnostics {
+	var diags diag.Diagnostics
+	loc := r.location(plan.ProjectID)
+	appName := plan.AppName.ValueString()
+
+	planned := make(map[string]string, len(plan.Secrets.Elements()))
+	diags.Append(plan.Secrets.ElementsAs(ctx, &planned, false)...)
+	current := make(map[string]string)
+	if state != nil {
+		diags.Append(state.Secrets.ElementsAs(ctx, &current, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	deletes := make([]string, 0)
+	for name := range current {
+		if _, ok := planned[name]; !ok {
+			deletes = append(deletes, name)
+		}
+	}
+
+	// The app is listed, rather than relying on the state, so that secrets
+	// created outside of Terraform are deleted as soon as the resource is
+	// created or turned authoritative.
+	if plan.Authoritative.ValueBool() {
+		secrets, err := clients.ListVaultSecretsAppSecrets(ctx, r.client, loc, appName)
+		if err != nil {
+			diags.Append(customdiags.NewAPIError("Error listing secrets", err))
+			return diags
+		}
+		for _, secret := range secrets {
+			_, isPlanned := planned[secret.Name]
+			_, isCurrent := current[secret.Name]
+			if secret.Type == staticSecretType && !isPlanned && !isCurrent {
+				deletes = append(deletes, secret.Name)
+			}
+		}
+	}
+
+	upserts := make(map[string]string, len(planned))
+	for name, value := range planned {
+		if currentValue, ok := current[name]; !ok || currentValue != value {
+			upserts[name] = value
+		}
+	}
+
+	// Secrets that failed to be written keep their prior value in the state,
+	// so that the next plan writes them again.
+	values, applyDiags := applyAppSecrets(ctx, r.client, loc, appName, current, upserts, deletes)
+	diags.Append(applyDiags...)
+	secrets, mapDiags := types.MapValueFrom(ctx, types.StringType, values)
+	diags.Append(mapDiags...)
+	if mapDiags.HasError() {
+		return diags
+	}
+
+	plan.ID = plan.AppName
+	plan.Secrets = secrets
+	plan.OrganizationID = types.StringValue(loc.OrganizationID)
+	plan.ProjectID = types.StringValue(loc.ProjectID)
+	return diags
+}
+
+// location returns the location of the app of the secrets.
+func (r *resourceVaultSecretsAppSecrets) location(projectID types.String) *sharedmodels.HashicorpCloudLocationLocation {
+	orgID, projID := r.client.Location(projectID)
+	return &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: orgID,
+		ProjectID:      projID,
+	}
+}
+
+// applyAppSecrets writes the secrets of upserts to an app and deletes the
+// secrets of deletes from it, appSecretsParallelism at a time. It returns the
+// secrets of current with the changes that succeeded applied, and an error
+// for every change that failed.
+func applyAppSecrets(ctx context.Context, client *clients.Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName string, current, upserts map[string]string, deletes []string) (map[string]string, diag.Diagnostics) {
+	var (
+		mu     sync.Mutex
+		diags  diag.Diagnostics
+		values = make(map[string]string, len(current)+len(upserts))
+	)
+	for name, value := range current {
+		values[name] = value
+	}
+
+	var g errgroup.Group
+	g.SetLimit(appSecretsParallelism)
+
+	// Secrets are changed in a stable order, so that failures are reported
+	// in the same order.
+	names := make([]string, 0, len(upserts))
+	for name := range upserts {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		value := upserts[name]
+		g.Go(func() error {
+			_, err := clients.CreateVaultSecretsAppSecret(ctx, client, loc, appName, name, value)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				diags.Append(customdiags.NewAttributeAPIError(path.Root("secrets").AtMapKey(name), fmt.Sprintf("Error writing secret %q", name), err))
+				return nil
+			}
+			values[name] = value
+			return nil
+		})
+	}
+
+	slices.Sort(deletes)
+	for _, name := range deletes {
+		g.Go(func() error {
+			err := clients.DeleteVaultSecretsAppSecret(ctx, client, loc, appName, name)
+			if err != nil && clients.IsResponseCodeNotFound(err) {
+				err = nil
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				diags.Append(customdiags.NewAttributeAPIError(path.Root("secrets").AtMapKey(name), fmt.Sprintf("Error deleting secret %q", name), err))
+				return nil
+			}
+			delete(values, name)
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+	return values, diags
+}
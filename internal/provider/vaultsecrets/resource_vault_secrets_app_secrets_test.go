@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+func TestResourceVaultSecretsAppSecrets(t *testing.T) {
+	f := newResourceFixture(t, func(client *clients.Client) *resourceVaultSecretsAppSecrets {
+		return &resourceVaultSecretsAppSecrets{client: client}
+	})
+	ctx, client, loc, r := f.ctx, f.client, f.loc, f.r
+	create, read := f.create, f.read
+
+	// newPlan returns the plan of the secrets of an app, as planned from its
+	// configuration.
+	newPlan := func(t *testing.T, appName string, authoritative bool, secrets map[string]string) tfsdk.Plan {
+		t.Helper()
+
+		return f.newPlan(t, map[string]any{
+			"id":              types.StringUnknown(),
+			"app_name":        appName,
+			"secrets":         secrets,
+			"authoritative":   authoritative,
+			"project_id":      loc.ProjectID,
+			"organization_id": types.StringUnknown(),
+		})
+	}
+
+	secretsOf := func(t *testing.T, state tfsdk.State) map[string]string {
+		t.Helper()
+
+		var secrets map[string]string
+		require.False(t, state.GetAttribute(ctx, path.Root("secrets"), &secrets).HasError())
+		return secrets
+	}
+
+	appSecrets := func(t *testing.T, appName string) map[string]string {
+		t.Helper()
+
+		secrets, err := clients.OpenVaultSecretsAppSecrets(ctx, client, loc, appName)
+		require.NoError(t, err)
+		values := make(map[string]string, len(secrets))
+		for _, secret := range secrets {
+			values[secret.Name] = secret.StaticVersion.Value
+		}
+		return values
+	}
+
+	t.Run("authoritative", func(t *testing.T) {
+		_, err := clients.CreateVaultSecretsApp(ctx, client, loc, "authoritative", "")
+		require.NoError(t, err)
+		_, err = clients.CreateVaultSecretsAppSecret(ctx, client, loc, "authoritative", "legacy", "value")
+		require.NoError(t, err)
+
+		// Secrets missing from the configuration are deleted on create.
+		state := create(t, newPlan(t, "authoritative", true, map[string]string{"a": "1", "b": "2"}))
+		assert.Equal(t, map[string]string{"a": "1", "b": "2"}, appSecrets(t, "authoritative"))
+
+		// Secrets added outside of Terraform are read, so that the next plan
+		// deletes them.
+		_, err = clients.CreateVaultSecretsAppSecret(ctx, client, loc, "authoritative", "extra", "value")
+		require.NoError(t, err)
+		state = read(t, state)
+		assert.Equal(t, map[string]string{"a": "1", "b": "2", "extra": "value"}, secretsOf(t, state))
+
+		plan := newPlan(t, "authoritative", true, map[string]string{"a": "changed", "c": "3"})
+		resp := resource.UpdateResponse{State: state}
+		r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, map[string]string{"a": "changed", "c": "3"}, secretsOf(t, resp.State))
+		assert.Equal(t, map[string]string{"a": "changed", "c": "3"}, appSecrets(t, "authoritative"))
+
+		// Only the value of the changed secret is written again.
+		opened, err := clients.OpenVaultSecretsAppSecret(ctx, client, loc, "authoritative", "a")
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, opened.LatestVersion)
+
+		deleteResp := resource.DeleteResponse{State: resp.State}
+		r.Delete(ctx, resource.DeleteRequest{State: resp.State}, &deleteResp)
+		require.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+		assert.Empty(t, appSecrets(t, "authoritative"))
+	})
+
+	t.Run("additive", func(t *testing.T) {
+		_, err := clients.CreateVaultSecretsApp(ctx, client, loc, "additive", "")
+		require.NoError(t, err)
+		_, err = clients.CreateVaultSecretsAppSecret(ctx, client, loc, "additive", "legacy", "value")
+		require.NoError(t, err)
+
+		state := read(t, create(t, newPlan(t, "additive", false, map[string]string{"a": "1"})))
+		assert.Equal(t, map[string]string{"a": "1"}, secretsOf(t, state))
+
+		deleteResp := resource.DeleteResponse{State: state}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, &deleteResp)
+		require.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+		assert.Equal(t, map[string]string{"legacy": "value"}, appSecrets(t, "additive"))
+	})
+
+	t.Run("import", func(t *testing.T) {
+		_, err := clients.CreateVaultSecretsApp(ctx, client, loc, "imported", "")
+		require.NoError(t, err)
+		for name, value := range map[string]string{"a": "1", "b": "2"} {
+			_, err = clients.CreateVaultSecretsAppSecret(ctx, client, loc, "imported", name, value)
+			require.NoError(t, err)
+		}
+
+		for id, authoritative := range map[string]bool{"imported": false, "imported:authoritative": true} {
+			importResp := resource.ImportStateResponse{State: f.newState(t, nil)}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: id}, &importResp)
+			require.False(t, importResp.Diagnostics.HasError(), importResp.Diagnostics)
+
+			// All secrets of the app are imported.
+			state := read(t, importResp.State)
+			assert.Equal(t, map[string]string{"a": "1", "b": "2"}, secretsOf(t, state))
+
+			var imported types.Bool
+			state.GetAttribute(ctx, path.Root("authoritative"), &imported)
+			assert.Equal(t, authoritative, imported.ValueBool(), id)
+		}
+
+		importResp := resource.ImportStateResponse{State: f.newState(t, nil)}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: "imported:other"}, &importResp)
+		assert.True(t, importResp.Diagnostics.HasError())
+	})
+
+	t.Run("app deleted", func(t *testing.T) {
+		state := create(t, newPlan(t, "authoritative", true, map[string]string{"a": "1"}))
+		require.NoError(t, clients.DeleteVaultSecretsApp(ctx, client, loc, "authoritative"))
+		assert.True(t, read(t, state).Raw.IsNull())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		current := map[string]string{"a": "1"}
+		values, diags := applyAppSecrets(ctx, client, loc, "missing", current, map[string]string{"a": "2", "b": "2"}, nil)
+		require.Len(t, diags.Errors(), 2)
+		assert.Equal(t, current, values)
+	})
+}
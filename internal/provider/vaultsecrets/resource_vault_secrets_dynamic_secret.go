@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 	"golang.org/x/exp/maps"
 )
@@ -49,12 +51,13 @@ var dynamicSecretsImpl = map[Provider]dynamicSecret{
 
 type DynamicSecret struct {
 	// Shared input fields
-	ProjectID       types.String `tfsdk:"project_id"`
-	AppName         types.String `tfsdk:"app_name"`
-	SecretProvider  types.String `tfsdk:"secret_provider"`
-	Name            types.String `tfsdk:"name"`
-	IntegrationName types.String `tfsdk:"integration_name"`
-	DefaultTTL      types.String `tfsdk:"default_ttl"`
+	ProjectID       types.String   `tfsdk:"project_id"`
+	AppName         types.String   `tfsdk:"app_name"`
+	SecretProvider  types.String   `tfsdk:"secret_provider"`
+	Name            types.String   `tfsdk:"name"`
+	IntegrationName types.String   `tfsdk:"integration_name"`
+	DefaultTTL      types.String   `tfsdk:"default_ttl"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 
 	// Provider specific mutually exclusive fields
 	AWSAssumeRole                *awsAssumeRole                `tfsdk:"aws_assume_role"`
@@ -75,6 +78,7 @@ type gcpImpersonateServiceAccount struct {
 var _ resource.Resource = &resourceVaultSecretsDynamicSecret{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsDynamicSecret{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsDynamicSecret{}
+var _ identity.ResourceWithIdentity = &resourceVaultSecretsDynamicSecret{}
 
 func NewVaultSecretsDynamicSecretResource() resource.Resource {
 	return &resourceVaultSecretsDynamicSecret{}
@@ -88,7 +92,7 @@ func (r *resourceVaultSecretsDynamicSecret) Metadata(_ context.Context, req reso
 	resp.TypeName = req.ProviderTypeName + "_vault_secrets_dynamic_secret"
 }
 
-func (r *resourceVaultSecretsDynamicSecret) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceVaultSecretsDynamicSecret) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	attributes := map[string]schema.Attribute{
 		"default_ttl": schema.StringAttribute{
 			Description: "TTL the generated credentials will be valid for.",
@@ -128,6 +132,22 @@ func (r *resourceVaultSecretsDynamicSecret) Schema(_ context.Context, _ resource
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets dynamic secret resource manages a dynamic secret configuration.",
 		Attributes:          attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceVaultSecretsDynamicSecret) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			{
+				Name:        "app_name",
+				Description: "The name of the Vault Secrets app of the secret.",
+			},
+			identity.Name("The name of the dynamic secret."),
+		},
 	}
 }
 
@@ -151,6 +171,13 @@ func (r *resourceVaultSecretsDynamicSecret) ModifyPlan(ctx context.Context, req
 }
 
 func (r *resourceVaultSecretsDynamicSecret) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Read, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*DynamicSecret](ctx, r.client, &resp.State, req.State.Get, "reading", func(s hvsResource) (any, error) {
 		secret, ok := s.(*DynamicSecret)
 		if !ok {
@@ -166,6 +193,13 @@ func (r *resourceVaultSecretsDynamicSecret) Read(ctx context.Context, req resour
 }
 
 func (r *resourceVaultSecretsDynamicSecret) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Create, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*DynamicSecret](ctx, r.client, &resp.State, req.Plan.Get, "creating", func(s hvsResource) (any, error) {
 		secret, ok := s.(*DynamicSecret)
 		if !ok {
@@ -181,6 +215,13 @@ func (r *resourceVaultSecretsDynamicSecret) Create(ctx context.Context, req reso
 }
 
 func (r *resourceVaultSecretsDynamicSecret) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Update, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*DynamicSecret](ctx, r.client, &resp.State, req.Plan.Get, "updating", func(s hvsResource) (any, error) {
 		secret, ok := s.(*DynamicSecret)
 		if !ok {
@@ -196,6 +237,13 @@ func (r *resourceVaultSecretsDynamicSecret) Update(ctx context.Context, req reso
 }
 
 func (r *resourceVaultSecretsDynamicSecret) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Delete, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*DynamicSecret](ctx, r.client, &resp.State, req.State.Get, "deleting", func(s hvsResource) (any, error) {
 		secret, ok := s.(*DynamicSecret)
 		if !ok {
@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -19,11 +21,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"golang.org/x/exp/maps"
 
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 )
 
@@ -43,15 +48,69 @@ var exactlyOneIntegrationTypeFieldsValidator = objectvalidator.ExactlyOneOf(
 )
 
 type gitlabAccessDetails struct {
-	AccessToken types.String `tfsdk:"token"`
+	AccessToken          types.String `tfsdk:"token"`
+	AccessTokenWO        types.String `tfsdk:"token_wo"`
+	AccessTokenWOVersion types.Int64  `tfsdk:"token_wo_version"`
+}
+
+// The credential blocks of the integration resource extend the ones of the deprecated
+// integration resources with the write-only variant of their sensitive field.
+
+type integrationAccessKeys struct {
+	accessKeys
+	SecretAccessKeyWO        types.String `tfsdk:"secret_access_key_wo"`
+	SecretAccessKeyWOVersion types.Int64  `tfsdk:"secret_access_key_wo_version"`
+}
+
+type integrationClientSecret struct {
+	clientSecret
+	ClientSecretWO        types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWOVersion types.Int64  `tfsdk:"client_secret_wo_version"`
+}
+
+type integrationConfluentStaticCredentialDetails struct {
+	confluentStaticCredentialDetails
+	CloudAPISecretWO        types.String `tfsdk:"cloud_api_secret_wo"`
+	CloudAPISecretWOVersion types.Int64  `tfsdk:"cloud_api_secret_wo_version"`
+}
+
+type integrationServiceAccountKey struct {
+	serviceAccountKey
+	CredentialsWO        types.String `tfsdk:"credentials_wo"`
+	CredentialsWOVersion types.Int64  `tfsdk:"credentials_wo_version"`
+}
+
+type integrationMongoDBAtlasStaticCredentialDetails struct {
+	mongoDBAtlasStaticCredentialDetails
+	APIPrivateKeyWO        types.String `tfsdk:"api_private_key_wo"`
+	APIPrivateKeyWOVersion types.Int64  `tfsdk:"api_private_key_wo_version"`
+}
+
+type integrationStaticCredentialDetails struct {
+	staticCredentialDetails
+	APIKeySecretWO        types.String `tfsdk:"api_key_secret_wo"`
+	APIKeySecretWOVersion types.Int64  `tfsdk:"api_key_secret_wo_version"`
+}
+
+// integrationWriteOnlyPaths lists the write-only attributes of the integration resource.
+// Terraform only sends their values in the configuration, never in the plan or the state.
+var integrationWriteOnlyPaths = []path.Path{
+	path.Root("aws_access_keys").AtName("secret_access_key_wo"),
+	path.Root("azure_client_secret").AtName("client_secret_wo"),
+	path.Root("confluent_static_credentials").AtName("cloud_api_secret_wo"),
+	path.Root("gcp_service_account_key").AtName("credentials_wo"),
+	path.Root("mongodb_atlas_static_credentials").AtName("api_private_key_wo"),
+	path.Root("twilio_static_credentials").AtName("api_key_secret_wo"),
+	path.Root("gitlab_access").AtName("token_wo"),
 }
 
 type Integration struct {
 	// Input fields
-	ProjectID    types.String `tfsdk:"project_id"`
-	Name         types.String `tfsdk:"name"`
-	Capabilities types.Set    `tfsdk:"capabilities"`
-	Provider     types.String `tfsdk:"provider_type"`
+	ProjectID    types.String   `tfsdk:"project_id"`
+	Name         types.String   `tfsdk:"name"`
+	Capabilities types.Set      `tfsdk:"capabilities"`
+	Provider     types.String   `tfsdk:"provider_type"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 
 	// Provider specific mutually exclusive fields
 	AwsAccessKeys                    types.Object `tfsdk:"aws_access_keys"`
@@ -88,6 +147,8 @@ var _ resource.Resource = &resourceVaultSecretsIntegration{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsIntegration{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsIntegration{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsIntegration{}
+var _ resource.ResourceWithMoveState = &resourceVaultSecretsIntegration{}
+var _ identity.ResourceWithIdentity = &resourceVaultSecretsIntegration{}
 
 func NewVaultSecretsIntegrationResource() resource.Resource {
 	return &resourceVaultSecretsIntegration{}
@@ -101,7 +162,7 @@ func (r *resourceVaultSecretsIntegration) Metadata(_ context.Context, req resour
 	resp.TypeName = req.ProviderTypeName + "_vault_secrets_integration"
 }
 
-func (r *resourceVaultSecretsIntegration) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceVaultSecretsIntegration) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	attributes := map[string]schema.Attribute{
 		"provider_type": schema.StringAttribute{
 			Description: "The provider or 3rd party platform the integration is for.",
@@ -116,17 +177,12 @@ func (r *resourceVaultSecretsIntegration) Schema(_ context.Context, _ resource.S
 		"aws_access_keys": schema.SingleNestedAttribute{
 			Description: "AWS IAM key pair used to authenticate against the target AWS account. Cannot be used with `federated_workload_identity`.",
 			Optional:    true,
-			Attributes: map[string]schema.Attribute{
+			Attributes: withWriteOnlyCredential(map[string]schema.Attribute{
 				"access_key_id": schema.StringAttribute{
 					Description: "Key ID used with the secret key to authenticate against the target AWS account.",
 					Required:    true,
 				},
-				"secret_access_key": schema.StringAttribute{
-					Description: "Secret key used with the key ID to authenticate against the target AWS account.",
-					Required:    true,
-					Sensitive:   true,
-				},
-			},
+			}, "secret_access_key", "Secret key used with the key ID to authenticate against the target AWS account."),
 			Validators: []validator.Object{
 				exactlyOneIntegrationTypeFieldsValidator,
 			},
@@ -151,7 +207,7 @@ func (r *resourceVaultSecretsIntegration) Schema(_ context.Context, _ resource.S
 		"azure_client_secret": schema.SingleNestedAttribute{
 			Description: "Azure client secret used to authenticate against the target Azure application. Cannot be used with `federated_workload_identity`.",
 			Optional:    true,
-			Attributes: map[string]schema.Attribute{
+			Attributes: withWriteOnlyCredential(map[string]schema.Attribute{
 				"tenant_id": schema.StringAttribute{
 					Description: "Azure tenant ID corresponding to the Azure application.",
 					Required:    true,
@@ -160,11 +216,7 @@ func (r *resourceVaultSecretsIntegration) Schema(_ context.Context, _ resource.S
 					Description: "Azure client ID corresponding to the Azure application.",
 					Required:    true,
 				},
-				"client_secret": schema.StringAttribute{
-					Description: "Secret value corresponding to the Azure client secret.",
-					Required:    true,
-				},
-			},
+			}, "client_secret", "Secret value corresponding to the Azure client secret."),
 			Validators: []validator.Object{
 				exactlyOneIntegrationTypeFieldsValidator,
 			},
@@ -193,17 +245,12 @@ func (r *resourceVaultSecretsIntegration) Schema(_ context.Context, _ resource.S
 		"confluent_static_credentials": schema.SingleNestedAttribute{
 			Description: "Confluent API key used to authenticate for cloud apis.",
 			Optional:    true,
-			Attributes: map[string]schema.Attribute{
+			Attributes: withWriteOnlyCredential(map[string]schema.Attribute{
 				"cloud_api_key_id": schema.StringAttribute{
 					Description: "Public key used alongside the private key to authenticate for cloud apis.",
 					Required:    true,
 				},
-				"cloud_api_secret": schema.StringAttribute{
-					Description: "Private key used alongside the public key to authenticate for cloud apis.",
-					Required:    true,
-					Sensitive:   true,
-				},
-			},
+			}, "cloud_api_secret", "Private key used alongside the public key to authenticate for cloud apis."),
 			Validators: []validator.Object{
 				exactlyOneIntegrationTypeFieldsValidator,
 			},
@@ -211,11 +258,7 @@ func (r *resourceVaultSecretsIntegration) Schema(_ context.Context, _ resource.S
 		"gcp_service_account_key": schema.SingleNestedAttribute{
 			Description: "GCP service account key used to authenticate against the target GCP project. Cannot be used with `federated_workload_identity`.",
 			Optional:    true,
-			Attributes: map[string]schema.Attribute{
-				"credentials": schema.StringAttribute{
-					Description: "JSON or base64 encoded service account key received from GCP.",
-					Required:    true,
-				},
+			Attributes: withWriteOnlyCredential(map[string]schema.Attribute{
 				"project_id": schema.StringAttribute{
 					Description: "GCP project ID corresponding to the service account key.",
 					Computed:    true,
@@ -224,7 +267,7 @@ func (r *resourceVaultSecretsIntegration) Schema(_ context.Context, _ resource.S
 					Description: "Service account email corresponding to the service account key.",
 					Computed:    true,
 				},
-			},
+			}, "credentials", "JSON or base64 encoded service account key received from GCP."),
 			Validators: []validator.Object{
 				exactlyOneIntegrationTypeFieldsValidator,
 			},
@@ -249,17 +292,12 @@ func (r *resourceVaultSecretsIntegration) Schema(_ context.Context, _ resource.S
 		"mongodb_atlas_static_credentials": schema.SingleNestedAttribute{
 			Description: "MongoDB Atlas API key used to authenticate against the target project.",
 			Optional:    true,
-			Attributes: map[string]schema.Attribute{
+			Attributes: withWriteOnlyCredential(map[string]schema.Attribute{
 				"api_public_key": schema.StringAttribute{
 					Description: "Public key used alongside the private key to authenticate against the target project.",
 					Required:    true,
 				},
-				"api_private_key": schema.StringAttribute{
-					Description: "Private key used alongside the public key to authenticate against the target project.",
-					Required:    true,
-					Sensitive:   true,
-				},
-			},
+			}, "api_private_key", "Private key used alongside the public key to authenticate against the target project."),
 			Validators: []validator.Object{
 				exactlyOneIntegrationTypeFieldsValidator,
 			},
@@ -267,7 +305,7 @@ func (r *resourceVaultSecretsIntegration) Schema(_ context.Context, _ resource.S
 		"twilio_static_credentials": schema.SingleNestedAttribute{
 			Description: "Twilio API key parts used to authenticate against the target Twilio account.",
 			Optional:    true,
-			Attributes: map[string]schema.Attribute{
+			Attributes: withWriteOnlyCredential(map[string]schema.Attribute{
 				"account_sid": schema.StringAttribute{
 					Description: "Account SID for the target Twilio account.",
 					Required:    true,
@@ -276,12 +314,7 @@ func (r *resourceVaultSecretsIntegration) Schema(_ context.Context, _ resource.S
 					Description: "Api key SID to authenticate against the target Twilio account.",
 					Required:    true,
 				},
-				"api_key_secret": schema.StringAttribute{
-					Description: "Api key secret used with the api key SID to authenticate against the target Twilio account.",
-					Required:    true,
-					Sensitive:   true,
-				},
-			},
+			}, "api_key_secret", "Api key secret used with the api key SID to authenticate against the target Twilio account."),
 			Validators: []validator.Object{
 				exactlyOneIntegrationTypeFieldsValidator,
 			},
@@ -289,13 +322,8 @@ func (r *resourceVaultSecretsIntegration) Schema(_ context.Context, _ resource.S
 		"gitlab_access": schema.SingleNestedAttribute{
 			Description: "GitLab access token used to authenticate against the target GitLab account.",
 			Optional:    true,
-			Attributes: map[string]schema.Attribute{
-				"token": schema.StringAttribute{
-					Description: "Access token used to authenticate against the target GitLab account. This token must have privilege to create CI/CD variables.",
-					Required:    true,
-					Sensitive:   true,
-				},
-			},
+			Attributes: withWriteOnlyCredential(map[string]schema.Attribute{},
+				"token", "Access token used to authenticate against the target GitLab account. This token must have privilege to create CI/CD variables."),
 			Validators: []validator.Object{
 				exactlyOneIntegrationTypeFieldsValidator,
 			},
@@ -308,6 +336,18 @@ func (r *resourceVaultSecretsIntegration) Schema(_ context.Context, _ resource.S
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets integration resource manages an integration.",
 		Attributes:          attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceVaultSecretsIntegration) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			identity.Name("The name of the Vault Secrets integration."),
+		},
 	}
 }
 
@@ -331,6 +371,13 @@ func (r *resourceVaultSecretsIntegration) ModifyPlan(ctx context.Context, req re
 }
 
 func (r *resourceVaultSecretsIntegration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Read, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*Integration](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		integration, ok := i.(*Integration)
 		if !ok {
@@ -353,13 +400,21 @@ func (r *resourceVaultSecretsIntegration) Read(ctx context.Context, req resource
 }
 
 func (r *resourceVaultSecretsIntegration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	resp.Diagnostics.Append(decorateOperation[*Integration](ctx, r.client, &resp.State, req.Plan.Get, "creating", func(i hvsResource) (any, error) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Create, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	resp.Diagnostics.Append(decorateOperation[*Integration](ctx, r.client, &resp.State, planWithWriteOnlyCredentials(req.Plan, req.Config), "creating", func(i hvsResource) (any, error) {
 		integration, ok := i.(*Integration)
 		if !ok {
 			return nil, fmt.Errorf("invalid integration type, expected *Integration, got: %T, this is a bug on the provider", i)
 		}
 
 		response, err := r.client.VaultSecrets.CreateIntegration(&secret_service.CreateIntegrationParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceCreateIntegrationBody{
 				Name:                           integration.Name.ValueString(),
 				Provider:                       integration.Provider.ValueString(),
@@ -389,13 +444,21 @@ func (r *resourceVaultSecretsIntegration) Create(ctx context.Context, req resour
 }
 
 func (r *resourceVaultSecretsIntegration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.Append(decorateOperation[*Integration](ctx, r.client, &resp.State, req.Plan.Get, "updating", func(i hvsResource) (any, error) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Update, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
+	resp.Diagnostics.Append(decorateOperation[*Integration](ctx, r.client, &resp.State, planWithWriteOnlyCredentials(req.Plan, req.Config), "updating", func(i hvsResource) (any, error) {
 		integration, ok := i.(*Integration)
 		if !ok {
 			return nil, fmt.Errorf("invalid integration type, expected *Integration, got: %T, this is a bug on the provider", i)
 		}
 
 		response, err := r.client.VaultSecrets.UpdateIntegration(&secret_service.UpdateIntegrationParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceUpdateIntegrationBody{
 				Provider:                       integration.Provider.ValueString(),
 				Capabilities:                   integration.capabilities,
@@ -425,6 +488,13 @@ func (r *resourceVaultSecretsIntegration) Update(ctx context.Context, req resour
 }
 
 func (r *resourceVaultSecretsIntegration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Delete, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*Integration](ctx, r.client, &resp.State, req.State.Get, "deleting", func(i hvsResource) (any, error) {
 		integration, ok := i.(*Integration)
 		if !ok {
@@ -451,6 +521,176 @@ func (r *resourceVaultSecretsIntegration) ImportState(ctx context.Context, req r
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
 }
 
+// deprecatedIntegration is a deprecated integration resource whose state can be moved to the integration resource.
+type deprecatedIntegration struct {
+	newResource func() resource.Resource
+	provider    Provider
+
+	// credentials maps the credential blocks of the deprecated resource to the ones of the integration resource.
+	credentials map[string]string
+}
+
+var deprecatedIntegrations = []deprecatedIntegration{
+	{
+		newResource: NewVaultSecretsIntegrationAWSResource,
+		provider:    ProviderAWS,
+		credentials: map[string]string{
+			"access_keys":                 "aws_access_keys",
+			"federated_workload_identity": "aws_federated_workload_identity",
+		},
+	},
+	{
+		newResource: NewVaultSecretsIntegrationAzureResource,
+		provider:    ProviderAzure,
+		credentials: map[string]string{
+			"client_secret":               "azure_client_secret",
+			"federated_workload_identity": "azure_federated_workload_identity",
+		},
+	},
+	{
+		newResource: NewVaultSecretsIntegrationsConfluentResource,
+		provider:    ProviderConfluent,
+		credentials: map[string]string{
+			"static_credential_details": "confluent_static_credentials",
+		},
+	},
+	{
+		newResource: NewVaultSecretsIntegrationGCPResource,
+		provider:    ProviderGCP,
+		credentials: map[string]string{
+			"service_account_key":         "gcp_service_account_key",
+			"federated_workload_identity": "gcp_federated_workload_identity",
+		},
+	},
+	{
+		newResource: NewVaultSecretsIntegrationMongoDBAtlasResource,
+		provider:    ProviderMongoDBAtlas,
+		credentials: map[string]string{
+			"static_credential_details": "mongodb_atlas_static_credentials",
+		},
+	},
+	{
+		newResource: NewVaultSecretsIntegrationTwilioResource,
+		provider:    ProviderTwilio,
+		credentials: map[string]string{
+			"static_credential_details": "twilio_static_credentials",
+		},
+	},
+}
+
+// MoveState moves the state of the deprecated integration resources to the integration resource, such that they
+// can be migrated with a moved block instead of being re-created.
+func (r *resourceVaultSecretsIntegration) MoveState(ctx context.Context) []resource.StateMover {
+	movers := make([]resource.StateMover, 0, len(deprecatedIntegrations))
+	for _, deprecated := range deprecatedIntegrations {
+		source := deprecated.newResource()
+
+		var metadata resource.MetadataResponse
+		source.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "hcp"}, &metadata)
+		var sourceSchema resource.SchemaResponse
+		source.Schema(ctx, resource.SchemaRequest{}, &sourceSchema)
+
+		movers = append(movers, resource.StateMover{
+			SourceSchema: &sourceSchema.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != metadata.TypeName || req.SourceProviderAddress != hcpProviderAddress || req.SourceState == nil {
+					return
+				}
+
+				targetState, diags := deprecated.moveState(ctx, req.SourceState.Raw, resp.TargetState.Schema.Type().TerraformType(ctx))
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.TargetState.Raw = targetState
+			},
+		})
+	}
+	return movers
+}
+
+// hcpProviderAddress is the address of the provider, the state of deprecated integration resources is only moved
+// from resources of the provider.
+const hcpProviderAddress = "registry.terraform.io/hashicorp/hcp"
+
+// moveState returns the state of the integration resource of type targetType, from the state of the deprecated
+// integration resource. The credential blocks are renamed, and provider_type is set to the provider of the
+// deprecated resource.
+func (d deprecatedIntegration) moveState(ctx context.Context, sourceState tftypes.Value, targetType tftypes.Type) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var sourceAttrs map[string]tftypes.Value
+	if err := sourceState.As(&sourceAttrs); err != nil {
+		diags.AddError("Unable to Move Resource State", fmt.Sprintf("Unable to read the state of the deprecated integration resource: %v", err))
+		return tftypes.Value{}, diags
+	}
+
+	attrs := make(map[string]tftypes.Value, len(sourceAttrs)+1)
+	for name, value := range sourceAttrs {
+		if target, ok := d.credentials[name]; ok {
+			name = target
+		}
+		attrs[name] = value
+	}
+	attrs["provider_type"] = tftypes.NewValue(tftypes.String, string(d.provider))
+
+	targetState, err := convertValue(tftypes.NewValue(tftypes.Object{AttributeTypes: attributeTypes(attrs)}, attrs), targetType)
+	if err != nil {
+		diags.AddError("Unable to Move Resource State", fmt.Sprintf("Unable to convert the state of the deprecated integration resource: %v", err))
+		return tftypes.Value{}, diags
+	}
+	return targetState, diags
+}
+
+// attributeTypes returns the types of the values of attrs.
+func attributeTypes(attrs map[string]tftypes.Value) map[string]tftypes.Type {
+	attrTypes := make(map[string]tftypes.Type, len(attrs))
+	for name, value := range attrs {
+		attrTypes[name] = value.Type()
+	}
+	return attrTypes
+}
+
+// convertValue converts value to targetType. The attributes of objects missing from value, such as write-only
+// credentials, are set to null, and the ones missing from targetType are dropped.
+func convertValue(value tftypes.Value, targetType tftypes.Type) (tftypes.Value, error) {
+	if value.IsNull() {
+		return tftypes.NewValue(targetType, nil), nil
+	}
+	if !value.IsKnown() {
+		return tftypes.NewValue(targetType, tftypes.UnknownValue), nil
+	}
+
+	objectType, ok := targetType.(tftypes.Object)
+	if !ok {
+		if !value.Type().Equal(targetType) {
+			return tftypes.Value{}, fmt.Errorf("expected a value of type %s, got %s", targetType, value.Type())
+		}
+		return value, nil
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := value.As(&attrs); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	converted := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		attr, ok := attrs[name]
+		if !ok {
+			converted[name] = tftypes.NewValue(attrType, nil)
+			continue
+		}
+
+		var err error
+		converted[name], err = convertValue(attr, attrType)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return tftypes.NewValue(targetType, converted), nil
+}
+
 var _ hvsResource = &Integration{}
 
 func (i *Integration) projectID() types.String {
@@ -473,7 +713,7 @@ func (i *Integration) initModel(ctx context.Context, orgID, projID string) diag.
 	}
 
 	if !i.AwsAccessKeys.IsNull() {
-		ak := accessKeys{}
+		ak := integrationAccessKeys{}
 		diags = i.AwsAccessKeys.As(ctx, &ak, basetypes.ObjectAsOptions{})
 		if diags.HasError() {
 			return diags
@@ -481,7 +721,7 @@ func (i *Integration) initModel(ctx context.Context, orgID, projID string) diag.
 
 		i.awsAccessKeys = &secretmodels.Secrets20231128AwsAccessKeysRequest{
 			AccessKeyID:     ak.AccessKeyID.ValueString(),
-			SecretAccessKey: ak.SecretAccessKey.ValueString(),
+			SecretAccessKey: writeOnlyOrValue(ak.SecretAccessKeyWO, ak.SecretAccessKey),
 		}
 	}
 
@@ -499,7 +739,7 @@ func (i *Integration) initModel(ctx context.Context, orgID, projID string) diag.
 	}
 
 	if !i.AzureClientSecret.IsNull() {
-		cs := clientSecret{}
+		cs := integrationClientSecret{}
 		diags = i.AzureClientSecret.As(ctx, &cs, basetypes.ObjectAsOptions{})
 		if diags.HasError() {
 			return diags
@@ -508,7 +748,7 @@ func (i *Integration) initModel(ctx context.Context, orgID, projID string) diag.
 		i.azureClientSecret = &secretmodels.Secrets20231128AzureClientSecretRequest{
 			TenantID:     cs.TenantID.ValueString(),
 			ClientID:     cs.ClientID.ValueString(),
-			ClientSecret: cs.ClientSecret.ValueString(),
+			ClientSecret: writeOnlyOrValue(cs.ClientSecretWO, cs.ClientSecret),
 		}
 	}
 
@@ -527,7 +767,7 @@ func (i *Integration) initModel(ctx context.Context, orgID, projID string) diag.
 	}
 
 	if !i.ConfluentStaticCredentialDetails.IsNull() {
-		scd := confluentStaticCredentialDetails{}
+		scd := integrationConfluentStaticCredentialDetails{}
 		diags = i.ConfluentStaticCredentialDetails.As(ctx, &scd, basetypes.ObjectAsOptions{})
 		if diags.HasError() {
 			return diags
@@ -535,19 +775,19 @@ func (i *Integration) initModel(ctx context.Context, orgID, projID string) diag.
 
 		i.confluentStaticCredentials = &secretmodels.Secrets20231128ConfluentStaticCredentialsRequest{
 			CloudAPIKeyID:  scd.CloudAPIKeyID.ValueString(),
-			CloudAPISecret: scd.CloudAPISecret.ValueString(),
+			CloudAPISecret: writeOnlyOrValue(scd.CloudAPISecretWO, scd.CloudAPISecret),
 		}
 	}
 
 	if !i.GcpServiceAccountKey.IsNull() {
-		sa := serviceAccountKey{}
+		sa := integrationServiceAccountKey{}
 		diags = i.GcpServiceAccountKey.As(ctx, &sa, basetypes.ObjectAsOptions{})
 		if diags.HasError() {
 			return diags
 		}
 
 		i.gcpServiceAccountKey = &secretmodels.Secrets20231128GcpServiceAccountKeyRequest{
-			Credentials: sa.Credentials.ValueString(),
+			Credentials: writeOnlyOrValue(sa.CredentialsWO, sa.Credentials),
 		}
 	}
 
@@ -565,7 +805,7 @@ func (i *Integration) initModel(ctx context.Context, orgID, projID string) diag.
 	}
 
 	if !i.MongoDBAtlasStaticCredentials.IsNull() {
-		scd := mongoDBAtlasStaticCredentialDetails{}
+		scd := integrationMongoDBAtlasStaticCredentialDetails{}
 		diags = i.MongoDBAtlasStaticCredentials.As(ctx, &scd, basetypes.ObjectAsOptions{})
 		if diags.HasError() {
 			return diags
@@ -573,12 +813,12 @@ func (i *Integration) initModel(ctx context.Context, orgID, projID string) diag.
 
 		i.mongoDBAtlasStaticCredentials = &secretmodels.Secrets20231128MongoDBAtlasStaticCredentialsRequest{
 			APIPublicKey:  scd.APIPublicKey.ValueString(),
-			APIPrivateKey: scd.APIPrivateKey.ValueString(),
+			APIPrivateKey: writeOnlyOrValue(scd.APIPrivateKeyWO, scd.APIPrivateKey),
 		}
 	}
 
 	if !i.TwilioStaticCredentials.IsNull() {
-		scd := staticCredentialDetails{}
+		scd := integrationStaticCredentialDetails{}
 		diags = i.TwilioStaticCredentials.As(ctx, &scd, basetypes.ObjectAsOptions{})
 		if diags.HasError() {
 			return diags
@@ -586,7 +826,7 @@ func (i *Integration) initModel(ctx context.Context, orgID, projID string) diag.
 
 		i.twilioStaticCredentials = &secretmodels.Secrets20231128TwilioStaticCredentialsRequest{
 			AccountSid:   scd.AccountSID.ValueString(),
-			APIKeySecret: scd.APIKeySecret.ValueString(),
+			APIKeySecret: writeOnlyOrValue(scd.APIKeySecretWO, scd.APIKeySecret),
 			APIKeySid:    scd.APIKeySID.ValueString(),
 		}
 	}
@@ -599,7 +839,7 @@ func (i *Integration) initModel(ctx context.Context, orgID, projID string) diag.
 		}
 
 		i.gitlabAccess = &secretmodels.Secrets20231128GitlabAccessTokenRequest{
-			Token: gad.AccessToken.ValueString(),
+			Token: writeOnlyOrValue(gad.AccessTokenWO, gad.AccessToken),
 		}
 	}
 
@@ -632,15 +872,10 @@ func (i *Integration) fromModel(ctx context.Context, orgID, projID string, model
 	}
 
 	if integrationModel.AwsAccessKeys != nil {
-		secretAccessKey := ""
-		if i.awsAccessKeys != nil {
-			secretAccessKey = i.awsAccessKeys.SecretAccessKey
-		}
+		values := sensitiveCredentialValues(i.AwsAccessKeys, "secret_access_key")
+		values["access_key_id"] = types.StringValue(integrationModel.AwsAccessKeys.AccessKeyID)
 
-		i.AwsAccessKeys, diags = types.ObjectValue(i.AwsAccessKeys.AttributeTypes(ctx), map[string]attr.Value{
-			"access_key_id":     types.StringValue(integrationModel.AwsAccessKeys.AccessKeyID),
-			"secret_access_key": types.StringValue(secretAccessKey),
-		})
+		i.AwsAccessKeys, diags = types.ObjectValue(i.AwsAccessKeys.AttributeTypes(ctx), values)
 		if diags.HasError() {
 			return diags
 		}
@@ -657,15 +892,11 @@ func (i *Integration) fromModel(ctx context.Context, orgID, projID string, model
 	}
 
 	if integrationModel.AzureClientSecret != nil {
-		clientSecret := ""
-		if i.azureClientSecret != nil {
-			clientSecret = i.azureClientSecret.ClientSecret
-		}
-		i.AzureClientSecret, diags = types.ObjectValue(i.AzureClientSecret.AttributeTypes(ctx), map[string]attr.Value{
-			"tenant_id":     types.StringValue(integrationModel.AzureClientSecret.TenantID),
-			"client_id":     types.StringValue(integrationModel.AzureClientSecret.ClientID),
-			"client_secret": types.StringValue(clientSecret),
-		})
+		values := sensitiveCredentialValues(i.AzureClientSecret, "client_secret")
+		values["tenant_id"] = types.StringValue(integrationModel.AzureClientSecret.TenantID)
+		values["client_id"] = types.StringValue(integrationModel.AzureClientSecret.ClientID)
+
+		i.AzureClientSecret, diags = types.ObjectValue(i.AzureClientSecret.AttributeTypes(ctx), values)
 		if diags.HasError() {
 			return diags
 		}
@@ -683,31 +914,21 @@ func (i *Integration) fromModel(ctx context.Context, orgID, projID string, model
 	}
 
 	if integrationModel.ConfluentStaticCredentials != nil {
-		cloudAPISecret := ""
-		if i.confluentStaticCredentials != nil {
-			cloudAPISecret = i.confluentStaticCredentials.CloudAPISecret
-		}
+		values := sensitiveCredentialValues(i.ConfluentStaticCredentialDetails, "cloud_api_secret")
+		values["cloud_api_key_id"] = types.StringValue(integrationModel.ConfluentStaticCredentials.CloudAPIKeyID)
 
-		i.ConfluentStaticCredentialDetails, diags = types.ObjectValue(i.ConfluentStaticCredentialDetails.AttributeTypes(ctx), map[string]attr.Value{
-			"cloud_api_key_id": types.StringValue(integrationModel.ConfluentStaticCredentials.CloudAPIKeyID),
-			"cloud_api_secret": types.StringValue(cloudAPISecret),
-		})
+		i.ConfluentStaticCredentialDetails, diags = types.ObjectValue(i.ConfluentStaticCredentialDetails.AttributeTypes(ctx), values)
 		if diags.HasError() {
 			return diags
 		}
 	}
 
 	if integrationModel.GcpServiceAccountKey != nil {
-		credentials := ""
-		if i.gcpServiceAccountKey != nil {
-			credentials = i.gcpServiceAccountKey.Credentials
-		}
+		values := sensitiveCredentialValues(i.GcpServiceAccountKey, "credentials")
+		values["project_id"] = types.StringValue(integrationModel.GcpServiceAccountKey.ProjectID)
+		values["client_email"] = types.StringValue(integrationModel.GcpServiceAccountKey.ClientEmail)
 
-		i.GcpServiceAccountKey, diags = types.ObjectValue(i.GcpServiceAccountKey.AttributeTypes(ctx), map[string]attr.Value{
-			"credentials":  types.StringValue(credentials),
-			"project_id":   types.StringValue(integrationModel.GcpServiceAccountKey.ProjectID),
-			"client_email": types.StringValue(integrationModel.GcpServiceAccountKey.ClientEmail),
-		})
+		i.GcpServiceAccountKey, diags = types.ObjectValue(i.GcpServiceAccountKey.AttributeTypes(ctx), values)
 		if diags.HasError() {
 			return diags
 		}
@@ -724,45 +945,28 @@ func (i *Integration) fromModel(ctx context.Context, orgID, projID string, model
 	}
 
 	if integrationModel.MongoDbAtlasStaticCredentials != nil {
-		apiPrivateKey := ""
-		if i.mongoDBAtlasStaticCredentials != nil {
-			apiPrivateKey = i.mongoDBAtlasStaticCredentials.APIPrivateKey
-		}
+		values := sensitiveCredentialValues(i.MongoDBAtlasStaticCredentials, "api_private_key")
+		values["api_public_key"] = types.StringValue(integrationModel.MongoDbAtlasStaticCredentials.APIPublicKey)
 
-		i.MongoDBAtlasStaticCredentials, diags = types.ObjectValue(i.MongoDBAtlasStaticCredentials.AttributeTypes(ctx), map[string]attr.Value{
-			"api_public_key":  types.StringValue(integrationModel.MongoDbAtlasStaticCredentials.APIPublicKey),
-			"api_private_key": types.StringValue(apiPrivateKey),
-		})
+		i.MongoDBAtlasStaticCredentials, diags = types.ObjectValue(i.MongoDBAtlasStaticCredentials.AttributeTypes(ctx), values)
 		if diags.HasError() {
 			return diags
 		}
 	}
 
 	if integrationModel.TwilioStaticCredentials != nil {
-		apiKeySecret := ""
-		if i.twilioStaticCredentials != nil {
-			apiKeySecret = i.twilioStaticCredentials.APIKeySecret
-		}
+		values := sensitiveCredentialValues(i.TwilioStaticCredentials, "api_key_secret")
+		values["account_sid"] = types.StringValue(integrationModel.TwilioStaticCredentials.AccountSid)
+		values["api_key_sid"] = types.StringValue(integrationModel.TwilioStaticCredentials.APIKeySid)
 
-		i.TwilioStaticCredentials, diags = types.ObjectValue(i.TwilioStaticCredentials.AttributeTypes(ctx), map[string]attr.Value{
-			"account_sid":    types.StringValue(integrationModel.TwilioStaticCredentials.AccountSid),
-			"api_key_sid":    types.StringValue(integrationModel.TwilioStaticCredentials.APIKeySid),
-			"api_key_secret": types.StringValue(apiKeySecret),
-		})
+		i.TwilioStaticCredentials, diags = types.ObjectValue(i.TwilioStaticCredentials.AttributeTypes(ctx), values)
 		if diags.HasError() {
 			return diags
 		}
 	}
 
 	if integrationModel.GitlabAccessToken != nil {
-		accessToken := ""
-		if i.gitlabAccess != nil {
-			accessToken = i.gitlabAccess.Token
-		}
-
-		i.GitLabAccess, diags = types.ObjectValue(i.GitLabAccess.AttributeTypes(ctx), map[string]attr.Value{
-			"token": types.StringValue(accessToken),
-		})
+		i.GitLabAccess, diags = types.ObjectValue(i.GitLabAccess.AttributeTypes(ctx), sensitiveCredentialValues(i.GitLabAccess, "token"))
 		if diags.HasError() {
 			return diags
 		}
@@ -770,3 +974,87 @@ func (i *Integration) fromModel(ctx context.Context, orgID, projID string, model
 
 	return diags
 }
+
+// withWriteOnlyCredential adds a sensitive credential attribute to the attributes of a credential block, along
+// with its write-only variant and the version used to trigger updates of the write-only value.
+func withWriteOnlyCredential(attributes map[string]schema.Attribute, name, description string) map[string]schema.Attribute {
+	writeOnlyName := name + "_wo"
+
+	attributes[name] = schema.StringAttribute{
+		Description: description + fmt.Sprintf(" Exactly one of `%s` or `%s` must be set.", name, writeOnlyName),
+		Optional:    true,
+		Sensitive:   true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName(writeOnlyName)),
+		},
+	}
+	attributes[writeOnlyName] = schema.StringAttribute{
+		Description: description + " This value is write-only and is not stored in the Terraform state. Requires Terraform 1.11 or later.",
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+	}
+	attributes[writeOnlyName+"_version"] = schema.Int64Attribute{
+		Description: fmt.Sprintf("Version of the `%s` value. Terraform cannot detect changes to write-only values, change this version to update it.", writeOnlyName),
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName(writeOnlyName)),
+		},
+	}
+
+	return attributes
+}
+
+// planWithWriteOnlyCredentials returns a resourceFunc reading the plan merged with the write-only credentials
+// of the configuration, as Terraform does not include write-only values in the plan.
+func planWithWriteOnlyCredentials(plan tfsdk.Plan, config tfsdk.Config) resourceFunc {
+	return func(ctx context.Context, target interface{}) diag.Diagnostics {
+		for _, p := range integrationWriteOnlyPaths {
+			var value types.String
+			diags := config.GetAttribute(ctx, p, &value)
+			if diags.HasError() {
+				return diags
+			}
+			if value.IsNull() {
+				continue
+			}
+
+			diags = plan.SetAttribute(ctx, p, value)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		return plan.Get(ctx, target)
+	}
+}
+
+// writeOnlyOrValue returns the write-only value of a credential if set, its regular value otherwise.
+func writeOnlyOrValue(writeOnly, value types.String) string {
+	if !writeOnly.IsNull() {
+		return writeOnly.ValueString()
+	}
+	return value.ValueString()
+}
+
+// sensitiveCredentialValues returns the values of a sensitive credential attribute and its write-only companions
+// to store in the state. The Vault Secrets API does not return sensitive values, so they are carried over from
+// the given credential block, and initialized to an empty value on import. Write-only values are never stored.
+func sensitiveCredentialValues(credentials types.Object, name string) map[string]attr.Value {
+	values := map[string]attr.Value{
+		name:                 types.StringValue(""),
+		name + "_wo":         types.StringNull(),
+		name + "_wo_version": types.Int64Null(),
+	}
+	if credentials.IsNull() || credentials.IsUnknown() {
+		return values
+	}
+
+	attributes := credentials.Attributes()
+	for _, key := range []string{name, name + "_wo_version"} {
+		if value, ok := attributes[key]; ok {
+			values[key] = value
+		}
+	}
+	return values
+}
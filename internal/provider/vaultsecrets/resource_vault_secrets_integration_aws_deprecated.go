@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,17 +20,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 	"golang.org/x/exp/maps"
 )
 
 type IntegrationAWS struct {
 	// Input fields
-	ProjectID                 types.String `tfsdk:"project_id"`
-	Name                      types.String `tfsdk:"name"`
-	Capabilities              types.Set    `tfsdk:"capabilities"`
-	AccessKeys                types.Object `tfsdk:"access_keys"`
-	FederatedWorkloadIdentity types.Object `tfsdk:"federated_workload_identity"`
+	ProjectID                 types.String   `tfsdk:"project_id"`
+	Name                      types.String   `tfsdk:"name"`
+	Capabilities              types.Set      `tfsdk:"capabilities"`
+	AccessKeys                types.Object   `tfsdk:"access_keys"`
+	FederatedWorkloadIdentity types.Object   `tfsdk:"federated_workload_identity"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
 
 	// Computed fields
 	OrganizationID types.String `tfsdk:"organization_id"`
@@ -57,6 +60,7 @@ var _ resource.Resource = &resourceVaultSecretsIntegrationAWS{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsIntegrationAWS{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsIntegrationAWS{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsIntegrationAWS{}
+var _ identity.ResourceWithIdentity = &resourceVaultSecretsIntegrationAWS{}
 
 func NewVaultSecretsIntegrationAWSResource() resource.Resource {
 	return &resourceVaultSecretsIntegrationAWS{}
@@ -70,7 +74,7 @@ func (r *resourceVaultSecretsIntegrationAWS) Metadata(_ context.Context, req res
 	resp.TypeName = req.ProviderTypeName + "_vault_secrets_integration_aws"
 }
 
-func (r *resourceVaultSecretsIntegrationAWS) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceVaultSecretsIntegrationAWS) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	attributes := map[string]schema.Attribute{
 		"access_keys": schema.SingleNestedAttribute{
 			Description: "AWS IAM key pair used to authenticate against the target AWS account. Cannot be used with `federated_workload_identity`.",
@@ -119,6 +123,18 @@ func (r *resourceVaultSecretsIntegrationAWS) Schema(_ context.Context, _ resourc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets AWS integration resource manages an AWS integration.",
 		Attributes:          attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceVaultSecretsIntegrationAWS) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			identity.Name("The name of the Vault Secrets integration."),
+		},
 	}
 }
 
@@ -142,6 +158,13 @@ func (r *resourceVaultSecretsIntegrationAWS) ModifyPlan(ctx context.Context, req
 }
 
 func (r *resourceVaultSecretsIntegrationAWS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Read, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationAWS](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationAWS)
 		if !ok {
@@ -164,6 +187,13 @@ func (r *resourceVaultSecretsIntegrationAWS) Read(ctx context.Context, req resou
 }
 
 func (r *resourceVaultSecretsIntegrationAWS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Create, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationAWS](ctx, r.client, &resp.State, req.Plan.Get, "creating", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationAWS)
 		if !ok {
@@ -171,6 +201,7 @@ func (r *resourceVaultSecretsIntegrationAWS) Create(ctx context.Context, req res
 		}
 
 		response, err := r.client.VaultSecrets.CreateAwsIntegration(&secret_service.CreateAwsIntegrationParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceCreateAwsIntegrationBody{
 				AccessKeys:                integration.accessKeys,
 				Capabilities:              integration.capabilities,
@@ -191,6 +222,13 @@ func (r *resourceVaultSecretsIntegrationAWS) Create(ctx context.Context, req res
 }
 
 func (r *resourceVaultSecretsIntegrationAWS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Update, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationAWS](ctx, r.client, &resp.State, req.Plan.Get, "updating", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationAWS)
 		if !ok {
@@ -198,6 +236,7 @@ func (r *resourceVaultSecretsIntegrationAWS) Update(ctx context.Context, req res
 		}
 
 		response, err := r.client.VaultSecrets.UpdateAwsIntegration(&secret_service.UpdateAwsIntegrationParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceUpdateAwsIntegrationBody{
 				AccessKeys:                integration.accessKeys,
 				Capabilities:              integration.capabilities,
@@ -218,6 +257,13 @@ func (r *resourceVaultSecretsIntegrationAWS) Update(ctx context.Context, req res
 }
 
 func (r *resourceVaultSecretsIntegrationAWS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Delete, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationAWS](ctx, r.client, &resp.State, req.State.Get, "deleting", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationAWS)
 		if !ok {
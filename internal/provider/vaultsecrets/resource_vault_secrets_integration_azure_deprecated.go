@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,17 +20,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 	"golang.org/x/exp/maps"
 )
 
 type IntegrationAzure struct {
 	// Input fields
-	ProjectID                 types.String `tfsdk:"project_id"`
-	Name                      types.String `tfsdk:"name"`
-	Capabilities              types.Set    `tfsdk:"capabilities"`
-	ClientSecret              types.Object `tfsdk:"client_secret"`
-	FederatedWorkloadIdentity types.Object `tfsdk:"federated_workload_identity"`
+	ProjectID                 types.String   `tfsdk:"project_id"`
+	Name                      types.String   `tfsdk:"name"`
+	Capabilities              types.Set      `tfsdk:"capabilities"`
+	ClientSecret              types.Object   `tfsdk:"client_secret"`
+	FederatedWorkloadIdentity types.Object   `tfsdk:"federated_workload_identity"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
 
 	// Computed fields
 	OrganizationID types.String `tfsdk:"organization_id"`
@@ -59,6 +62,7 @@ var _ resource.Resource = &resourceVaultSecretsIntegrationAzure{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsIntegrationAzure{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsIntegrationAzure{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsIntegrationAzure{}
+var _ identity.ResourceWithIdentity = &resourceVaultSecretsIntegrationAzure{}
 
 func NewVaultSecretsIntegrationAzureResource() resource.Resource {
 	return &resourceVaultSecretsIntegrationAzure{}
@@ -72,7 +76,7 @@ func (r *resourceVaultSecretsIntegrationAzure) Metadata(_ context.Context, req r
 	resp.TypeName = req.ProviderTypeName + "_vault_secrets_integration_azure"
 }
 
-func (r *resourceVaultSecretsIntegrationAzure) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceVaultSecretsIntegrationAzure) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	attributes := map[string]schema.Attribute{
 		"client_secret": schema.SingleNestedAttribute{
 			Description: "Azure client secret used to authenticate against the target Azure application. Cannot be used with `federated_workload_identity`.",
@@ -128,6 +132,18 @@ func (r *resourceVaultSecretsIntegrationAzure) Schema(_ context.Context, _ resou
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets Azure integration resource manages an Azure integration.",
 		Attributes:          attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceVaultSecretsIntegrationAzure) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			identity.Name("The name of the Vault Secrets integration."),
+		},
 	}
 }
 
@@ -151,6 +167,13 @@ func (r *resourceVaultSecretsIntegrationAzure) ModifyPlan(ctx context.Context, r
 }
 
 func (r *resourceVaultSecretsIntegrationAzure) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Read, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationAzure](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationAzure)
 		if !ok {
@@ -173,6 +196,13 @@ func (r *resourceVaultSecretsIntegrationAzure) Read(ctx context.Context, req res
 }
 
 func (r *resourceVaultSecretsIntegrationAzure) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Create, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationAzure](ctx, r.client, &resp.State, req.Plan.Get, "creating", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationAzure)
 		if !ok {
@@ -180,6 +210,7 @@ func (r *resourceVaultSecretsIntegrationAzure) Create(ctx context.Context, req r
 		}
 
 		response, err := r.client.VaultSecrets.CreateAzureIntegration(&secret_service.CreateAzureIntegrationParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceCreateAzureIntegrationBody{
 				Capabilities:              integration.capabilities,
 				FederatedWorkloadIdentity: integration.federatedWorkloadIdentity,
@@ -200,6 +231,13 @@ func (r *resourceVaultSecretsIntegrationAzure) Create(ctx context.Context, req r
 }
 
 func (r *resourceVaultSecretsIntegrationAzure) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Update, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationAzure](ctx, r.client, &resp.State, req.Plan.Get, "updating", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationAzure)
 		if !ok {
@@ -207,6 +245,7 @@ func (r *resourceVaultSecretsIntegrationAzure) Update(ctx context.Context, req r
 		}
 
 		response, err := r.client.VaultSecrets.UpdateAzureIntegration(&secret_service.UpdateAzureIntegrationParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceUpdateAzureIntegrationBody{
 				Capabilities:              integration.capabilities,
 				FederatedWorkloadIdentity: integration.federatedWorkloadIdentity,
@@ -227,6 +266,13 @@ func (r *resourceVaultSecretsIntegrationAzure) Update(ctx context.Context, req r
 }
 
 func (r *resourceVaultSecretsIntegrationAzure) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Delete, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationAzure](ctx, r.client, &resp.State, req.State.Get, "deleting", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationAzure)
 		if !ok {
@@ -234,7 +280,7 @@ func (r *resourceVaultSecretsIntegrationAzure) Delete(ctx context.Context, req r
 		}
 
 		_, err := r.client.VaultSecrets.DeleteAzureIntegration(
-			secret_service.NewDeleteAzureIntegrationParams().
+			secret_service.NewDeleteAzureIntegrationParamsWithContext(ctx).
 				WithOrganizationID(integration.OrganizationID.ValueString()).
 				WithProjectID(integration.ProjectID.ValueString()).
 				WithName(integration.Name.ValueString()), nil)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,15 +20,17 @@ import (
 	"golang.org/x/exp/maps"
 
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 )
 
 type IntegrationConfluent struct {
 	// Input fields
-	ProjectID               types.String `tfsdk:"project_id"`
-	Name                    types.String `tfsdk:"name"`
-	Capabilities            types.Set    `tfsdk:"capabilities"`
-	StaticCredentialDetails types.Object `tfsdk:"static_credential_details"`
+	ProjectID               types.String   `tfsdk:"project_id"`
+	Name                    types.String   `tfsdk:"name"`
+	Capabilities            types.Set      `tfsdk:"capabilities"`
+	StaticCredentialDetails types.Object   `tfsdk:"static_credential_details"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
 
 	// Computed fields
 	OrganizationID types.String `tfsdk:"organization_id"`
@@ -49,6 +52,7 @@ var _ resource.Resource = &resourceVaultSecretsIntegrationConfluent{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsIntegrationConfluent{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsIntegrationConfluent{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsIntegrationConfluent{}
+var _ identity.ResourceWithIdentity = &resourceVaultSecretsIntegrationConfluent{}
 
 func NewVaultSecretsIntegrationsConfluentResource() resource.Resource {
 	return &resourceVaultSecretsIntegrationConfluent{}
@@ -62,7 +66,7 @@ func (r *resourceVaultSecretsIntegrationConfluent) Metadata(_ context.Context, r
 	resp.TypeName = req.ProviderTypeName + "_vault_secrets_integration_confluent"
 }
 
-func (r *resourceVaultSecretsIntegrationConfluent) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceVaultSecretsIntegrationConfluent) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	attributes := map[string]schema.Attribute{
 		"static_credential_details": schema.SingleNestedAttribute{
 			Description: "Confluent API key used to authenticate for cloud apis.",
@@ -87,6 +91,18 @@ func (r *resourceVaultSecretsIntegrationConfluent) Schema(_ context.Context, _ r
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets Confluent integration resource manages an Confluent integration.",
 		Attributes:          attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceVaultSecretsIntegrationConfluent) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			identity.Name("The name of the Vault Secrets integration."),
+		},
 	}
 }
 
@@ -110,6 +126,13 @@ func (r *resourceVaultSecretsIntegrationConfluent) ModifyPlan(ctx context.Contex
 }
 
 func (r *resourceVaultSecretsIntegrationConfluent) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Read, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationConfluent](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationConfluent)
 		if !ok {
@@ -132,6 +155,13 @@ func (r *resourceVaultSecretsIntegrationConfluent) Read(ctx context.Context, req
 }
 
 func (r *resourceVaultSecretsIntegrationConfluent) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Create, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationConfluent](ctx, r.client, &resp.State, req.Plan.Get, "creating", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationConfluent)
 		if !ok {
@@ -139,6 +169,7 @@ func (r *resourceVaultSecretsIntegrationConfluent) Create(ctx context.Context, r
 		}
 
 		response, err := r.client.VaultSecrets.CreateConfluentIntegration(&secret_service.CreateConfluentIntegrationParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceCreateConfluentIntegrationBody{
 				Capabilities:            integration.capabilities,
 				Name:                    integration.Name.ValueString(),
@@ -158,6 +189,13 @@ func (r *resourceVaultSecretsIntegrationConfluent) Create(ctx context.Context, r
 }
 
 func (r *resourceVaultSecretsIntegrationConfluent) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Update, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationConfluent](ctx, r.client, &resp.State, req.Plan.Get, "updating", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationConfluent)
 		if !ok {
@@ -165,6 +203,7 @@ func (r *resourceVaultSecretsIntegrationConfluent) Update(ctx context.Context, r
 		}
 
 		response, err := r.client.VaultSecrets.UpdateConfluentIntegration(&secret_service.UpdateConfluentIntegrationParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceUpdateConfluentIntegrationBody{
 				Capabilities:            integration.capabilities,
 				StaticCredentialDetails: integration.staticCredentialDetails,
@@ -184,6 +223,13 @@ func (r *resourceVaultSecretsIntegrationConfluent) Update(ctx context.Context, r
 }
 
 func (r *resourceVaultSecretsIntegrationConfluent) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Delete, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationConfluent](ctx, r.client, &resp.State, req.State.Get, "deleting", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationConfluent)
 		if !ok {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -19,17 +20,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 	"golang.org/x/exp/maps"
 )
 
 type IntegrationGCP struct {
 	// Input fields
-	ProjectID                 types.String `tfsdk:"project_id"`
-	Name                      types.String `tfsdk:"name"`
-	Capabilities              types.Set    `tfsdk:"capabilities"`
-	ServiceAccountKey         types.Object `tfsdk:"service_account_key"`
-	FederatedWorkloadIdentity types.Object `tfsdk:"federated_workload_identity"`
+	ProjectID                 types.String   `tfsdk:"project_id"`
+	Name                      types.String   `tfsdk:"name"`
+	Capabilities              types.Set      `tfsdk:"capabilities"`
+	ServiceAccountKey         types.Object   `tfsdk:"service_account_key"`
+	FederatedWorkloadIdentity types.Object   `tfsdk:"federated_workload_identity"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
 
 	// Computed fields
 	OrganizationID types.String `tfsdk:"organization_id"`
@@ -58,6 +61,7 @@ var _ resource.Resource = &resourceVaultSecretsIntegrationGCP{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsIntegrationGCP{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsIntegrationGCP{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsIntegrationGCP{}
+var _ identity.ResourceWithIdentity = &resourceVaultSecretsIntegrationGCP{}
 
 func NewVaultSecretsIntegrationGCPResource() resource.Resource {
 	return &resourceVaultSecretsIntegrationGCP{}
@@ -71,7 +75,7 @@ func (r *resourceVaultSecretsIntegrationGCP) Metadata(_ context.Context, req res
 	resp.TypeName = req.ProviderTypeName + "_vault_secrets_integration_gcp"
 }
 
-func (r *resourceVaultSecretsIntegrationGCP) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceVaultSecretsIntegrationGCP) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	attributes := map[string]schema.Attribute{
 		"service_account_key": schema.SingleNestedAttribute{
 			Description: "GCP service account key used to authenticate against the target GCP project. Cannot be used with `federated_workload_identity`.",
@@ -123,6 +127,18 @@ func (r *resourceVaultSecretsIntegrationGCP) Schema(_ context.Context, _ resourc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets GCP integration resource manages an GCP integration.",
 		Attributes:          attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceVaultSecretsIntegrationGCP) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			identity.Name("The name of the Vault Secrets integration."),
+		},
 	}
 }
 
@@ -146,6 +162,13 @@ func (r *resourceVaultSecretsIntegrationGCP) ModifyPlan(ctx context.Context, req
 }
 
 func (r *resourceVaultSecretsIntegrationGCP) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Read, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationGCP](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationGCP)
 		if !ok {
@@ -168,6 +191,13 @@ func (r *resourceVaultSecretsIntegrationGCP) Read(ctx context.Context, req resou
 }
 
 func (r *resourceVaultSecretsIntegrationGCP) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Create, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationGCP](ctx, r.client, &resp.State, req.Plan.Get, "creating", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationGCP)
 		if !ok {
@@ -175,6 +205,7 @@ func (r *resourceVaultSecretsIntegrationGCP) Create(ctx context.Context, req res
 		}
 
 		response, err := r.client.VaultSecrets.CreateGcpIntegration(&secret_service.CreateGcpIntegrationParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceCreateGcpIntegrationBody{
 				Capabilities:              integration.capabilities,
 				FederatedWorkloadIdentity: integration.federatedWorkloadIdentity,
@@ -195,6 +226,13 @@ func (r *resourceVaultSecretsIntegrationGCP) Create(ctx context.Context, req res
 }
 
 func (r *resourceVaultSecretsIntegrationGCP) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Update, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationGCP](ctx, r.client, &resp.State, req.Plan.Get, "updating", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationGCP)
 		if !ok {
@@ -202,6 +240,7 @@ func (r *resourceVaultSecretsIntegrationGCP) Update(ctx context.Context, req res
 		}
 
 		response, err := r.client.VaultSecrets.UpdateGcpIntegration(&secret_service.UpdateGcpIntegrationParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceUpdateGcpIntegrationBody{
 				Capabilities:              integration.capabilities,
 				FederatedWorkloadIdentity: integration.federatedWorkloadIdentity,
@@ -222,6 +261,13 @@ func (r *resourceVaultSecretsIntegrationGCP) Update(ctx context.Context, req res
 }
 
 func (r *resourceVaultSecretsIntegrationGCP) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Delete, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationGCP](ctx, r.client, &resp.State, req.State.Get, "deleting", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationGCP)
 		if !ok {
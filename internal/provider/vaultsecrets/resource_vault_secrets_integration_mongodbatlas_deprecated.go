@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -17,16 +18,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 	"golang.org/x/exp/maps"
 )
 
 type IntegrationMongoDBAtlas struct {
 	// Input fields
-	ProjectID               types.String `tfsdk:"project_id"`
-	Name                    types.String `tfsdk:"name"`
-	Capabilities            types.Set    `tfsdk:"capabilities"`
-	StaticCredentialDetails types.Object `tfsdk:"static_credential_details"`
+	ProjectID               types.String   `tfsdk:"project_id"`
+	Name                    types.String   `tfsdk:"name"`
+	Capabilities            types.Set      `tfsdk:"capabilities"`
+	StaticCredentialDetails types.Object   `tfsdk:"static_credential_details"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
 
 	// Computed fields
 	OrganizationID types.String `tfsdk:"organization_id"`
@@ -48,6 +51,7 @@ var _ resource.Resource = &resourceVaultSecretsIntegrationMongoDBAtlas{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsIntegrationMongoDBAtlas{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsIntegrationMongoDBAtlas{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsIntegrationMongoDBAtlas{}
+var _ identity.ResourceWithIdentity = &resourceVaultSecretsIntegrationMongoDBAtlas{}
 
 func NewVaultSecretsIntegrationMongoDBAtlasResource() resource.Resource {
 	return &resourceVaultSecretsIntegrationMongoDBAtlas{}
@@ -61,7 +65,7 @@ func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Metadata(_ context.Context
 	resp.TypeName = req.ProviderTypeName + "_vault_secrets_integration_mongodbatlas"
 }
 
-func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	attributes := map[string]schema.Attribute{
 		"static_credential_details": schema.SingleNestedAttribute{
 			Description: "MongoDB Atlas API key used to authenticate against the target project.",
@@ -86,6 +90,18 @@ func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Schema(_ context.Context,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets MongoDB Atlas integration resource manages an MongoDB Atlas integration.",
 		Attributes:          attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			identity.Name("The name of the Vault Secrets integration."),
+		},
 	}
 }
 
@@ -109,6 +125,13 @@ func (r *resourceVaultSecretsIntegrationMongoDBAtlas) ModifyPlan(ctx context.Con
 }
 
 func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Read, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationMongoDBAtlas](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationMongoDBAtlas)
 		if !ok {
@@ -131,6 +154,13 @@ func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Read(ctx context.Context,
 }
 
 func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Create, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationMongoDBAtlas](ctx, r.client, &resp.State, req.Plan.Get, "creating", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationMongoDBAtlas)
 		if !ok {
@@ -138,6 +168,7 @@ func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Create(ctx context.Context
 		}
 
 		response, err := r.client.VaultSecrets.CreateMongoDBAtlasIntegration(&secret_service.CreateMongoDBAtlasIntegrationParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceCreateMongoDBAtlasIntegrationBody{
 				Capabilities:            integration.capabilities,
 				Name:                    integration.Name.ValueString(),
@@ -157,6 +188,13 @@ func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Create(ctx context.Context
 }
 
 func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Update, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationMongoDBAtlas](ctx, r.client, &resp.State, req.Plan.Get, "updating", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationMongoDBAtlas)
 		if !ok {
@@ -164,6 +202,7 @@ func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Update(ctx context.Context
 		}
 
 		response, err := r.client.VaultSecrets.UpdateMongoDBAtlasIntegration(&secret_service.UpdateMongoDBAtlasIntegrationParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceUpdateMongoDBAtlasIntegrationBody{
 				Capabilities:            integration.capabilities,
 				StaticCredentialDetails: integration.staticCredentialDetails,
@@ -183,6 +222,13 @@ func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Update(ctx context.Context
 }
 
 func (r *resourceVaultSecretsIntegrationMongoDBAtlas) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Delete, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationMongoDBAtlas](ctx, r.client, &resp.State, req.State.Get, "deleting", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationMongoDBAtlas)
 		if !ok {
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceVaultSecretsIntegration_MoveState(t *testing.T) {
+	ctx := context.Background()
+	target := NewVaultSecretsIntegrationResource().(*resourceVaultSecretsIntegration)
+
+	var targetSchema resource.SchemaResponse
+	target.Schema(ctx, resource.SchemaRequest{}, &targetSchema)
+	require.False(t, targetSchema.Diagnostics.HasError(), targetSchema.Diagnostics)
+
+	// moveState runs the state movers of the integration resource on the
+	// state of a deprecated resource.
+	moveState := func(t *testing.T, sourceTypeName string, source resource.Resource, sourceAttrs map[string]any) resource.MoveStateResponse {
+		t.Helper()
+
+		var sourceSchema resource.SchemaResponse
+		source.Schema(ctx, resource.SchemaRequest{}, &sourceSchema)
+		sourceState := newTestState(t, sourceSchema.Schema, sourceAttrs)
+
+		resp := resource.MoveStateResponse{TargetState: newTestState(t, targetSchema.Schema, nil)}
+		req := resource.MoveStateRequest{
+			SourceProviderAddress: hcpProviderAddress,
+			SourceTypeName:        sourceTypeName,
+			SourceState:           &sourceState,
+		}
+		for _, mover := range target.MoveState(ctx) {
+			mover.StateMover(ctx, req, &resp)
+			if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+				break
+			}
+		}
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		return resp
+	}
+
+	// sources holds the state of every deprecated integration resource, and the credential field it's expected to
+	// move to the integration resource.
+	sources := map[Provider]struct {
+		attrs      map[string]any
+		credential path.Path
+		want       string
+		check      func(t *testing.T, state Integration)
+	}{
+		ProviderAWS: {
+			attrs: map[string]any{
+				"name":            "my-integration",
+				"project_id":      "project",
+				"organization_id": "org",
+				"capabilities":    []string{"DYNAMIC"},
+				"access_keys": accessKeys{
+					AccessKeyID:     types.StringValue("key-id"),
+					SecretAccessKey: types.StringValue("secret"),
+				},
+			},
+			credential: path.Root("aws_access_keys").AtName("access_key_id"),
+			want:       "key-id",
+			check: func(t *testing.T, state Integration) {
+				assert.Equal(t, "project", state.ProjectID.ValueString())
+				assert.True(t, state.AwsFederatedWorkloadIdentity.IsNull())
+
+				var accessKeys integrationAccessKeys
+				require.False(t, state.AwsAccessKeys.As(ctx, &accessKeys, basetypes.ObjectAsOptions{}).HasError())
+				assert.Equal(t, "secret", accessKeys.SecretAccessKey.ValueString())
+				assert.True(t, accessKeys.SecretAccessKeyWO.IsNull())
+				assert.True(t, accessKeys.SecretAccessKeyWOVersion.IsNull())
+			},
+		},
+		ProviderAzure: {
+			attrs: map[string]any{
+				"name":         "my-integration",
+				"capabilities": []string{"DYNAMIC"},
+				"client_secret": clientSecret{
+					TenantID:     types.StringValue("tenant"),
+					ClientID:     types.StringValue("client"),
+					ClientSecret: types.StringValue("client-secret"),
+				},
+			},
+			credential: path.Root("azure_client_secret").AtName("client_secret"),
+			want:       "client-secret",
+		},
+		ProviderConfluent: {
+			attrs: map[string]any{
+				"name":         "my-integration",
+				"capabilities": []string{"ROTATION"},
+				"static_credential_details": confluentStaticCredentialDetails{
+					CloudAPIKeyID:  types.StringValue("key"),
+					CloudAPISecret: types.StringValue("secret"),
+				},
+			},
+			credential: path.Root("confluent_static_credentials").AtName("cloud_api_key_id"),
+			want:       "key",
+		},
+		ProviderGCP: {
+			attrs: map[string]any{
+				"name":         "my-integration",
+				"capabilities": []string{"DYNAMIC"},
+				"service_account_key": serviceAccountKey{
+					Credentials: types.StringValue("{}"),
+					ProjectID:   types.StringValue("gcp-project"),
+					ClientEmail: types.StringValue("sa@example.com"),
+				},
+			},
+			credential: path.Root("gcp_service_account_key").AtName("client_email"),
+			want:       "sa@example.com",
+		},
+		ProviderMongoDBAtlas: {
+			attrs: map[string]any{
+				"name":         "my-integration",
+				"capabilities": []string{"ROTATION"},
+				"static_credential_details": mongoDBAtlasStaticCredentialDetails{
+					APIPublicKey:  types.StringValue("public"),
+					APIPrivateKey: types.StringValue("private"),
+				},
+			},
+			credential: path.Root("mongodb_atlas_static_credentials").AtName("api_public_key"),
+			want:       "public",
+		},
+		ProviderTwilio: {
+			attrs: map[string]any{
+				"name":         "my-integration",
+				"capabilities": []string{"ROTATION"},
+				"static_credential_details": staticCredentialDetails{
+					AccountSID:   types.StringValue("account"),
+					APIKeySID:    types.StringValue("key"),
+					APIKeySecret: types.StringValue("secret"),
+				},
+			},
+			credential: path.Root("twilio_static_credentials").AtName("account_sid"),
+			want:       "account",
+		},
+	}
+
+	for _, deprecated := range deprecatedIntegrations {
+		t.Run(string(deprecated.provider), func(t *testing.T) {
+			source, ok := sources[deprecated.provider]
+			require.True(t, ok, "missing the state of the deprecated %s integration resource", deprecated.provider)
+
+			r := deprecated.newResource()
+			var metadata resource.MetadataResponse
+			r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "hcp"}, &metadata)
+			resp := moveState(t, metadata.TypeName, r, source.attrs)
+
+			var state Integration
+			require.False(t, resp.TargetState.Get(ctx, &state).HasError())
+			assert.Equal(t, string(deprecated.provider), state.Provider.ValueString())
+			assert.Equal(t, "my-integration", state.Name.ValueString())
+
+			var credential types.String
+			require.False(t, resp.TargetState.GetAttribute(ctx, source.credential, &credential).HasError())
+			assert.Equal(t, source.want, credential.ValueString())
+
+			if source.check != nil {
+				source.check(t, state)
+			}
+		})
+	}
+
+	t.Run("other resource", func(t *testing.T) {
+		resp := moveState(t, "hcp_vault_secrets_app", NewVaultSecretsIntegrationAWSResource(), map[string]any{
+			"name": "my-integration",
+		})
+		assert.True(t, resp.TargetState.Raw.IsNull())
+	})
+}
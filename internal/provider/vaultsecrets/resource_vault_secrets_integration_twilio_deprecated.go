@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -19,15 +20,17 @@ import (
 	"golang.org/x/exp/maps"
 
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 )
 
 type IntegrationTwilio struct {
 	// Input fields
-	ProjectID               types.String `tfsdk:"project_id"`
-	Name                    types.String `tfsdk:"name"`
-	Capabilities            types.Set    `tfsdk:"capabilities"`
-	StaticCredentialDetails types.Object `tfsdk:"static_credential_details"`
+	ProjectID               types.String   `tfsdk:"project_id"`
+	Name                    types.String   `tfsdk:"name"`
+	Capabilities            types.Set      `tfsdk:"capabilities"`
+	StaticCredentialDetails types.Object   `tfsdk:"static_credential_details"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
 
 	// Computed fields
 	OrganizationID types.String `tfsdk:"organization_id"`
@@ -50,6 +53,7 @@ var _ resource.Resource = &resourceVaultSecretsIntegrationTwilio{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsIntegrationTwilio{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsIntegrationTwilio{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsIntegrationTwilio{}
+var _ identity.ResourceWithIdentity = &resourceVaultSecretsIntegrationTwilio{}
 
 func NewVaultSecretsIntegrationTwilioResource() resource.Resource {
 	return &resourceVaultSecretsIntegrationTwilio{}
@@ -63,7 +67,7 @@ func (r *resourceVaultSecretsIntegrationTwilio) Metadata(_ context.Context, req
 	resp.TypeName = req.ProviderTypeName + "_vault_secrets_integration_twilio"
 }
 
-func (r *resourceVaultSecretsIntegrationTwilio) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceVaultSecretsIntegrationTwilio) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	attributes := map[string]schema.Attribute{
 		"static_credential_details": schema.SingleNestedAttribute{
 			Description: "Twilio API key parts used to authenticate against the target Twilio account.",
@@ -92,6 +96,18 @@ func (r *resourceVaultSecretsIntegrationTwilio) Schema(_ context.Context, _ reso
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets Twilio integration resource manages a Twilio integration.",
 		Attributes:          attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceVaultSecretsIntegrationTwilio) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			identity.Name("The name of the Vault Secrets integration."),
+		},
 	}
 }
 
@@ -115,6 +131,13 @@ func (r *resourceVaultSecretsIntegrationTwilio) ModifyPlan(ctx context.Context,
 }
 
 func (r *resourceVaultSecretsIntegrationTwilio) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Read, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationTwilio](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationTwilio)
 		if !ok {
@@ -137,6 +160,13 @@ func (r *resourceVaultSecretsIntegrationTwilio) Read(ctx context.Context, req re
 }
 
 func (r *resourceVaultSecretsIntegrationTwilio) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Create, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationTwilio](ctx, r.client, &resp.State, req.Plan.Get, "creating", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationTwilio)
 		if !ok {
@@ -144,6 +174,7 @@ func (r *resourceVaultSecretsIntegrationTwilio) Create(ctx context.Context, req
 		}
 
 		response, err := r.client.VaultSecrets.CreateTwilioIntegration(&secret_service.CreateTwilioIntegrationParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceCreateTwilioIntegrationBody{
 				Capabilities:            integration.capabilities,
 				StaticCredentialDetails: integration.staticCredentialDetails,
@@ -163,6 +194,13 @@ func (r *resourceVaultSecretsIntegrationTwilio) Create(ctx context.Context, req
 }
 
 func (r *resourceVaultSecretsIntegrationTwilio) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Update, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationTwilio](ctx, r.client, &resp.State, req.Plan.Get, "updating", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationTwilio)
 		if !ok {
@@ -170,6 +208,7 @@ func (r *resourceVaultSecretsIntegrationTwilio) Update(ctx context.Context, req
 		}
 
 		response, err := r.client.VaultSecrets.UpdateTwilioIntegration(&secret_service.UpdateTwilioIntegrationParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceUpdateTwilioIntegrationBody{
 				Capabilities:            integration.capabilities,
 				StaticCredentialDetails: integration.staticCredentialDetails,
@@ -189,6 +228,13 @@ func (r *resourceVaultSecretsIntegrationTwilio) Update(ctx context.Context, req
 }
 
 func (r *resourceVaultSecretsIntegrationTwilio) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Delete, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*IntegrationTwilio](ctx, r.client, &resp.State, req.State.Get, "deleting", func(i hvsResource) (any, error) {
 		integration, ok := i.(*IntegrationTwilio)
 		if !ok {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -22,6 +23,7 @@ import (
 	"golang.org/x/exp/maps"
 
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 )
 
@@ -58,12 +60,13 @@ var rotatingSecretsImpl = map[Provider]rotatingSecret{
 
 type RotatingSecret struct {
 	// Shared input fields
-	ProjectID          types.String `tfsdk:"project_id"`
-	AppName            types.String `tfsdk:"app_name"`
-	SecretProvider     types.String `tfsdk:"secret_provider"`
-	Name               types.String `tfsdk:"name"`
-	IntegrationName    types.String `tfsdk:"integration_name"`
-	RotationPolicyName types.String `tfsdk:"rotation_policy_name"`
+	ProjectID          types.String   `tfsdk:"project_id"`
+	AppName            types.String   `tfsdk:"app_name"`
+	SecretProvider     types.String   `tfsdk:"secret_provider"`
+	Name               types.String   `tfsdk:"name"`
+	IntegrationName    types.String   `tfsdk:"integration_name"`
+	RotationPolicyName types.String   `tfsdk:"rotation_policy_name"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 
 	// Provider specific mutually exclusive fields
 	AWSAccessKeys            *awsAccessKeys            `tfsdk:"aws_access_keys"`
@@ -107,6 +110,7 @@ type twilioAPIKey struct{}
 var _ resource.Resource = &resourceVaultSecretsRotatingSecret{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsRotatingSecret{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsRotatingSecret{}
+var _ identity.ResourceWithIdentity = &resourceVaultSecretsRotatingSecret{}
 
 func NewVaultSecretsRotatingSecretResource() resource.Resource {
 	return &resourceVaultSecretsRotatingSecret{}
@@ -120,7 +124,7 @@ func (r *resourceVaultSecretsRotatingSecret) Metadata(_ context.Context, req res
 	resp.TypeName = req.ProviderTypeName + "_vault_secrets_rotating_secret"
 }
 
-func (r *resourceVaultSecretsRotatingSecret) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceVaultSecretsRotatingSecret) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	attributes := map[string]schema.Attribute{
 		"rotation_policy_name": schema.StringAttribute{
 			Description: "Name of the rotation policy that governs the rotation of the secret.",
@@ -246,6 +250,22 @@ func (r *resourceVaultSecretsRotatingSecret) Schema(_ context.Context, _ resourc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets rotating secret resource manages a rotating secret configuration.",
 		Attributes:          attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceVaultSecretsRotatingSecret) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			{
+				Name:        "app_name",
+				Description: "The name of the Vault Secrets app of the secret.",
+			},
+			identity.Name("The name of the rotating secret."),
+		},
 	}
 }
 
@@ -269,6 +289,13 @@ func (r *resourceVaultSecretsRotatingSecret) ModifyPlan(ctx context.Context, req
 }
 
 func (r *resourceVaultSecretsRotatingSecret) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Read, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*RotatingSecret](ctx, r.client, &resp.State, req.State.Get, "reading", func(s hvsResource) (any, error) {
 		secret, ok := s.(*RotatingSecret)
 		if !ok {
@@ -284,6 +311,13 @@ func (r *resourceVaultSecretsRotatingSecret) Read(ctx context.Context, req resou
 }
 
 func (r *resourceVaultSecretsRotatingSecret) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Create, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*RotatingSecret](ctx, r.client, &resp.State, req.Plan.Get, "creating", func(s hvsResource) (any, error) {
 		secret, ok := s.(*RotatingSecret)
 		if !ok {
@@ -299,6 +333,13 @@ func (r *resourceVaultSecretsRotatingSecret) Create(ctx context.Context, req res
 }
 
 func (r *resourceVaultSecretsRotatingSecret) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Update, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*RotatingSecret](ctx, r.client, &resp.State, req.Plan.Get, "updating", func(s hvsResource) (any, error) {
 		secret, ok := s.(*RotatingSecret)
 		if !ok {
@@ -314,6 +355,13 @@ func (r *resourceVaultSecretsRotatingSecret) Update(ctx context.Context, req res
 }
 
 func (r *resourceVaultSecretsRotatingSecret) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Delete, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*RotatingSecret](ctx, r.client, &resp.State, req.State.Get, "deleting", func(s hvsResource) (any, error) {
 		secret, ok := s.(*RotatingSecret)
 		if !ok {
@@ -6,23 +6,38 @@ package vaultsecrets
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"regexp"
+	"strconv"
 
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/customdiags"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 )
 
 var _ resource.Resource = &resourceVaultsecretsSecret{}
 var _ resource.ResourceWithConfigure = &resourceVaultsecretsSecret{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultsecretsSecret{}
+var _ identity.ResourceWithIdentity = &resourceVaultsecretsSecret{}
+
+// writtenVersionKey is the key of the private state holding the latest
+// version of the secret written by Terraform. A different latest version in
+// the state means the secret was updated outside of Terraform.
+const writtenVersionKey = "written_version"
 
 func NewVaultSecretsSecretResource() resource.Resource {
 	return &resourceVaultsecretsSecret{}
@@ -33,19 +48,25 @@ type resourceVaultsecretsSecret struct {
 }
 
 type VaultSecretsSecret struct {
-	ID             types.String `tfsdk:"id"`
-	AppName        types.String `tfsdk:"app_name"`
-	SecretName     types.String `tfsdk:"secret_name"`
-	SecretValue    types.String `tfsdk:"secret_value"`
-	ProjectID      types.String `tfsdk:"project_id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
+	ID                   types.String   `tfsdk:"id"`
+	AppName              types.String   `tfsdk:"app_name"`
+	SecretName           types.String   `tfsdk:"secret_name"`
+	SecretValue          types.String   `tfsdk:"secret_value"`
+	SecretValueWO        types.String   `tfsdk:"secret_value_wo"`
+	SecretValueWOVersion types.Int64    `tfsdk:"secret_value_wo_version"`
+	RestoreVersion       types.Int64    `tfsdk:"restore_version"`
+	SecretType           types.String   `tfsdk:"secret_type"`
+	LatestVersion        types.Int64    `tfsdk:"latest_version"`
+	ProjectID            types.String   `tfsdk:"project_id"`
+	OrganizationID       types.String   `tfsdk:"organization_id"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *resourceVaultsecretsSecret) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_vault_secrets_secret"
 }
 
-func (r *resourceVaultsecretsSecret) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceVaultsecretsSecret) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets secret resource manages a secret within a given application.",
 		Attributes: map[string]schema.Attribute{
@@ -84,9 +105,46 @@ func (r *resourceVaultsecretsSecret) Schema(_ context.Context, _ resource.Schema
 				},
 			},
 			"secret_value": schema.StringAttribute{
-				Description: "The value of the secret",
-				Required:    true,
+				Description: "The value of the secret. Exactly one of `secret_value`, `secret_value_wo` or `restore_version` must be set.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("secret_value_wo"), path.MatchRoot("restore_version")),
+				},
+			},
+			"secret_value_wo": schema.StringAttribute{
+				Description: "The value of the secret. This value is write-only and is not stored in the Terraform state. Requires `secret_value_wo_version` to be set, and Terraform 1.11 or later.",
+				Optional:    true,
 				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("secret_value_wo_version")),
+				},
+			},
+			"secret_value_wo_version": schema.Int64Attribute{
+				Description: "Version of the `secret_value_wo` value. Terraform cannot detect changes to write-only values, change this version to update the secret.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("secret_value_wo")),
+				},
+			},
+			"restore_version": schema.Int64Attribute{
+				Description: "Version of the secret to restore. The value of this version is written as a new version of the secret, and is not stored in the Terraform state. Change this version to restore another version.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"secret_type": schema.StringAttribute{
+				Description: "The type of the secret. Secrets whose type was changed outside of Terraform are replaced by a static secret.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"latest_version": schema.Int64Attribute{
+				Description: "The latest version of the secret. A new version written outside of Terraform is overwritten with the configured value.",
+				Computed:    true,
 			},
 			"project_id": schema.StringAttribute{
 				Description: "The ID of the HCP project where the HCP Vault Secrets secret is located.",
@@ -102,6 +160,25 @@ func (r *resourceVaultsecretsSecret) Schema(_ context.Context, _ resource.Schema
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceVaultsecretsSecret) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			{
+				Name:        "app_name",
+				Description: "The name of the Vault Secrets app of the secret.",
+			},
+			{
+				Name:        "secret_name",
+				Description: "The name of the secret.",
+			},
+		},
 	}
 }
 
@@ -123,6 +200,39 @@ func (r *resourceVaultsecretsSecret) Configure(_ context.Context, req resource.C
 
 func (r *resourceVaultsecretsSecret) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	modifiers.ModifyPlanForDefaultProjectChange(ctx, r.client.Config.ProjectID, req.State, req.Config, req.Plan, resp)
+
+	// Changes made outside of Terraform are only reconciled for secrets that
+	// are neither created nor destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state VaultSecretsSecret
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The secret was turned into a rotating or dynamic secret, it's replaced
+	// by the static secret of the configuration.
+	if !state.SecretType.IsNull() && state.SecretType.ValueString() != staticSecretType {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_type"), types.StringValue(staticSecretType))...)
+		resp.RequiresReplace.Append(path.Root("secret_type"))
+		return
+	}
+
+	// A new version of the secret was written outside of Terraform, the
+	// configured value is written again. This also covers write-only values,
+	// which can't be compared with the value of the secret.
+	writtenVersion, diags := req.Private.GetKey(ctx, writtenVersionKey)
+	resp.Diagnostics.Append(diags...)
+	if writtenVersion == nil || state.LatestVersion.IsNull() {
+		return
+	}
+	version, err := strconv.ParseInt(string(writtenVersion), 10, 64)
+	if err == nil && version != state.LatestVersion.ValueInt64() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("latest_version"), types.Int64Unknown())...)
+	}
 }
 
 func (r *resourceVaultsecretsSecret) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -133,6 +243,15 @@ func (r *resourceVaultsecretsSecret) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !plan.ProjectID.IsUnknown() {
 		projectID = plan.ProjectID.ValueString()
@@ -143,17 +262,33 @@ func (r *resourceVaultsecretsSecret) Create(ctx context.Context, req resource.Cr
 		ProjectID:      projectID,
 	}
 
-	res, err := clients.CreateVaultSecretsAppSecret(ctx, r.client, loc, plan.AppName.ValueString(), plan.SecretName.ValueString(), plan.SecretValue.ValueString())
+	secretValue, diags := r.secretValue(ctx, loc, plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := clients.CreateVaultSecretsAppSecret(ctx, r.client, loc, plan.AppName.ValueString(), plan.SecretName.ValueString(), secretValue)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating secret", err.Error())
+		// Only an invalid or already existing secret name is the fault of the
+		// secret_name attribute.
+		if apiErr := customdiags.ParseAPIError(err); apiErr != nil &&
+			(apiErr.HTTPStatusCode == http.StatusBadRequest || apiErr.HTTPStatusCode == http.StatusConflict) {
+			resp.Diagnostics.Append(customdiags.NewAttributeAPIError(path.Root("secret_name"), "Error creating secret", err))
+			return
+		}
+		resp.Diagnostics.Append(customdiags.NewAPIError("Error creating secret", err))
 		return
 	}
 
 	plan.ID = plan.AppName
 	plan.SecretName = types.StringValue(res.Name)
+	plan.SecretType = types.StringValue(staticSecretType)
+	plan.LatestVersion = types.Int64Value(res.LatestVersion)
 	plan.OrganizationID = types.StringValue(loc.OrganizationID)
 	plan.ProjectID = types.StringValue(loc.ProjectID)
 
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, writtenVersionKey, []byte(strconv.FormatInt(res.LatestVersion, 10)))...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -165,6 +300,15 @@ func (r *resourceVaultsecretsSecret) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	timeout, diags := state.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !state.ProjectID.IsUnknown() {
 		projectID = state.ProjectID.ValueString()
@@ -177,13 +321,35 @@ func (r *resourceVaultsecretsSecret) Read(ctx context.Context, req resource.Read
 
 	res, err := clients.OpenVaultSecretsAppSecret(ctx, r.client, loc, state.AppName.ValueString(), state.SecretName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), "Error reading secret")
+		// The HVS API returns 403 if the app of the secret doesn't exist even
+		// if the principal has the correct permissions.
+		if clients.IsResponseCodeNotFound(err) || clients.IsResponseForbidden(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(customdiags.NewAPIError("Error reading secret", err))
 		return
 	}
 
-	// TODO: so the resource can only create a static secret,
-	// what happens when a user tries to import a rotating/other type of secret?
-	state.SecretValue = types.StringValue(res.StaticVersion.Value)
+	// Secrets that are no longer static are replaced, see ModifyPlan, their
+	// values are left as is until then. The value of a secret managed with
+	// the write-only argument, or restored from a previous version, must not
+	// be stored in the state. Imported secrets set neither, so their value is
+	// read.
+	state.SecretType = types.StringValue(res.Type)
+	state.LatestVersion = types.Int64Value(res.LatestVersion)
+	if res.StaticVersion != nil && state.SecretValueWOVersion.IsNull() && state.RestoreVersion.IsNull() {
+		state.SecretValue = types.StringValue(res.StaticVersion.Value)
+	}
+
+	// Secrets that were imported, or written by an earlier version of the
+	// provider, are assumed to be up to date with their latest version. The
+	// private state isn't set when the secret is read for a list block.
+	writtenVersion, diags := req.Private.GetKey(ctx, writtenVersionKey)
+	resp.Diagnostics.Append(diags...)
+	if writtenVersion == nil && resp.Private != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, writtenVersionKey, []byte(strconv.FormatInt(res.LatestVersion, 10)))...)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -196,6 +362,15 @@ func (r *resourceVaultsecretsSecret) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Update(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !plan.ProjectID.IsUnknown() {
 		projectID = plan.ProjectID.ValueString()
@@ -206,17 +381,26 @@ func (r *resourceVaultsecretsSecret) Update(ctx context.Context, req resource.Up
 		ProjectID:      projectID,
 	}
 
-	res, err := clients.CreateVaultSecretsAppSecret(ctx, r.client, loc, plan.AppName.ValueString(), plan.SecretName.ValueString(), plan.SecretValue.ValueString())
+	secretValue, diags := r.secretValue(ctx, loc, plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := clients.CreateVaultSecretsAppSecret(ctx, r.client, loc, plan.AppName.ValueString(), plan.SecretName.ValueString(), secretValue)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating secret", err.Error())
+		resp.Diagnostics.Append(customdiags.NewAPIError("Error updating secret", err))
 		return
 	}
 
 	plan.ID = plan.AppName
 	plan.SecretName = types.StringValue(res.Name)
+	plan.SecretType = types.StringValue(staticSecretType)
+	plan.LatestVersion = types.Int64Value(res.LatestVersion)
 	plan.OrganizationID = types.StringValue(loc.OrganizationID)
 	plan.ProjectID = types.StringValue(loc.ProjectID)
 
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, writtenVersionKey, []byte(strconv.FormatInt(res.LatestVersion, 10)))...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -228,6 +412,15 @@ func (r *resourceVaultsecretsSecret) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	timeout, diags := state.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !state.ProjectID.IsUnknown() {
 		projectID = state.ProjectID.ValueString()
@@ -240,7 +433,38 @@ func (r *resourceVaultsecretsSecret) Delete(ctx context.Context, req resource.De
 
 	err := clients.DeleteVaultSecretsAppSecret(ctx, r.client, loc, state.AppName.ValueString(), state.SecretName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting secret", err.Error())
+		resp.Diagnostics.Append(customdiags.NewAPIError("Error deleting secret", err))
 		return
 	}
 }
+
+// secretValue returns the value of the secret to write, either from the plan,
+// from the version to restore or, if the write-only argument is used, from the
+// configuration.
+func (r *resourceVaultsecretsSecret) secretValue(ctx context.Context, loc *sharedmodels.HashicorpCloudLocationLocation, plan VaultSecretsSecret, config tfsdk.Config) (string, diag.Diagnostics) {
+	if !plan.SecretValue.IsNull() {
+		return plan.SecretValue.ValueString(), nil
+	}
+
+	if !plan.RestoreVersion.IsNull() {
+		var diags diag.Diagnostics
+		secret, err := clients.OpenVaultSecretsAppSecretVersion(ctx, r.client, loc, plan.AppName.ValueString(), plan.SecretName.ValueString(), plan.RestoreVersion.ValueInt64())
+		if err != nil {
+			diags.Append(customdiags.NewAttributeAPIError(path.Root("restore_version"), "Error reading the version of the secret to restore", err))
+			return "", diags
+		}
+		if secret.StaticVersion == nil {
+			diags.AddAttributeError(
+				path.Root("restore_version"),
+				"Unsupported secret version",
+				fmt.Sprintf("Version %d of secret %q is not a static secret version and can't be restored.", plan.RestoreVersion.ValueInt64(), plan.SecretName.ValueString()),
+			)
+			return "", diags
+		}
+		return secret.StaticVersion.Value, diags
+	}
+
+	var secretValueWO types.String
+	diags := config.GetAttribute(ctx, path.Root("secret_value_wo"), &secretValueWO)
+	return secretValueWO.ValueString(), diags
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+func TestResourceVaultSecretsSecret_CreateErrors(t *testing.T) {
+	f := newResourceFixture(t, func(client *clients.Client) *resourceVaultsecretsSecret {
+		return &resourceVaultsecretsSecret{client: client}
+	})
+	ctx, loc, r := f.ctx, f.loc, f.r
+
+	_, err := clients.CreateVaultSecretsApp(ctx, f.client, loc, "example", "")
+	require.NoError(t, err)
+
+	// create creates the secret and returns the diagnostics of the creation.
+	create := func(t *testing.T, appName, secretName string) diag.Diagnostics {
+		t.Helper()
+
+		plan := f.newPlan(t, map[string]any{
+			"app_name":                appName,
+			"secret_name":             secretName,
+			"secret_value":            "hunter2",
+			"secret_value_wo_version": types.Int64Null(),
+			"project_id":              loc.ProjectID,
+		})
+
+		resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+		r.Create(ctx, resource.CreateRequest{Plan: plan, Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+		return resp.Diagnostics
+	}
+
+	t.Run("invalid secret name", func(t *testing.T) {
+		diags := create(t, "example", "")
+		require.True(t, diags.HasError())
+		withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+		require.True(t, ok)
+		assert.Equal(t, path.Root("secret_name"), withPath.Path())
+	})
+
+	t.Run("missing app", func(t *testing.T) {
+		diags := create(t, "missing", "password")
+		require.True(t, diags.HasError())
+		_, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+		assert.False(t, ok)
+	})
+}
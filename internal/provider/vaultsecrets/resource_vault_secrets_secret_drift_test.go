@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+func TestResourceVaultSecretsSecret_Drift(t *testing.T) {
+	f := newResourceFixture(t, func(client *clients.Client) *resourceVaultsecretsSecret {
+		return &resourceVaultsecretsSecret{client: client}
+	})
+	ctx, client, loc, r, read := f.ctx, f.client, f.loc, f.r, f.read
+
+	_, err := clients.CreateVaultSecretsApp(ctx, client, loc, "example", "")
+	require.NoError(t, err)
+
+	// newSecret creates a secret with a version for every passed value.
+	newSecret := func(t *testing.T, name string, values ...string) {
+		t.Helper()
+
+		for _, value := range values {
+			_, err := clients.CreateVaultSecretsAppSecret(ctx, client, loc, "example", name, value)
+			require.NoError(t, err)
+		}
+	}
+
+	// newState returns the state of the secret as written by Terraform.
+	newState := func(t *testing.T, name string, overrides map[string]any) tfsdk.State {
+		t.Helper()
+
+		attrs := map[string]any{
+			"id":                      "example",
+			"app_name":                "example",
+			"secret_name":             name,
+			"secret_value":            "hunter2",
+			"secret_value_wo_version": types.Int64Null(),
+			"secret_type":             staticSecretType,
+			"latest_version":          int64(1),
+			"project_id":              loc.ProjectID,
+			"organization_id":         loc.OrganizationID,
+		}
+		maps.Copy(attrs, overrides)
+		return f.newState(t, attrs)
+	}
+
+	// modifyPlan plans the state of the secret again, with the passed latest
+	// version written by Terraform in its private state.
+	modifyPlan := func(t *testing.T, state tfsdk.State, writtenVersion string) resource.ModifyPlanResponse {
+		t.Helper()
+
+		req := resource.ModifyPlanRequest{
+			State:  state,
+			Plan:   tfsdk.Plan{Schema: state.Schema, Raw: state.Raw},
+			Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+		}
+		if writtenVersion != "" {
+			req.Private = newOf(req.Private)
+			require.False(t, req.Private.SetKey(ctx, writtenVersionKey, []byte(writtenVersion)).HasError())
+		}
+
+		resp := resource.ModifyPlanResponse{Plan: req.Plan}
+		r.ModifyPlan(ctx, req, &resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		return resp
+	}
+
+	t.Run("value written outside of Terraform", func(t *testing.T) {
+		newSecret(t, "changed", "hunter2", "correct-horse")
+
+		var secret VaultSecretsSecret
+		require.False(t, read(t, newState(t, "changed", nil)).Get(ctx, &secret).HasError())
+		assert.Equal(t, "correct-horse", secret.SecretValue.ValueString())
+		assert.Equal(t, int64(2), secret.LatestVersion.ValueInt64())
+		assert.Equal(t, staticSecretType, secret.SecretType.ValueString())
+	})
+
+	t.Run("write-only value", func(t *testing.T) {
+		newSecret(t, "write-only", "hunter2", "correct-horse")
+
+		var secret VaultSecretsSecret
+		require.False(t, read(t, newState(t, "write-only", map[string]any{
+			"secret_value":            types.StringNull(),
+			"secret_value_wo_version": int64(1),
+		})).Get(ctx, &secret).HasError())
+		assert.True(t, secret.SecretValue.IsNull())
+		assert.Equal(t, int64(2), secret.LatestVersion.ValueInt64())
+	})
+
+	t.Run("imported", func(t *testing.T) {
+		newSecret(t, "imported", "hunter2")
+
+		var secret VaultSecretsSecret
+		require.False(t, read(t, newState(t, "imported", map[string]any{"secret_value": types.StringNull()})).Get(ctx, &secret).HasError())
+		assert.Equal(t, "hunter2", secret.SecretValue.ValueString())
+	})
+
+	t.Run("version written outside of Terraform", func(t *testing.T) {
+		newSecret(t, "new-version", "hunter2", "correct-horse")
+		state := read(t, newState(t, "new-version", map[string]any{
+			"secret_value":            types.StringNull(),
+			"secret_value_wo_version": int64(1),
+		}))
+
+		// Terraform wrote the first version, the second one was written
+		// outside of Terraform, so the secret is written again.
+		var latestVersion types.Int64
+		resp := modifyPlan(t, state, "1")
+		resp.Plan.GetAttribute(ctx, path.Root("latest_version"), &latestVersion)
+		assert.True(t, latestVersion.IsUnknown())
+
+		resp = modifyPlan(t, state, "2")
+		resp.Plan.GetAttribute(ctx, path.Root("latest_version"), &latestVersion)
+		assert.Equal(t, int64(2), latestVersion.ValueInt64())
+	})
+
+	t.Run("type changed outside of Terraform", func(t *testing.T) {
+		newSecret(t, "rotated", "hunter2")
+
+		resp := modifyPlan(t, newState(t, "rotated", map[string]any{"secret_type": "rotating"}), "")
+
+		var secretType types.String
+		resp.Plan.GetAttribute(ctx, path.Root("secret_type"), &secretType)
+		assert.Equal(t, staticSecretType, secretType.ValueString())
+		assert.Contains(t, resp.RequiresReplace, path.Root("secret_type"))
+	})
+
+	t.Run("deleted outside of Terraform", func(t *testing.T) {
+		newSecret(t, "deleted", "hunter2")
+		require.NoError(t, clients.DeleteVaultSecretsAppSecret(ctx, client, loc, "example", "deleted"))
+		assert.True(t, read(t, newState(t, "deleted", nil)).Raw.IsNull())
+	})
+}
+
+// newOf returns a pointer to a new zero value of the type p points to. It
+// creates the private state of requests, whose type is internal to the
+// plugin framework.
+func newOf[T any](_ *T) *T {
+	return new(T)
+}
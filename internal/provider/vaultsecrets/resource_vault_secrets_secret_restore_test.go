@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+)
+
+func TestResourceVaultSecretsSecret_RestoreVersion(t *testing.T) {
+	f := newResourceFixture(t, func(client *clients.Client) *resourceVaultsecretsSecret {
+		return &resourceVaultsecretsSecret{client: client}
+	})
+	ctx, client, loc, r := f.ctx, f.client, f.loc, f.r
+
+	_, err := clients.CreateVaultSecretsApp(ctx, client, loc, "example", "")
+	require.NoError(t, err)
+	for _, value := range []string{"hunter2", "correct-horse"} {
+		_, err = clients.CreateVaultSecretsAppSecret(ctx, client, loc, "example", "password", value)
+		require.NoError(t, err)
+	}
+
+	state := f.newState(t, nil)
+	config := tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+
+	plan := VaultSecretsSecret{
+		AppName:        types.StringValue("example"),
+		SecretName:     types.StringValue("password"),
+		SecretValue:    types.StringNull(),
+		RestoreVersion: types.Int64Value(1),
+	}
+
+	t.Run("restores the value of the version", func(t *testing.T) {
+		value, diags := r.secretValue(ctx, loc, plan, config)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "hunter2", value)
+	})
+
+	t.Run("value of the plan takes precedence", func(t *testing.T) {
+		plan := plan
+		plan.SecretValue = types.StringValue("tr0ub4dor")
+		plan.RestoreVersion = types.Int64Null()
+
+		value, diags := r.secretValue(ctx, loc, plan, config)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, "tr0ub4dor", value)
+	})
+
+	t.Run("missing version", func(t *testing.T) {
+		plan := plan
+		plan.RestoreVersion = types.Int64Value(3)
+
+		_, diags := r.secretValue(ctx, loc, plan, config)
+		require.True(t, diags.HasError())
+		withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+		require.True(t, ok)
+		assert.Equal(t, path.Root("restore_version"), withPath.Path())
+	})
+}
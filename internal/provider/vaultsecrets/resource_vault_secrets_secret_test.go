@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -92,6 +93,55 @@ func TestAccVaultSecretsResourceSecret(t *testing.T) {
 	})
 }
 
+func TestAccVaultSecretsResourceSecretWriteOnly(t *testing.T) {
+	testAppName := generateRandomSlug()
+	secretName := "acc_tests_secret_wo"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					createTestApp(t, testAppName)
+				},
+				Config: fmt.Sprintf(`
+				resource "hcp_vault_secrets_secret" "example" {
+					app_name                = %q
+					secret_name             = %q
+					secret_value_wo         = "super secret"
+					secret_value_wo_version = 1
+				}`, testAppName, secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("hcp_vault_secrets_secret.example", "secret_name", secretName),
+					resource.TestCheckNoResourceAttr("hcp_vault_secrets_secret.example", "secret_value"),
+					resource.TestCheckNoResourceAttr("hcp_vault_secrets_secret.example", "secret_value_wo"),
+					resource.TestCheckResourceAttr("hcp_vault_secrets_secret.example", "secret_value_wo_version", "1"),
+				),
+			},
+			// Bumping the version writes the new write-only value.
+			{
+				Config: fmt.Sprintf(`
+				resource "hcp_vault_secrets_secret" "example" {
+					app_name                = %q
+					secret_name             = %q
+					secret_value_wo         = "another super secret"
+					secret_value_wo_version = 2
+				}`, testAppName, secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("hcp_vault_secrets_secret.example", "secret_value_wo"),
+					resource.TestCheckResourceAttr("hcp_vault_secrets_secret.example", "secret_value_wo_version", "2"),
+				),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			deleteTestApp(t, testAppName)
+			return nil
+		},
+	})
+}
+
 func testAccCheckSecretExists(t *testing.T, appName string, secretName string) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		if secretExists(t, appName, secretName) {
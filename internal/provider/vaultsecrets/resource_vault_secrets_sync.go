@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/client/secret_service"
 	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -24,15 +25,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 )
 
 type Sync struct {
-	ResourceID      types.String `tfsdk:"resource_id"`
-	Name            types.String `tfsdk:"name"`
-	IntegrationName types.String `tfsdk:"integration_name"`
-	OrganizationID  types.String `tfsdk:"organization_id"`
-	ProjectID       types.String `tfsdk:"project_id"`
+	ResourceID      types.String   `tfsdk:"resource_id"`
+	Name            types.String   `tfsdk:"name"`
+	IntegrationName types.String   `tfsdk:"integration_name"`
+	OrganizationID  types.String   `tfsdk:"organization_id"`
+	ProjectID       types.String   `tfsdk:"project_id"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 
 	// Destination-specific mutually exclusive fields
 	GitlabConfig types.Object `tfsdk:"gitlab_config"`
@@ -45,6 +48,7 @@ var _ resource.Resource = &resourceVaultSecretsSync{}
 var _ resource.ResourceWithConfigure = &resourceVaultSecretsSync{}
 var _ resource.ResourceWithModifyPlan = &resourceVaultSecretsSync{}
 var _ resource.ResourceWithImportState = &resourceVaultSecretsSync{}
+var _ identity.ResourceWithIdentity = &resourceVaultSecretsSync{}
 
 func NewVaultSecretsSyncResource() resource.Resource {
 	return &resourceVaultSecretsSync{}
@@ -58,7 +62,7 @@ func (r *resourceVaultSecretsSync) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_vault_secrets_sync"
 }
 
-func (r *resourceVaultSecretsSync) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceVaultSecretsSync) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	attributes := map[string]schema.Attribute{
 		"resource_id": schema.StringAttribute{
 			Description: "Resource ID used to uniquely identify the sync on the HCP platform.",
@@ -124,6 +128,22 @@ func (r *resourceVaultSecretsSync) Schema(_ context.Context, _ resource.SchemaRe
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Vault Secrets sync resource manages an integration.",
 		Attributes:          attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceVaultSecretsSync) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			identity.Name("The name of the Vault Secrets sync."),
+		},
 	}
 }
 
@@ -147,6 +167,13 @@ func (r *resourceVaultSecretsSync) ModifyPlan(ctx context.Context, req resource.
 }
 
 func (r *resourceVaultSecretsSync) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Read, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*Sync](ctx, r.client, &resp.State, req.State.Get, "reading", func(i hvsResource) (any, error) {
 		sync, ok := i.(*Sync)
 		if !ok {
@@ -170,6 +197,13 @@ func (r *resourceVaultSecretsSync) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *resourceVaultSecretsSync) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.Plan, timeouts.Value.Create, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*Sync](ctx, r.client, &resp.State, req.Plan.Get, "creating", func(i hvsResource) (any, error) {
 		sync, ok := i.(*Sync)
 		if !ok {
@@ -188,6 +222,7 @@ func (r *resourceVaultSecretsSync) Create(ctx context.Context, req resource.Crea
 		providerType := res.Payload.Integration.Provider
 
 		response, err := r.client.VaultSecrets.CreateSync(&secret_service.CreateSyncParams{
+			Context: ctx,
 			Body: &secretmodels.SecretServiceCreateSyncBody{
 				Name:             sync.Name.ValueString(),
 				IntegrationName:  sync.IntegrationName.ValueString(),
@@ -206,9 +241,21 @@ func (r *resourceVaultSecretsSync) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *resourceVaultSecretsSync) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// In-place update is not supported, except for the timeouts which only
+	// exist in the state.
+	var t timeouts.Value
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeouts"), &t)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), t)...)
 }
 
 func (r *resourceVaultSecretsSync) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel, diags := clients.WithOperationTimeout(ctx, &req.State, timeouts.Value.Delete, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer cancel()
+
 	resp.Diagnostics.Append(decorateOperation[*Sync](ctx, r.client, &resp.State, req.State.Get, "deleting", func(i hvsResource) (any, error) {
 		sync, ok := i.(*Sync)
 		if !ok {
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vaultsecrets
+
+import (
+	"context"
+	"testing"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpfake"
+)
+
+// resourceFixture is a resource configured with a client of a fake HCP API,
+// whose methods are called directly by unit tests.
+type resourceFixture[R resource.Resource] struct {
+	ctx    context.Context
+	client *clients.Client
+	loc    *sharedmodels.HashicorpCloudLocationLocation
+	r      R
+	schema schema.Schema
+}
+
+// newResourceFixture starts a fake HCP API and returns the resource created by
+// newResource with a client of it.
+func newResourceFixture[R resource.Resource](t *testing.T, newResource func(*clients.Client) R) *resourceFixture[R] {
+	t.Helper()
+
+	ctx := context.Background()
+	server := hcpfake.NewServer(t)
+	client := server.NewClient(t)
+	r := newResource(client)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	return &resourceFixture[R]{
+		ctx:    ctx,
+		client: client,
+		loc: &sharedmodels.HashicorpCloudLocationLocation{
+			OrganizationID: client.Config.OrganizationID,
+			ProjectID:      server.ProjectID(),
+		},
+		r:      r,
+		schema: schemaResp.Schema,
+	}
+}
+
+// newState returns a state of the resource with the passed attributes set,
+// the others are null.
+func (f *resourceFixture[R]) newState(t *testing.T, attrs map[string]any) tfsdk.State {
+	t.Helper()
+	return newTestState(t, f.schema, attrs)
+}
+
+// newPlan returns a plan of the resource with the passed attributes set, the
+// others are null.
+func (f *resourceFixture[R]) newPlan(t *testing.T, attrs map[string]any) tfsdk.Plan {
+	t.Helper()
+
+	state := newTestState(t, f.schema, attrs)
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+// create creates the resource planned by plan, whose configuration is the
+// plan itself, and returns its state.
+func (f *resourceFixture[R]) create(t *testing.T, plan tfsdk.Plan) tfsdk.State {
+	t.Helper()
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+	f.r.Create(f.ctx, resource.CreateRequest{Plan: plan, Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	return resp.State
+}
+
+// read refreshes state and returns the new state.
+func (f *resourceFixture[R]) read(t *testing.T, state tfsdk.State) tfsdk.State {
+	t.Helper()
+
+	resp := resource.ReadResponse{State: state}
+	f.r.Read(f.ctx, resource.ReadRequest{State: state}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	return resp.State
+}
+
+// newTestState returns a state of schema s with the passed attributes set,
+// the others are null.
+func newTestState(t *testing.T, s schema.Schema, attrs map[string]any) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	state := tfsdk.State{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+	}
+	for name, value := range attrs {
+		require.False(t, state.SetAttribute(ctx, path.Root(name), value).HasError())
+	}
+	return state
+}
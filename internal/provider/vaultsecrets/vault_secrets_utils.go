@@ -5,9 +5,12 @@ package vaultsecrets
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	secretmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-secrets/stable/2023-11-28/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/customdiags"
 )
 
 type Provider string
@@ -48,6 +52,10 @@ func ProviderStrings() []string {
 	}
 }
 
+// staticSecretType is the type of static secrets, the only type of secrets
+// that hcp_vault_secrets_secret manages.
+const staticSecretType = "kv"
+
 var (
 	secretNameValidator = stringvalidator.RegexMatches(regexp.MustCompile(`^[_\da-zA-Z]{3,36}$`),
 		"must contain only letters, numbers or underscores",
@@ -174,7 +182,7 @@ func decorateOperation[T hvsResource](ctx context.Context, c *clients.Client, st
 
 	model, err := operationFunc(concreteIntegration)
 	if err != nil {
-		diags.AddError(fmt.Sprintf("Error %s Vault Secrets resource", operation), err.Error())
+		diags.Append(customdiags.NewAPIError(fmt.Sprintf("Error %s Vault Secrets resource", operation), err))
 		return diags
 	}
 	if model == nil {
@@ -191,3 +199,73 @@ func decorateOperation[T hvsResource](ctx context.Context, c *clients.Client, st
 
 	return diags
 }
+
+// openSecretVersion opens the latest version of a secret, or the passed
+// version if it's set.
+func openSecretVersion(ctx context.Context, client *clients.Client, loc *sharedmodels.HashicorpCloudLocationLocation, appName, secretName string, version types.Int64) (*secretmodels.Secrets20231128OpenSecret, error) {
+	if version.IsNull() || version.IsUnknown() {
+		return clients.OpenVaultSecretsAppSecret(ctx, client, loc, appName, secretName)
+	}
+	return clients.OpenVaultSecretsAppSecretVersion(ctx, client, loc, appName, secretName, version.ValueInt64())
+}
+
+// openSecretValue returns the value of an opened secret. Static secrets are
+// returned verbatim, while the values of rotating and dynamic secrets are
+// encoded as a JSON object.
+func openSecretValue(secret *secretmodels.Secrets20231128OpenSecret) (string, error) {
+	switch {
+	case secret.StaticVersion != nil:
+		return secret.StaticVersion.Value, nil
+	case secret.RotatingVersion != nil:
+		secretData, err := json.Marshal(secret.RotatingVersion.Values)
+		if err != nil {
+			return "", fmt.Errorf("could not encode rotating secret as json: %w", err)
+		}
+		return string(secretData), nil
+	case secret.DynamicInstance != nil:
+		secretData, err := json.Marshal(secret.DynamicInstance.Values)
+		if err != nil {
+			return "", fmt.Errorf("could not encode dynamic secret as json: %w", err)
+		}
+		return string(secretData), nil
+	default:
+		return "", fmt.Errorf("HCP Secrets secret type %q is not currently supported by terraform-provider-hcp", secret.Type)
+	}
+}
+
+// openSecretValues returns the key/value pairs of an opened rotating or
+// dynamic secret. Static secrets have no key/value pairs and return an empty map.
+func openSecretValues(secret *secretmodels.Secrets20231128OpenSecret) map[string]string {
+	switch {
+	case secret.RotatingVersion != nil:
+		return secret.RotatingVersion.Values
+	case secret.DynamicInstance != nil:
+		return secret.DynamicInstance.Values
+	default:
+		return map[string]string{}
+	}
+}
+
+// flattenOpenAppSecrets flattens the opened secrets of an app into a single map.
+// Static secrets are keyed by their name, the values of rotating and dynamic
+// secrets are keyed by the secret name and value name joined by an underscore.
+func flattenOpenAppSecrets(secrets []*secretmodels.Secrets20231128OpenSecret) (map[string]string, error) {
+	openAppSecrets := map[string]string{}
+	for _, appSecret := range secrets {
+		switch {
+		case appSecret.StaticVersion != nil:
+			openAppSecrets[appSecret.Name] = appSecret.StaticVersion.Value
+		case appSecret.RotatingVersion != nil:
+			for name, value := range appSecret.RotatingVersion.Values {
+				openAppSecrets[appSecret.Name+"_"+name] = value
+			}
+		case appSecret.DynamicInstance != nil:
+			for name, value := range appSecret.DynamicInstance.Values {
+				openAppSecrets[appSecret.Name+"_"+name] = value
+			}
+		default:
+			return nil, fmt.Errorf("HCP Secrets secret type %q is not currently supported by terraform-provider-hcp", appSecret.Type)
+		}
+	}
+	return openAppSecrets, nil
+}
@@ -34,6 +34,17 @@ type DataSourceAction struct {
 	client *clients.Client
 }
 
+// ActionDataSourceModel describes the data source data model.
+type ActionDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	OrgID       types.String `tfsdk:"organization_id"`
+	Description types.String `tfsdk:"description"`
+
+	Request *actionRequest `tfsdk:"request"`
+}
+
 func NewActionDataSource() datasource.DataSource {
 	return &DataSourceAction{}
 }
@@ -140,7 +151,7 @@ func (d *DataSourceAction) Configure(ctx context.Context, req datasource.Configu
 }
 
 func (d *DataSourceAction) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data *ActionResourceModel
+	var data *ActionDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -186,13 +197,13 @@ func (d *DataSourceAction) Read(ctx context.Context, req datasource.ReadRequest,
 	// In the future, expand this to accommodate other types of requests
 
 	if actionModel.Request.Custom != nil {
-		diags = readCustomAction(ctx, data, actionModel)
+		diags = readCustomAction(ctx, data.Request, actionModel)
 		if diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 			return
 		}
 	} else if actionModel.Request.Agent != nil {
-		diags = readAgentAction(ctx, data, actionModel)
+		diags = readAgentAction(ctx, data.Request, actionModel)
 		if diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 			return
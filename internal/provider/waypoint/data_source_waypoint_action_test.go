@@ -4,11 +4,18 @@
 package waypoint_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/client/waypoint_service"
+	waypointmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpfake"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/waypoint"
 )
@@ -102,3 +109,39 @@ data "hcp_waypoint_action" "test_agent" {
   name    = hcp_waypoint_action.test_agent.name
 }`, testAgentAction(actionName))
 }
+
+func TestWaypoint_Action_DataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	server := hcpfake.NewServer(t)
+	client := server.NewClient(t)
+
+	params := waypoint_service.NewWaypointServiceCreateActionConfigParamsWithContext(ctx)
+	params.NamespaceLocationOrganizationID = server.OrganizationID()
+	params.NamespaceLocationProjectID = server.ProjectID()
+	params.Body = &waypointmodels.HashicorpCloudWaypointV20241122WaypointServiceCreateActionConfigBody{
+		ActionConfig: &waypointmodels.HashicorpCloudWaypointV20241122ActionConfig{
+			Name:        "example",
+			Description: "An action",
+			Request: &waypointmodels.HashicorpCloudWaypointV20241122ActionConfigRequest{
+				Custom: &waypointmodels.HashicorpCloudWaypointV20241122ActionConfigFlavorCustom{
+					Method: waypointmodels.HashicorpCloudWaypointV20241122ActionConfigFlavorCustomMethodGET.Pointer(),
+					URL:    "https://example.com",
+					Headers: []*waypointmodels.HashicorpCloudWaypointV20241122ActionConfigFlavorCustomHeader{
+						{Key: "Accept", Value: "application/json"},
+					},
+				},
+			},
+		},
+	}
+	_, err := client.Waypoint.WaypointServiceCreateActionConfig(params, nil)
+	require.NoError(t, err)
+
+	state := readDataSource(t, client, waypoint.NewActionDataSource(), map[string]any{"name": "example"})
+
+	var data waypoint.ActionDataSourceModel
+	require.False(t, state.Get(ctx, &data).HasError())
+	assert.Equal(t, "An action", data.Description.ValueString())
+	require.NotNil(t, data.Request)
+	require.NotNil(t, data.Request.Custom)
+	assert.Equal(t, "https://example.com", data.Request.Custom.URL.ValueString())
+}
@@ -32,6 +32,14 @@ type DataSourceAgentGroup struct {
 	client *clients.Client
 }
 
+// AgentGroupDataSourceModel describes the data source data model.
+type AgentGroupDataSourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	OrgID       types.String `tfsdk:"organization_id"`
+}
+
 func NewAgentGroupDataSource() datasource.DataSource {
 	return &DataSourceAgentGroup{}
 }
@@ -83,7 +91,7 @@ func (d *DataSourceAgentGroup) Configure(ctx context.Context, req datasource.Con
 }
 
 func (d *DataSourceAgentGroup) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data *AgentGroupResourceModel
+	var data *AgentGroupDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
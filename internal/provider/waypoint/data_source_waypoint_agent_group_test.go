@@ -4,10 +4,22 @@
 package waypoint_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/client/waypoint_service"
+	waypointmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/hcpfake"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/acctest"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/waypoint"
 )
@@ -48,3 +60,56 @@ data "hcp_waypoint_agent_group" "test" {
   name    = hcp_waypoint_agent_group.test.name
 }`, testAgentGroup(agentGroupName))
 }
+
+func TestWaypoint_Agent_Group_DataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	server := hcpfake.NewServer(t)
+	client := server.NewClient(t)
+
+	params := waypoint_service.NewWaypointServiceCreateAgentGroupParamsWithContext(ctx)
+	params.NamespaceLocationOrganizationID = server.OrganizationID()
+	params.NamespaceLocationProjectID = server.ProjectID()
+	params.Body = &waypointmodels.HashicorpCloudWaypointV20241122WaypointServiceCreateAgentGroupBody{
+		Group: &waypointmodels.HashicorpCloudWaypointV20241122AgentGroup{Name: "example", Description: "An agent group"},
+	}
+	_, err := client.Waypoint.WaypointServiceCreateAgentGroup(params, nil)
+	require.NoError(t, err)
+
+	state := readDataSource(t, client, waypoint.NewAgentGroupDataSource(), map[string]any{"name": "example"})
+
+	var data waypoint.AgentGroupDataSourceModel
+	require.False(t, state.Get(ctx, &data).HasError())
+	assert.Equal(t, "example", data.Name.ValueString())
+	assert.Equal(t, "An agent group", data.Description.ValueString())
+}
+
+// readDataSource reads the data source with a configuration that sets the
+// passed attributes, and returns the resulting state.
+func readDataSource(t *testing.T, client *clients.Client, d datasource.DataSource, attrs map[string]any) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	var configureResp datasource.ConfigureResponse
+	d.(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{ProviderData: client}, &configureResp)
+	require.False(t, configureResp.Diagnostics.HasError(), configureResp.Diagnostics)
+
+	config := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	for name, value := range attrs {
+		require.False(t, config.SetAttribute(ctx, path.Root(name), value).HasError())
+	}
+
+	resp := datasource.ReadResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw}}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	return resp.State
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waypoint
+
+import (
+	"context"
+	"fmt"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
+)
+
+// NewApplicationListResource returns the list resource of the Waypoint
+// applications of a project.
+func NewApplicationListResource() list.ListResource {
+	return identity.NewListResource(NewApplicationResource(), schema.Schema{
+		MarkdownDescription: "Lists the Waypoint applications of a project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": identity.ProjectIDListAttribute(),
+		},
+	}, listApplications)
+}
+
+func listApplications(ctx context.Context, client *clients.Client, config tfsdk.Config) ([]identity.ListResult, diag.Diagnostics) {
+	projectID, diags := identity.ListProjectID(ctx, client, config)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: client.Config.OrganizationID,
+		ProjectID:      projectID,
+	}
+	applications, err := clients.ListApplications(ctx, client, loc)
+	if err != nil {
+		diags.AddError("Unable to list Waypoint applications", fmt.Sprintf("Unable to list the Waypoint applications of project %q: %v", projectID, err))
+		return nil, diags
+	}
+
+	results := make([]identity.ListResult, 0, len(applications))
+	for _, application := range applications {
+		results = append(results, identity.ListResult{
+			DisplayName: application.Name,
+			Identity: map[string]string{
+				"project_id": projectID,
+				"id":         application.ID,
+			},
+		})
+	}
+	return results, diags
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waypoint
+
+import (
+	"context"
+	"fmt"
+
+	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
+)
+
+// NewTemplateListResource returns the list resource of the Waypoint templates
+// of a project.
+func NewTemplateListResource() list.ListResource {
+	return identity.NewListResource(NewTemplateResource(), schema.Schema{
+		MarkdownDescription: "Lists the Waypoint templates of a project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": identity.ProjectIDListAttribute(),
+		},
+	}, listTemplates)
+}
+
+func listTemplates(ctx context.Context, client *clients.Client, config tfsdk.Config) ([]identity.ListResult, diag.Diagnostics) {
+	projectID, diags := identity.ListProjectID(ctx, client, config)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	loc := &sharedmodels.HashicorpCloudLocationLocation{
+		OrganizationID: client.Config.OrganizationID,
+		ProjectID:      projectID,
+	}
+	templates, err := clients.ListApplicationTemplates(ctx, client, loc)
+	if err != nil {
+		diags.AddError("Unable to list Waypoint templates", fmt.Sprintf("Unable to list the Waypoint templates of project %q: %v", projectID, err))
+		return nil, diags
+	}
+
+	results := make([]identity.ListResult, 0, len(templates))
+	for _, template := range templates {
+		results = append(results, identity.ListResult{
+			DisplayName: template.Name,
+			Identity: map[string]string{
+				"project_id": projectID,
+				"id":         template.ID,
+			},
+		})
+	}
+	return results, diags
+}
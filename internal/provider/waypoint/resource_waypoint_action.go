@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/client/waypoint_service"
 	waypoint_models "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -21,11 +22,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ActionResource{}
 var _ resource.ResourceWithImportState = &ActionResource{}
+var _ identity.ResourceWithIdentity = &ActionResource{}
 
 func NewActionResource() resource.Resource {
 	return &ActionResource{}
@@ -43,7 +46,8 @@ type ActionResourceModel struct {
 	OrgID       types.String `tfsdk:"organization_id"`
 	Description types.String `tfsdk:"description"`
 
-	Request *actionRequest `tfsdk:"request"`
+	Request  *actionRequest `tfsdk:"request"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type actionRequest struct {
@@ -152,6 +156,21 @@ func (r *ActionResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *ActionResource) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			{
+				Name:        "id",
+				Description: "The ID of the action.",
+			},
+		},
 	}
 }
 
@@ -183,6 +202,15 @@ func (r *ActionResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !plan.ProjectID.IsUnknown() {
 		projectID = plan.ProjectID.ValueString()
@@ -202,8 +230,6 @@ func (r *ActionResource) Create(ctx context.Context, req resource.CreateRequest,
 		modelBody.ActionConfig.Description = plan.Description.ValueString()
 	}
 
-	var diags diag.Diagnostics
-
 	// This is a proxy for the request type, as Custom.Method is required for Custom requests
 	if plan.Request.Custom != nil && !plan.Request.Custom.Method.IsUnknown() && !plan.Request.Custom.Method.IsNull() {
 		modelBody.ActionConfig.Request.Custom = &waypoint_models.HashicorpCloudWaypointV20241122ActionConfigFlavorCustom{}
@@ -261,6 +287,7 @@ func (r *ActionResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	params := &waypoint_service.WaypointServiceCreateActionConfigParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: orgID,
 		NamespaceLocationProjectID:      projectID,
 		Body:                            modelBody,
@@ -303,13 +330,13 @@ func (r *ActionResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.Request = &actionRequest{}
 
 	if aCfgModel.Request.Custom != nil {
-		diags = readCustomAction(ctx, plan, aCfgModel)
+		diags = readCustomAction(ctx, plan.Request, aCfgModel)
 		if diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 			return
 		}
 	} else if aCfgModel.Request.Agent != nil {
-		diags = readAgentAction(ctx, plan, aCfgModel)
+		diags = readAgentAction(ctx, plan.Request, aCfgModel)
 		if diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 			return
@@ -334,6 +361,15 @@ func (r *ActionResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	timeout, diags := data.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !data.ProjectID.IsUnknown() {
 		projectID = data.ProjectID.ValueString()
@@ -376,13 +412,13 @@ func (r *ActionResource) Read(ctx context.Context, req resource.ReadRequest, res
 	data.Request = &actionRequest{}
 
 	if actionCfg.Request.Custom != nil {
-		diags := readCustomAction(ctx, data, actionCfg)
+		diags := readCustomAction(ctx, data.Request, actionCfg)
 		if diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 			return
 		}
 	} else if actionCfg.Request.Agent != nil {
-		diags := readAgentAction(ctx, data, actionCfg)
+		diags := readAgentAction(ctx, data.Request, actionCfg)
 		if diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 			return
@@ -402,6 +438,15 @@ func (r *ActionResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Update(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	// get the current state as well, so we know the current name of the
 	// action for reference during the update
 	var data *ActionResourceModel
@@ -426,8 +471,6 @@ func (r *ActionResource) Update(ctx context.Context, req resource.UpdateRequest,
 	modelBody.ActionConfig.Name = plan.Name.ValueString()
 	modelBody.ActionConfig.Description = plan.Description.ValueString()
 
-	var diags diag.Diagnostics
-
 	// This is a proxy for the request type, as Custom.Method is required for Custom requests
 	if plan.Request.Custom != nil && !plan.Request.Custom.Method.IsUnknown() && !plan.Request.Custom.Method.IsNull() {
 		modelBody.ActionConfig.Request.Custom = &waypoint_models.HashicorpCloudWaypointV20241122ActionConfigFlavorCustom{}
@@ -489,6 +532,7 @@ func (r *ActionResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	params := &waypoint_service.WaypointServiceUpdateActionConfigParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: orgID,
 		NamespaceLocationProjectID:      projectID,
 		Body:                            modelBody,
@@ -527,13 +571,13 @@ func (r *ActionResource) Update(ctx context.Context, req resource.UpdateRequest,
 	plan.Request = &actionRequest{}
 
 	if aCfgModel.Request.Custom != nil {
-		diags = readCustomAction(ctx, plan, aCfgModel)
+		diags = readCustomAction(ctx, plan.Request, aCfgModel)
 		if diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 			return
 		}
 	} else if aCfgModel.Request.Agent != nil {
-		diags = readAgentAction(ctx, plan, aCfgModel)
+		diags = readAgentAction(ctx, plan.Request, aCfgModel)
 		if diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 			return
@@ -558,6 +602,15 @@ func (r *ActionResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	timeout, diags := data.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !data.ProjectID.IsUnknown() {
 		projectID = data.ProjectID.ValueString()
@@ -569,6 +622,7 @@ func (r *ActionResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	params := &waypoint_service.WaypointServiceDeleteActionConfigParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: loc.OrganizationID,
 		NamespaceLocationProjectID:      loc.ProjectID,
 		ActionID:                        data.ID.ValueStringPointer(),
@@ -595,77 +649,77 @@ func (r *ActionResource) ImportState(ctx context.Context, req resource.ImportSta
 
 func readCustomAction(
 	ctx context.Context,
-	data *ActionResourceModel,
+	request *actionRequest,
 	actionCfg *waypoint_models.HashicorpCloudWaypointV20241122ActionConfig,
 ) diag.Diagnostics {
-	data.Request.Custom = &customRequest{}
+	request.Custom = &customRequest{}
 	headerMap := make(map[string]string)
 	var diags diag.Diagnostics
 	if actionCfg.Request.Custom.Method != nil {
-		data.Request.Custom.Method = types.StringValue(string(*actionCfg.Request.Custom.Method))
+		request.Custom.Method = types.StringValue(string(*actionCfg.Request.Custom.Method))
 	} else {
-		data.Request.Custom.Method = types.StringNull()
+		request.Custom.Method = types.StringNull()
 	}
 	if actionCfg.Request.Custom.Headers != nil {
 		for _, header := range actionCfg.Request.Custom.Headers {
 			headerMap[header.Key] = header.Value
 		}
 		if len(headerMap) > 0 {
-			data.Request.Custom.Headers, diags = types.MapValueFrom(ctx, types.StringType, headerMap)
+			request.Custom.Headers, diags = types.MapValueFrom(ctx, types.StringType, headerMap)
 			if diags.HasError() {
 				return diags
 			}
 		} else {
-			data.Request.Custom.Headers = types.MapNull(types.StringType)
+			request.Custom.Headers = types.MapNull(types.StringType)
 		}
 	}
 	if actionCfg.Request.Custom.URL != "" {
-		data.Request.Custom.URL = types.StringValue(actionCfg.Request.Custom.URL)
+		request.Custom.URL = types.StringValue(actionCfg.Request.Custom.URL)
 	} else {
-		data.Request.Custom.URL = types.StringNull()
+		request.Custom.URL = types.StringNull()
 	}
 	if actionCfg.Request.Custom.Body != "" {
-		data.Request.Custom.Body = types.StringValue(actionCfg.Request.Custom.Body)
+		request.Custom.Body = types.StringValue(actionCfg.Request.Custom.Body)
 	} else {
-		data.Request.Custom.Body = types.StringNull()
+		request.Custom.Body = types.StringNull()
 	}
 
 	// Ensure Agent is nil if Custom is set
-	data.Request.Agent = nil
+	request.Agent = nil
 
 	return diags
 }
 
 func readAgentAction(
 	ctx context.Context,
-	data *ActionResourceModel,
+	request *actionRequest,
 	actionCfg *waypoint_models.HashicorpCloudWaypointV20241122ActionConfig,
 ) diag.Diagnostics {
-	data.Request.Agent = &agentRequest{}
+	request.Agent = &agentRequest{}
 	var diags diag.Diagnostics
 	if actionCfg.Request.Agent.Op.ID != "" {
-		data.Request.Agent.OperationID = types.StringValue(actionCfg.Request.Agent.Op.ID)
+		request.Agent.OperationID = types.StringValue(actionCfg.Request.Agent.Op.ID)
 	} else {
-		data.Request.Agent.OperationID = types.StringNull()
+		request.Agent.OperationID = types.StringNull()
 	}
 	if actionCfg.Request.Agent.Op.Group != "" {
-		data.Request.Agent.Group = types.StringValue(actionCfg.Request.Agent.Op.Group)
+		request.Agent.Group = types.StringValue(actionCfg.Request.Agent.Op.Group)
 	} else {
-		data.Request.Agent.Group = types.StringNull()
+		request.Agent.Group = types.StringNull()
 	}
 	if actionCfg.Request.Agent.Op.Body.String() != "" {
-		data.Request.Agent.Body = types.StringValue(base64.StdEncoding.EncodeToString(actionCfg.Request.Agent.Op.Body))
+		request.Agent.Body = types.StringValue(base64.StdEncoding.EncodeToString(actionCfg.Request.Agent.Op.Body))
 	} else {
-		data.Request.Agent.Body = types.StringNull()
+		request.Agent.Body = types.StringNull()
 	}
 	if actionCfg.Request.Agent.Op.ActionRunID != "" {
-		data.Request.Agent.ActionRunID = types.StringValue(actionCfg.Request.Agent.Op.ActionRunID)
+		request.Agent.ActionRunID = types.StringValue(actionCfg.Request.Agent.Op.ActionRunID)
 	} else {
-		data.Request.Agent.ActionRunID = types.StringNull()
+		request.Agent.ActionRunID = types.StringNull()
 	}
 
 	// Ensure Custom is nil if Agent is set
-	data.Request.Custom = nil
+	request.Custom = nil
 
 	return diags
 }
@@ -11,6 +11,7 @@ import (
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/client/waypoint_service"
 	waypoint_models "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,11 +22,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AddOnResource{}
 var _ resource.ResourceWithImportState = &AddOnResource{}
+var _ identity.ResourceWithIdentity = &AddOnResource{}
 
 func NewAddOnResource() resource.Resource {
 	return &AddOnResource{}
@@ -55,8 +58,9 @@ type AddOnResourceModel struct {
 
 	TerraformNoCodeModuleSource types.String `tfsdk:"terraform_no_code_module_source"`
 
-	InputVars                types.Set `tfsdk:"add_on_input_variables"`
-	AddOnDefinitionInputVars types.Set `tfsdk:"add_on_definition_input_variables"`
+	InputVars                types.Set      `tfsdk:"add_on_input_variables"`
+	AddOnDefinitionInputVars types.Set      `tfsdk:"add_on_definition_input_variables"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
 }
 
 type outputValue struct {
@@ -224,6 +228,21 @@ func (r *AddOnResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *AddOnResource) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			{
+				Name:        "id",
+				Description: "The ID of the add-on.",
+			},
+		},
 	}
 }
 
@@ -257,6 +276,15 @@ func (r *AddOnResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !plan.ProjectID.IsUnknown() {
 		projectID = plan.ProjectID.ValueString()
@@ -279,7 +307,7 @@ func (r *AddOnResource) Create(ctx context.Context, req resource.CreateRequest,
 	ivs := make([]*waypoint_models.HashicorpCloudWaypointV20241122InputVariable, 0)
 
 	var inputVarsSlice []InputVar
-	diags := plan.InputVars.ElementsAs(ctx, &inputVarsSlice, false)
+	diags = plan.InputVars.ElementsAs(ctx, &inputVarsSlice, false)
 	if diags.HasError() {
 		return
 	}
@@ -344,6 +372,7 @@ func (r *AddOnResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	params := &waypoint_service.WaypointServiceCreateAddOnParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: loc.OrganizationID,
 		NamespaceLocationProjectID:      loc.ProjectID,
 		Body:                            modelBody,
@@ -478,6 +507,15 @@ func (r *AddOnResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	timeout, diags := state.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !state.ProjectID.IsUnknown() && !state.ProjectID.IsNull() {
 		projectID = state.ProjectID.ValueString()
@@ -493,7 +531,7 @@ func (r *AddOnResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// to be used later when fetching the input variables from the API
 	varTypes := map[string]string{}
 	inputVarsSlice := []InputVar{}
-	diags := state.InputVars.ElementsAs(ctx, &inputVarsSlice, false)
+	diags = state.InputVars.ElementsAs(ctx, &inputVarsSlice, false)
 	if diags.HasError() {
 		return
 	}
@@ -624,6 +662,15 @@ func (r *AddOnResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Update(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 
 	orgID := r.client.Config.OrganizationID
@@ -637,6 +684,7 @@ func (r *AddOnResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	params := &waypoint_service.WaypointServiceUpdateAddOnParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: loc.OrganizationID,
 		NamespaceLocationProjectID:      loc.ProjectID,
 		Body:                            modelBody,
@@ -744,6 +792,15 @@ func (r *AddOnResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	timeout, diags := state.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 
 	loc := &sharedmodels.HashicorpCloudLocationLocation{
@@ -752,6 +809,7 @@ func (r *AddOnResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	params := &waypoint_service.WaypointServiceDestroyAddOnParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: loc.OrganizationID,
 		NamespaceLocationProjectID:      loc.ProjectID,
 		AddOnID:                         state.ID.ValueString(),
@@ -11,6 +11,7 @@ import (
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/client/waypoint_service"
 	waypoint_models "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,11 +22,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AddOnDefinitionResource{}
 var _ resource.ResourceWithImportState = &AddOnDefinitionResource{}
+var _ identity.ResourceWithIdentity = &AddOnDefinitionResource{}
 
 func NewAddOnDefinitionResource() resource.Resource {
 	return &AddOnDefinitionResource{}
@@ -54,6 +57,7 @@ type AddOnDefinitionResourceModel struct {
 	TerraformVariableOptions    []*tfcVariableOption `tfsdk:"variable_options"`
 	TerraformExecutionMode      types.String         `tfsdk:"terraform_execution_mode"`
 	TerraformAgentPoolID        types.String         `tfsdk:"terraform_agent_pool_id"`
+	Timeouts                    timeouts.Value       `tfsdk:"timeouts"`
 }
 
 func (r *AddOnDefinitionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -191,6 +195,21 @@ func (r *AddOnDefinitionResource) Schema(ctx context.Context, req resource.Schem
 				Description: "The ID of the Terraform no-code module to use for running Terraform operations. This is in the format of 'nocode-<ID>'.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *AddOnDefinitionResource) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			{
+				Name:        "id",
+				Description: "The ID of the add-on definition.",
+			},
+		},
 	}
 }
 
@@ -223,6 +242,15 @@ func (r *AddOnDefinitionResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !plan.ProjectID.IsUnknown() {
 		projectID = plan.ProjectID.ValueString()
@@ -311,6 +339,7 @@ func (r *AddOnDefinitionResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	params := &waypoint_service.WaypointServiceCreateAddOnDefinitionParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: orgID,
 		NamespaceLocationProjectID:      projectID,
 		Body:                            modelBody,
@@ -401,6 +430,15 @@ func (r *AddOnDefinitionResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	timeout, diags := state.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !state.ProjectID.IsUnknown() && !state.ProjectID.IsNull() {
 		projectID = state.ProjectID.ValueString()
@@ -490,6 +528,15 @@ func (r *AddOnDefinitionResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Update(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !plan.ProjectID.IsUnknown() {
 		projectID = plan.ProjectID.ValueString()
@@ -575,6 +622,7 @@ func (r *AddOnDefinitionResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	params := &waypoint_service.WaypointServiceUpdateAddOnDefinitionParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: orgID,
 		NamespaceLocationProjectID:      projectID,
 		Body:                            modelBody,
@@ -664,6 +712,15 @@ func (r *AddOnDefinitionResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	timeout, diags := state.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !state.ProjectID.IsUnknown() {
 		projectID = state.ProjectID.ValueString()
@@ -675,6 +732,7 @@ func (r *AddOnDefinitionResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	params := &waypoint_service.WaypointServiceDeleteAddOnDefinitionParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: loc.OrganizationID,
 		NamespaceLocationProjectID:      loc.ProjectID,
 		AddOnDefinitionID:               state.ID.ValueString(),
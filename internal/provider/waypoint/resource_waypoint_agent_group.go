@@ -11,17 +11,20 @@ import (
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/client/waypoint_service"
 	waypoint_models "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &AgentGroupResource{}
 var _ resource.ResourceWithImportState = &AgentGroupResource{}
+var _ identity.ResourceWithIdentity = &AgentGroupResource{}
 
 func NewAgentGroupResource() resource.Resource {
 	return &AgentGroupResource{}
@@ -33,10 +36,11 @@ type AgentGroupResource struct {
 
 // AgentGroupResourceModel describes the resource data model
 type AgentGroupResourceModel struct {
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	ProjectID   types.String `tfsdk:"project_id"`
-	OrgID       types.String `tfsdk:"organization_id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	ProjectID   types.String   `tfsdk:"project_id"`
+	OrgID       types.String   `tfsdk:"organization_id"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *AgentGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -68,6 +72,19 @@ func (r *AgentGroupResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *AgentGroupResource) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			identity.Name("The name of the agent group."),
+		},
+		Mutable: true,
 	}
 }
 
@@ -99,6 +116,15 @@ func (r *AgentGroupResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !plan.ProjectID.IsUnknown() && !plan.ProjectID.IsNull() {
 		projectID = plan.ProjectID.ValueString()
@@ -120,6 +146,7 @@ func (r *AgentGroupResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	params := &waypoint_service.WaypointServiceCreateAgentGroupParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: orgID,
 		NamespaceLocationProjectID:      projectID,
 		Body:                            modelBody,
@@ -133,6 +160,7 @@ func (r *AgentGroupResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// We do not get the agent group back from the API, so we need to get it in a separate request
 	getParams := &waypoint_service.WaypointServiceGetAgentGroupParams{
+		Context:                         ctx,
 		Name:                            params.Body.Group.Name,
 		NamespaceLocationOrganizationID: orgID,
 		NamespaceLocationProjectID:      projectID,
@@ -193,6 +221,15 @@ func (r *AgentGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	timeout, diags := data.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !data.ProjectID.IsUnknown() && !data.ProjectID.IsNull() {
 		projectID = data.ProjectID.ValueString()
@@ -251,6 +288,15 @@ func (r *AgentGroupResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Update(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	// get the current state as well, so we know the current name of the
 	// agent group for reference during the update
 	var data *AgentGroupResourceModel
@@ -273,6 +319,7 @@ func (r *AgentGroupResource) Update(ctx context.Context, req resource.UpdateRequ
 	modelBody.Description = plan.Description.ValueString()
 
 	params := &waypoint_service.WaypointServiceUpdateAgentGroupParams{
+		Context:                         ctx,
 		Body:                            modelBody,
 		Name:                            data.Name.ValueString(),
 		NamespaceLocationOrganizationID: orgID,
@@ -321,6 +368,15 @@ func (r *AgentGroupResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	timeout, diags := data.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !data.ProjectID.IsUnknown() {
 		projectID = data.ProjectID.ValueString()
@@ -332,6 +388,7 @@ func (r *AgentGroupResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	params := &waypoint_service.WaypointServiceDeleteAgentGroupParams{
+		Context:                         ctx,
 		Name:                            data.Name.ValueString(),
 		NamespaceLocationOrganizationID: loc.OrganizationID,
 		NamespaceLocationProjectID:      loc.ProjectID,
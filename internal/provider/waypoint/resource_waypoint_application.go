@@ -11,6 +11,7 @@ import (
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/client/waypoint_service"
 	waypoint_models "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,11 +22,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ApplicationResource{}
 var _ resource.ResourceWithImportState = &ApplicationResource{}
+var _ identity.ResourceWithIdentity = &ApplicationResource{}
 
 func NewApplicationResource() resource.Resource {
 	return &ApplicationResource{}
@@ -60,7 +63,8 @@ type ApplicationResourceModel struct {
 	// https://github.com/hashicorp/terraform-plugin-framework/issues/242
 	TemplateInputVars types.Set `tfsdk:"template_input_variables"`
 
-	OutputValues types.List `tfsdk:"output_values"`
+	OutputValues types.List     `tfsdk:"output_values"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 type InputVar struct {
@@ -224,6 +228,21 @@ func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *ApplicationResource) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+			{
+				Name:        "id",
+				Description: "The ID of the application.",
+			},
+		},
 	}
 }
 
@@ -256,6 +275,15 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !plan.ProjectID.IsUnknown() {
 		projectID = plan.ProjectID.ValueString()
@@ -286,7 +314,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	ivs := make([]*waypoint_models.HashicorpCloudWaypointV20241122InputVariable, 0)
 
 	var inputVarsSlice []InputVar
-	diags := plan.InputVars.ElementsAs(ctx, &inputVarsSlice, false)
+	diags = plan.InputVars.ElementsAs(ctx, &inputVarsSlice, false)
 	if diags.HasError() {
 		return
 	}
@@ -327,6 +355,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	params := &waypoint_service.WaypointServiceCreateApplicationFromTemplateParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: loc.OrganizationID,
 		NamespaceLocationProjectID:      loc.ProjectID,
 		Body:                            modelBody,
@@ -436,6 +465,15 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	timeout, diags := data.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !data.ProjectID.IsUnknown() && !data.ProjectID.IsNull() {
 		projectID = data.ProjectID.ValueString()
@@ -451,7 +489,7 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 	// to be used later when fetching the input variables from the API
 	varTypes := map[string]string{}
 	inputVarsSlice := []InputVar{}
-	diags := data.InputVars.ElementsAs(ctx, &inputVarsSlice, false)
+	diags = data.InputVars.ElementsAs(ctx, &inputVarsSlice, false)
 	if diags.HasError() {
 		return
 	}
@@ -590,6 +628,15 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Update(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	// get the current state as well, so we know the current name of the
 	// application for reference during the update
 	var data *ApplicationResourceModel
@@ -629,7 +676,7 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	strActions := []string{}
-	diags := plan.Actions.ElementsAs(ctx, &strActions, false)
+	diags = plan.Actions.ElementsAs(ctx, &strActions, false)
 	if diags.HasError() {
 		return
 	}
@@ -648,6 +695,7 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	params := &waypoint_service.WaypointServiceUpdateApplicationParams{
+		Context:                         ctx,
 		ApplicationID:                   plan.ID.ValueString(),
 		NamespaceLocationOrganizationID: loc.OrganizationID,
 		NamespaceLocationProjectID:      loc.ProjectID,
@@ -727,6 +775,15 @@ func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	timeout, diags := data.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !data.ProjectID.IsUnknown() {
 		projectID = data.ProjectID.ValueString()
@@ -738,6 +795,7 @@ func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	params := &waypoint_service.WaypointServiceDestroyApplicationParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: loc.OrganizationID,
 		NamespaceLocationProjectID:      loc.ProjectID,
 		ApplicationID:                   data.ID.ValueString(),
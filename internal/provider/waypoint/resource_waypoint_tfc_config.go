@@ -10,17 +10,26 @@ import (
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
 	"github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/client/waypoint_service"
 	waypoint_models "github.com/hashicorp/hcp-sdk-go/clients/cloud-waypoint-service/preview/2024-11-22/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TfcConfigResource{}
+var _ identity.ResourceWithIdentity = &TfcConfigResource{}
 
 func NewTfcConfigResource() resource.Resource {
 	return &TfcConfigResource{}
@@ -36,10 +45,13 @@ type TfcConfigResourceModel struct {
 	// note: there is no true ID in the TFC Config, and each HCP Waypoint
 	// organization has only 1 TFC Config, so we use the TFC Organization name
 	// as an ID.
-	ID         types.String `tfsdk:"id"`
-	ProjectID  types.String `tfsdk:"project_id"`
-	Token      types.String `tfsdk:"token"`
-	TfcOrgName types.String `tfsdk:"tfc_org_name"`
+	ID             types.String   `tfsdk:"id"`
+	ProjectID      types.String   `tfsdk:"project_id"`
+	Token          types.String   `tfsdk:"token"`
+	TokenWO        types.String   `tfsdk:"token_wo"`
+	TokenWOVersion types.Int64    `tfsdk:"token_wo_version"`
+	TfcOrgName     types.String   `tfsdk:"tfc_org_name"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *TfcConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -74,9 +86,25 @@ func (r *TfcConfigResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 			},
 			"token": schema.StringAttribute{
-				Required:            true,
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Terraform Cloud team token. The token must include permissions to manage workspaces and applications. Exactly one of `token` or `token_wo` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("token_wo")),
+				},
+			},
+			"token_wo": schema.StringAttribute{
+				Optional:            true,
 				Sensitive:           true,
-				MarkdownDescription: "Terraform Cloud team token. The token must include permissions to manage workspaces and applications.",
+				WriteOnly:           true,
+				MarkdownDescription: "Terraform Cloud team token. The token must include permissions to manage workspaces and applications. This value is write-only and is not stored in the Terraform state. Requires Terraform 1.11 or later.",
+			},
+			"token_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of the `token_wo` value. Terraform cannot detect changes to write-only values, change this version to update the token.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("token_wo")),
+				},
 			},
 			"tfc_org_name": schema.StringAttribute{
 				Required:            true,
@@ -89,6 +117,18 @@ func (r *TfcConfigResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *TfcConfigResource) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ProjectID(),
+		},
+		ImportUnsupported: true,
 	}
 }
 
@@ -123,6 +163,15 @@ func (r *TfcConfigResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !plan.ProjectID.IsUnknown() {
 		projectID = plan.ProjectID.ValueString()
@@ -133,14 +182,21 @@ func (r *TfcConfigResource) Create(ctx context.Context, req resource.CreateReque
 		ProjectID:      projectID,
 	}
 
+	token, diags := tfcConfigToken(ctx, plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	modelBody := &waypoint_models.HashicorpCloudWaypointV20241122WaypointServiceCreateTFCConfigBody{
 		TfcConfig: &waypoint_models.HashicorpCloudWaypointV20241122TFCConfig{
 			OrganizationName: plan.TfcOrgName.ValueString(),
-			Token:            plan.Token.ValueString(),
+			Token:            token,
 		},
 	}
 
 	params := &waypoint_service.WaypointServiceCreateTFCConfigParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: loc.OrganizationID,
 		NamespaceLocationProjectID:      loc.ProjectID,
 		Body:                            modelBody,
@@ -177,6 +233,15 @@ func (r *TfcConfigResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	timeout, diags := data.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !data.ProjectID.IsUnknown() && !data.ProjectID.IsNull() {
 		projectID = data.ProjectID.ValueString()
@@ -189,6 +254,7 @@ func (r *TfcConfigResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	params := &waypoint_service.WaypointServiceGetTFCConfigParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: loc.OrganizationID,
 		NamespaceLocationProjectID:      loc.ProjectID,
 	}
@@ -229,6 +295,15 @@ func (r *TfcConfigResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Update(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !plan.ProjectID.IsUnknown() {
 		projectID = plan.ProjectID.ValueString()
@@ -239,14 +314,21 @@ func (r *TfcConfigResource) Update(ctx context.Context, req resource.UpdateReque
 		ProjectID:      projectID,
 	}
 
+	token, diags := tfcConfigToken(ctx, plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	modelBody := &waypoint_models.HashicorpCloudWaypointV20241122WaypointServiceUpdateTFCConfigBody{
 		TfcConfig: &waypoint_models.HashicorpCloudWaypointV20241122TFCConfig{
 			OrganizationName: plan.TfcOrgName.ValueString(),
-			Token:            plan.Token.ValueString(),
+			Token:            token,
 		},
 	}
 
 	params := &waypoint_service.WaypointServiceUpdateTFCConfigParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: loc.OrganizationID,
 		NamespaceLocationProjectID:      loc.ProjectID,
 		Body:                            modelBody,
@@ -283,6 +365,15 @@ func (r *TfcConfigResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	timeout, diags := data.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	projectID := r.client.Config.ProjectID
 	if !data.ProjectID.IsUnknown() {
 		projectID = data.ProjectID.ValueString()
@@ -294,6 +385,7 @@ func (r *TfcConfigResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 
 	params := &waypoint_service.WaypointServiceDeleteTFCConfigParams{
+		Context:                         ctx,
 		NamespaceLocationOrganizationID: loc.OrganizationID,
 		NamespaceLocationProjectID:      loc.ProjectID,
 	}
@@ -314,9 +406,10 @@ func (r *TfcConfigResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // getNamespaceByLocation will retrieve a namespace by location information
 // provided by HCP
-func getNamespaceByLocation(_ context.Context, client *clients.Client, loc *sharedmodels.HashicorpCloudLocationLocation) (*waypoint_models.HashicorpCloudWaypointV20241122Namespace, error) {
+func getNamespaceByLocation(ctx context.Context, client *clients.Client, loc *sharedmodels.HashicorpCloudLocationLocation) (*waypoint_models.HashicorpCloudWaypointV20241122Namespace, error) {
 	// TODO:(clint) consolidate this either in the wrapper or something
 	namespaceParams := &waypoint_service.WaypointServiceGetNamespaceParams{
+		Context:                ctx,
 		LocationOrganizationID: loc.OrganizationID,
 		LocationProjectID:      loc.ProjectID,
 	}
@@ -328,6 +421,19 @@ func getNamespaceByLocation(_ context.Context, client *clients.Client, loc *shar
 	return ns.GetPayload().Namespace, nil
 }
 
+// tfcConfigToken returns the Terraform Cloud token of the TFC Config. Write-only values
+// are not part of the plan, so the token is read from the configuration when the
+// write-only argument is used.
+func tfcConfigToken(ctx context.Context, plan TfcConfigResourceModel, config tfsdk.Config) (string, diag.Diagnostics) {
+	if !plan.Token.IsNull() {
+		return plan.Token.ValueString(), nil
+	}
+
+	var tokenWO types.String
+	diags := config.GetAttribute(ctx, path.Root("token_wo"), &tokenWO)
+	return tokenWO.ValueString(), diags
+}
+
 // Generate the unique ID for the resource
 func generateUID(projectID string) string {
 	return fmt.Sprintf("/project/%s/%s", projectID, "waypoint_tfc_config")
@@ -13,7 +13,11 @@ import (
 
 	webhookservice "github.com/hashicorp/hcp-sdk-go/clients/cloud-webhook/stable/2023-05-31/client/webhook_service"
 	webhookmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-webhook/stable/2023-05-31/models"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,9 +25,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
 	"github.com/hashicorp/terraform-provider-hcp/internal/hcpvalidator"
+	"github.com/hashicorp/terraform-provider-hcp/internal/identity"
 	"github.com/hashicorp/terraform-provider-hcp/internal/provider/modifiers"
 	webhookvalidator "github.com/hashicorp/terraform-provider-hcp/internal/provider/webhook/validator"
 )
@@ -33,6 +39,7 @@ var _ resource.Resource = &resourceNotificationsWebhook{}
 var _ resource.ResourceWithImportState = &resourceNotificationsWebhook{}
 var _ resource.ResourceWithConfigure = &resourceNotificationsWebhook{}
 var _ resource.ResourceWithModifyPlan = &resourceNotificationsWebhook{}
+var _ identity.ResourceWithIdentity = &resourceNotificationsWebhook{}
 
 func NewNotificationsWebhookResource() resource.Resource {
 	return &resourceNotificationsWebhook{}
@@ -46,7 +53,7 @@ func (r *resourceNotificationsWebhook) Metadata(_ context.Context, req resource.
 	resp.TypeName = req.ProviderTypeName + "_notifications_webhook"
 }
 
-func (r *resourceNotificationsWebhook) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *resourceNotificationsWebhook) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "The webhook resource manages a HCP webhook, used to notify external systems about a " +
 			"project resource's lifecycle events",
@@ -76,8 +83,26 @@ The destination must be able to use the HCP webhook
 					"hmac_key": schema.StringAttribute{
 						Optional: true,
 						Description: "The arbitrary secret that HCP uses to sign all its webhook requests. This is a " +
-							"write-only field, it is written once and not visible thereafter.",
+							"write-only field, it is written once and not visible thereafter. Conflicts with `hmac_key_wo`.",
 						Sensitive: true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("hmac_key_wo")),
+						},
+					},
+					"hmac_key_wo": schema.StringAttribute{
+						Optional: true,
+						Description: "The arbitrary secret that HCP uses to sign all its webhook requests. This value is " +
+							"write-only and is not stored in the Terraform state. Requires Terraform 1.11 or later.",
+						Sensitive: true,
+						WriteOnly: true,
+					},
+					"hmac_key_wo_version": schema.Int64Attribute{
+						Optional: true,
+						Description: "Version of the `hmac_key_wo` value. Terraform cannot detect changes to write-only " +
+							"values, change this version to update the HMAC key.",
+						Validators: []validator.Int64{
+							int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("hmac_key_wo")),
+						},
 					},
 				},
 			},
@@ -170,6 +195,17 @@ The destination must be able to use the HCP webhook
 					"webhook/project/<project_id>/geo/us/webhook/<name>"),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (r *resourceNotificationsWebhook) Identity() identity.Schema {
+	return identity.Schema{
+		Attributes: []identity.Attribute{
+			identity.ResourceName("The resource name of the webhook."),
+		},
 	}
 }
 
@@ -205,11 +241,14 @@ type webhook struct {
 	Subscriptions []webhookSubscription `tfsdk:"subscriptions"`
 	ResourceID    types.String          `tfsdk:"resource_id"`
 	ResourceName  types.String          `tfsdk:"resource_name"`
+	Timeouts      timeouts.Value        `tfsdk:"timeouts"`
 }
 
 type webhookConfig struct {
-	URL     types.String `tfsdk:"url"`
-	HmacKey types.String `tfsdk:"hmac_key"`
+	URL              types.String `tfsdk:"url"`
+	HmacKey          types.String `tfsdk:"hmac_key"`
+	HmacKeyWO        types.String `tfsdk:"hmac_key_wo"`
+	HmacKeyWOVersion types.Int64  `tfsdk:"hmac_key_wo_version"`
 }
 
 type webhookSubscription struct {
@@ -231,16 +270,31 @@ func (r *resourceNotificationsWebhook) Create(ctx context.Context, req resource.
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	hmacKey, diags := webhookHmacKey(ctx, plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	projectName := fmt.Sprintf("project/%s", r.client.Config.ProjectID)
 	if plan.ProjectID.ValueString() != "" {
 		projectName = fmt.Sprintf("project/%s", plan.ProjectID.ValueString())
 	}
 
-	createParams := webhookservice.NewWebhookServiceCreateWebhookParams()
+	createParams := webhookservice.NewWebhookServiceCreateWebhookParamsWithContext(ctx)
 	createParams.ParentResourceName = projectName
 	createParams.Body = &webhookmodels.HashicorpCloudWebhookCreateWebhookRequestBody{
 		Config: &webhookmodels.HashicorpCloudWebhookWebhookConfig{
-			HmacKey: plan.Config.HmacKey.ValueString(),
+			HmacKey: hmacKey,
 			URL:     plan.Config.URL.ValueString(),
 		},
 		Description: plan.Description.ValueString(),
@@ -329,7 +383,16 @@ func (r *resourceNotificationsWebhook) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	getParams := webhookservice.NewWebhookServiceGetWebhookParams()
+	timeout, diags := state.Timeouts.Read(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	getParams := webhookservice.NewWebhookServiceGetWebhookParamsWithContext(ctx)
 	getParams.ResourceName = state.ResourceName.ValueString()
 
 	res, err := r.client.Webhook.WebhookServiceGetWebhook(getParams, nil)
@@ -420,8 +483,17 @@ func (r *resourceNotificationsWebhook) Update(ctx context.Context, req resource.
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Update(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	if !plan.Name.Equal(state.Name) {
-		updateNameParams := webhookservice.NewWebhookServiceUpdateWebhookNameParams()
+		updateNameParams := webhookservice.NewWebhookServiceUpdateWebhookNameParamsWithContext(ctx)
 		updateNameParams.ResourceName = state.ResourceName.ValueString()
 		updateNameParams.Body = &webhookmodels.HashicorpCloudWebhookUpdateWebhookNameRequestBody{
 			Name: plan.Name.ValueString(),
@@ -456,7 +528,8 @@ func (r *resourceNotificationsWebhook) Update(ctx context.Context, req resource.
 
 	var updateMaks []string
 	if !plan.Config.URL.Equal(state.Config.URL) ||
-		!plan.Config.HmacKey.Equal(state.Config.HmacKey) {
+		!plan.Config.HmacKey.Equal(state.Config.HmacKey) ||
+		!plan.Config.HmacKeyWOVersion.Equal(state.Config.HmacKeyWOVersion) {
 		updateMaks = append(updateMaks, "config")
 	}
 	if !plan.Description.Equal(state.Description) {
@@ -488,14 +561,20 @@ func (r *resourceNotificationsWebhook) Update(ctx context.Context, req resource.
 		updateMaks = append(updateMaks, "subscriptions")
 	}
 
+	hmacKey, diags := webhookHmacKey(ctx, plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if len(updateMaks) > 0 {
-		updateParams := webhookservice.NewWebhookServiceUpdateWebhookParams()
+		updateParams := webhookservice.NewWebhookServiceUpdateWebhookParamsWithContext(ctx)
 		updateParams.ResourceName = state.ResourceName.ValueString()
 		updateParams.Body = &webhookmodels.HashicorpCloudWebhookUpdateWebhookRequestBody{
 			UpdateMask: strings.Join(updateMaks, ","),
 			Webhook: &webhookmodels.HashicorpCloudWebhookWebhook{
 				Config: &webhookmodels.HashicorpCloudWebhookWebhookConfig{
-					HmacKey: plan.Config.HmacKey.ValueString(),
+					HmacKey: hmacKey,
 					URL:     plan.Config.URL.ValueString(),
 				},
 				Description:   plan.Description.ValueString(),
@@ -525,7 +604,16 @@ func (r *resourceNotificationsWebhook) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	deleteParams := webhookservice.NewWebhookServiceDeleteWebhookParams()
+	timeout, diags := state.Timeouts.Delete(ctx, clients.DefaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	deleteParams := webhookservice.NewWebhookServiceDeleteWebhookParamsWithContext(ctx)
 	deleteParams.ResourceName = state.ResourceName.ValueString()
 	_, err := r.client.Webhook.WebhookServiceDeleteWebhook(deleteParams, nil)
 	if err != nil {
@@ -543,3 +631,18 @@ func (r *resourceNotificationsWebhook) Delete(ctx context.Context, req resource.
 func (r *resourceNotificationsWebhook) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("resource_name"), req, resp)
 }
+
+// webhookHmacKey returns the HMAC key of the webhook. Write-only values are not part of the
+// plan, so the HMAC key is read from the configuration when the write-only argument is used.
+func webhookHmacKey(ctx context.Context, plan webhook, config tfsdk.Config) (string, diag.Diagnostics) {
+	if plan.Config == nil {
+		return "", nil
+	}
+	if !plan.Config.HmacKey.IsNull() {
+		return plan.Config.HmacKey.ValueString(), nil
+	}
+
+	var hmacKeyWO types.String
+	diags := config.GetAttribute(ctx, path.Root("config").AtName("hmac_key_wo"), &hmacKeyWO)
+	return hmacKeyWO.ValueString(), diags
+}
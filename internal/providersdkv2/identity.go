@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providersdkv2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// identityAttribute is a string attribute of the identity of a resource.
+type identityAttribute struct {
+	name        string
+	description string
+
+	// linkAttribute is the state attribute holding the link URL of the
+	// resource whose ID the attribute is, e.g. hvn_link. The value of the
+	// attribute is read from the state attribute of the same name if empty.
+	linkAttribute string
+}
+
+// value returns the value of the attribute from the state of a resource.
+func (a identityAttribute) value(d *schema.ResourceData) (string, error) {
+	if a.linkAttribute == "" {
+		value, _ := d.Get(a.name).(string)
+		return value, nil
+	}
+
+	linkURL, _ := d.Get(a.linkAttribute).(string)
+	if linkURL == "" {
+		return "", nil
+	}
+	link, err := parseLinkURL(linkURL, "")
+	if err != nil {
+		return "", fmt.Errorf("unable to parse %s: %w", a.linkAttribute, err)
+	}
+	return link.ID, nil
+}
+
+// resourceIdentity is the identity of a resource, which is always qualified
+// by its project_id.
+type resourceIdentity struct {
+	// attributes are the attributes of the identity following project_id, in
+	// the order of the import ID of the resource.
+	attributes []identityAttribute
+
+	// importID formats the import ID of the resource from the values of its
+	// attributes, the project ID is empty if it's omitted from the identity.
+	// Defaults to the values joined by colons, prefixed by the project ID if
+	// set.
+	importID func(projectID string, values []string) string
+
+	// mutable is set if any of the attributes can be updated in place.
+	mutable bool
+}
+
+// withIdentity sets the identity of r, such that it can be imported with the
+// identity attribute of import blocks, and returns r.
+//
+// The identity is read from the state after every create, read and update.
+// Resources are imported by identity by formatting their import ID from the
+// identity, and importing them by ID.
+func withIdentity(r *schema.Resource, identity resourceIdentity) *schema.Resource {
+	r.Identity = &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := map[string]*schema.Schema{
+				"project_id": {
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+					Description:       "The ID of the HCP project of the resource. Defaults to the project of the provider.",
+				},
+			}
+			for _, attr := range identity.attributes {
+				s[attr.name] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       attr.description,
+				}
+			}
+			return s
+		},
+	}
+	r.ResourceBehavior.MutableIdentity = identity.mutable
+
+	r.CreateContext = setIdentityAfter(identity, r.CreateContext)
+	r.ReadContext = setIdentityAfter(identity, r.ReadContext)
+	r.UpdateContext = setIdentityAfter(identity, r.UpdateContext)
+
+	importState := r.Importer.StateContext
+	r.Importer = &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if d.Id() == "" {
+				importID, err := identity.formatImportID(d)
+				if err != nil {
+					return nil, err
+				}
+				d.SetId(importID)
+			}
+			return importState(ctx, d, meta)
+		},
+	}
+
+	return r
+}
+
+// setIdentityAfter wraps f to set the identity of the resource from its state
+// after it succeeds, unless the resource was removed from the state.
+func setIdentityAfter[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](i resourceIdentity, f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		if err := i.set(d); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+// set sets the identity of the resource from its state.
+func (i resourceIdentity) set(d *schema.ResourceData) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	// Some resources only store the project in the link URL of their ID, if
+	// it's not configured.
+	projectID, _ := d.Get("project_id").(string)
+	if projectID == "" {
+		if link, err := parseLinkURL(d.Id(), ""); err == nil {
+			projectID = link.Location.ProjectID
+		}
+	}
+	if err := identity.Set("project_id", projectID); err != nil {
+		return err
+	}
+	for _, attr := range i.attributes {
+		value, err := attr.value(d)
+		if err != nil {
+			return err
+		}
+		if err := identity.Set(attr.name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// formatImportID returns the import ID of the resource imported by identity.
+func (i resourceIdentity) formatImportID(d *schema.ResourceData) (string, error) {
+	identity, err := d.Identity()
+	if err != nil {
+		return "", err
+	}
+
+	projectID, _ := identity.Get("project_id").(string)
+	values := make([]string, len(i.attributes))
+	for n, attr := range i.attributes {
+		values[n], _ = identity.Get(attr.name).(string)
+		if values[n] == "" {
+			return "", fmt.Errorf("the %s attribute of the identity is required to import the resource", attr.name)
+		}
+	}
+
+	if i.importID != nil {
+		return i.importID(projectID, values), nil
+	}
+	if projectID != "" {
+		values = append([]string{projectID}, values...)
+	}
+	return strings.Join(values, ":"), nil
+}
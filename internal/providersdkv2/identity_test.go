@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providersdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceIdentity_formatImportID(t *testing.T) {
+	tests := []struct {
+		name        string
+		resource    *schema.Resource
+		identity    resourceIdentity
+		raw         map[string]string
+		expectedID  string
+		expectedErr string
+	}{
+		{
+			name:       "provider project",
+			resource:   resourceHvnRoute(),
+			identity:   hvnRouteIdentity,
+			raw:        map[string]string{"hvn_id": "hvn", "hvn_route_id": "route"},
+			expectedID: "hvn:route",
+		},
+		{
+			name:       "explicit project",
+			resource:   resourceHvnRoute(),
+			identity:   hvnRouteIdentity,
+			raw:        map[string]string{"project_id": "proj", "hvn_id": "hvn", "hvn_route_id": "route"},
+			expectedID: "proj:hvn:route",
+		},
+		{
+			name:       "custom import ID",
+			resource:   resourceDNSForwarding(),
+			identity:   dnsForwardingIdentity,
+			raw:        map[string]string{"project_id": "proj", "hvn_id": "hvn", "dns_forwarding_id": "fwd"},
+			expectedID: "/project/proj/hvn/hvn/dns-forwarding/fwd",
+		},
+		{
+			name:        "missing attribute",
+			resource:    resourceHvnRoute(),
+			identity:    hvnRouteIdentity,
+			raw:         map[string]string{"hvn_id": "hvn"},
+			expectedErr: "the hvn_route_id attribute of the identity is required to import the resource",
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := withIdentity(testCase.resource, testCase.identity)
+			d := schema.TestResourceDataWithIdentityRaw(t, r.Schema, r.Identity.SchemaFunc(), testCase.raw)
+
+			importID, err := testCase.identity.formatImportID(d)
+			if testCase.expectedErr != "" {
+				assert.EqualError(t, err, testCase.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedID, importID)
+		})
+	}
+}
+
+func TestResourceIdentity_set(t *testing.T) {
+	r := withIdentity(resourceHvnRoute(), hvnRouteIdentity)
+	d := schema.TestResourceDataWithIdentityRaw(t, r.Schema, r.Identity.SchemaFunc(), nil)
+	d.SetId("/project/proj/hashicorp.network.route/route")
+	require.NoError(t, d.Set("hvn_link", "/project/proj/hashicorp.network.hvn/hvn"))
+	require.NoError(t, d.Set("hvn_route_id", "route"))
+
+	require.NoError(t, hvnRouteIdentity.set(d))
+
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	assert.Equal(t, "proj", identity.Get("project_id"))
+	assert.Equal(t, "hvn", identity.Get("hvn_id"))
+	assert.Equal(t, "route", identity.Get("hvn_route_id"))
+}
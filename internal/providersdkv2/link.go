@@ -4,12 +4,9 @@
 package providersdkv2
 
 import (
-	"errors"
-	"fmt"
-	"regexp"
-	"strings"
-
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
+
+	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
 )
 
 // NOTE: The `Link` behavior in this file is based off of the internal cloud-api:
@@ -84,34 +81,7 @@ func newLink(loc *sharedmodels.HashicorpCloudLocationLocation, resourceType stri
 //
 // Adapted from https://github.com/hashicorp/cloud-api-internal/blob/master/helper/hashicorp/cloud/location/link.go#L25-L60
 func linkURL(l *sharedmodels.HashicorpCloudLocationLink) (string, error) {
-	if l == nil {
-		return "", errors.New("nil link")
-	}
-
-	if l.Location == nil {
-		return "", errors.New("link missing Location")
-	}
-
-	// Validate that the link contains the necessary information
-	if l.Location.ProjectID == "" {
-		return "", errors.New("link missing project ID")
-	} else if l.Type == "" {
-		return "", errors.New("link missing resource type")
-	}
-
-	// Determine the ID of the resource
-	id := l.ID
-	if id == "" {
-		return "", errors.New("link missing resource ID")
-	}
-
-	// Generate the URL
-	urn := fmt.Sprintf("/project/%s/%s/%s",
-		l.Location.ProjectID,
-		l.Type,
-		id)
-
-	return urn, nil
+	return clients.LinkURL(l)
 }
 
 // parseLinkURL parses a link URL into a link. If the URL is malformed, an
@@ -126,25 +96,7 @@ func linkURL(l *sharedmodels.HashicorpCloudLocationLink) (string, error) {
 // typically required for requests. If organization is needed, use
 // `buildLinkFromURL()`.
 func parseLinkURL(urn string, expectedType string) (*sharedmodels.HashicorpCloudLocationLink, error) {
-	pattern := "^/project/[^/]+/[^/]+/[^/]+$"
-	match, _ := regexp.MatchString(pattern, urn)
-	if !match {
-		return nil, fmt.Errorf("url %q is not in the correct format: /project/{project_id}/{resource_type}/{id}", urn)
-	}
-
-	components := strings.Split(urn, "/")
-
-	if expectedType != "" && expectedType != components[3] {
-		return nil, fmt.Errorf("url %q is not in the correct format: /project/{project_id}/%s/{id}", urn, expectedType)
-	}
-
-	return &sharedmodels.HashicorpCloudLocationLink{
-		Type: components[3],
-		ID:   components[4],
-		Location: &sharedmodels.HashicorpCloudLocationLocation{
-			ProjectID: components[2],
-		},
-	}, nil
+	return clients.ParseLinkURL(urn, expectedType)
 }
 
 // buildLinkFromURL builds a full link from a link URL. In particular, a link
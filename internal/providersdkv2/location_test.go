@@ -7,10 +7,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
-	"time"
 
-	"github.com/go-openapi/strfmt"
-	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
@@ -44,70 +41,6 @@ func Test_GetProjectID(t *testing.T) {
 	}
 }
 
-// If project ID is not defined on the provider or resource config, the provider
-// project ID becomes the organization's oldest existing project
-func TestDetermineOldestProject(t *testing.T) {
-
-	testCases := []struct {
-		name           string
-		projArray      []*models.HashicorpCloudResourcemanagerProject
-		expectedProjID string
-	}{
-		{
-			name: "One Project",
-			projArray: []*models.HashicorpCloudResourcemanagerProject{
-				{
-					CreatedAt: strfmt.DateTime(time.Date(2010, time.November, 10, 23, 0, 0, 0, time.UTC)),
-					ID:        "proj1",
-				},
-			},
-			expectedProjID: "proj1",
-		},
-		{
-			name: "Two Projects",
-			projArray: []*models.HashicorpCloudResourcemanagerProject{
-				{
-					ID:        "proj1",
-					CreatedAt: strfmt.DateTime(time.Date(2010, time.November, 10, 23, 0, 0, 0, time.UTC)),
-				},
-				{
-					ID:        "proj2",
-					CreatedAt: strfmt.DateTime(time.Date(2009, time.November, 10, 23, 0, 0, 0, time.UTC)),
-				},
-			},
-			expectedProjID: "proj2",
-		},
-		{
-			name: "Three Projects",
-			projArray: []*models.HashicorpCloudResourcemanagerProject{
-				{
-					ID:        "proj1",
-					CreatedAt: strfmt.DateTime(time.Date(2010, time.November, 10, 23, 0, 0, 0, time.UTC)),
-				},
-				{
-					ID:        "proj2",
-					CreatedAt: strfmt.DateTime(time.Date(2007, time.November, 10, 23, 0, 0, 0, time.UTC)),
-				},
-				{
-					ID:        "proj3",
-					CreatedAt: strfmt.DateTime(time.Date(2009, time.November, 10, 23, 0, 0, 0, time.UTC)),
-				},
-			},
-			expectedProjID: "proj2",
-		},
-	}
-
-	for _, testCase := range testCases {
-		t.Run(testCase.name, func(t *testing.T) {
-
-			oldestProject := GetOldestProject(testCase.projArray)
-			assert.Equal(t, testCase.expectedProjID, oldestProject.ID)
-
-		})
-
-	}
-}
-
 var projectID = "prov-project-id-invalid"
 
 func TestAccMultiProject(t *testing.T) {
@@ -9,9 +9,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-cty/cty"
-	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/organization_service"
-	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/client/project_service"
-	"github.com/hashicorp/hcp-sdk-go/clients/cloud-resource-manager/stable/2019-12-10/models"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -45,25 +44,25 @@ func New() func() *schema.Provider {
 				"hcp_vault_plugin":                   dataSourceVaultPlugin(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"hcp_aws_network_peering":            resourceAwsNetworkPeering(),
+				"hcp_aws_network_peering":            withIdentity(resourceAwsNetworkPeering(), awsNetworkPeeringIdentity),
 				"hcp_aws_transit_gateway_attachment": resourceAwsTransitGatewayAttachment(),
-				"hcp_azure_peering_connection":       resourceAzurePeeringConnection(),
-				"hcp_boundary_cluster":               resourceBoundaryCluster(),
-				"hcp_consul_cluster":                 resourceConsulCluster(),
+				"hcp_azure_peering_connection":       withIdentity(resourceAzurePeeringConnection(), azurePeeringConnectionIdentity),
+				"hcp_boundary_cluster":               withIdentity(resourceBoundaryCluster(), boundaryClusterIdentity),
+				"hcp_consul_cluster":                 withIdentity(resourceConsulCluster(), consulClusterIdentity),
 				"hcp_consul_cluster_root_token":      resourceConsulClusterRootToken(),
 				"hcp_consul_snapshot":                resourceConsulSnapshot(),
-				"hcp_dns_forwarding":                 resourceDNSForwarding(),
-				"hcp_dns_forwarding_rule":            resourceDNSForwardingRule(),
-				"hcp_hvn":                            resourceHvn(),
-				"hcp_hvn_peering_connection":         resourceHvnPeeringConnection(),
-				"hcp_hvn_route":                      resourceHvnRoute(),
-				"hcp_packer_channel":                 resourcePackerChannel(),
-				"hcp_packer_channel_assignment":      resourcePackerChannelAssignment(),
+				"hcp_dns_forwarding":                 withIdentity(resourceDNSForwarding(), dnsForwardingIdentity),
+				"hcp_dns_forwarding_rule":            withIdentity(resourceDNSForwardingRule(), dnsForwardingRuleIdentity),
+				"hcp_hvn":                            withIdentity(resourceHvn(), hvnIdentity),
+				"hcp_hvn_peering_connection":         withIdentity(resourceHvnPeeringConnection(), hvnPeeringConnectionIdentity),
+				"hcp_hvn_route":                      withIdentity(resourceHvnRoute(), hvnRouteIdentity),
+				"hcp_packer_channel":                 withIdentity(resourcePackerChannel(), packerChannelIdentity),
+				"hcp_packer_channel_assignment":      withIdentity(resourcePackerChannelAssignment(), packerChannelAssignmentIdentity),
 				"hcp_packer_run_task":                resourcePackerRunTask(),
-				"hcp_private_link":                   resourcePrivateLink(),
-				"hcp_vault_cluster":                  resourceVaultCluster(),
+				"hcp_private_link":                   withIdentity(resourcePrivateLink(), privateLinkIdentity),
+				"hcp_vault_cluster":                  withIdentity(resourceVaultCluster(), vaultClusterIdentity),
 				"hcp_vault_cluster_admin_token":      resourceVaultClusterAdminToken(),
-				"hcp_vault_plugin":                   resourceVaultPlugin(),
+				"hcp_vault_plugin":                   withIdentity(resourceVaultPlugin(), vaultPluginIdentity),
 			},
 			Schema: map[string]*schema.Schema{
 				"client_id": {
@@ -82,6 +81,27 @@ func New() func() *schema.Provider {
 					ValidateFunc: validation.IsUUID,
 					Description:  "The default project in which resources should be created.",
 				},
+				"profile": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "The name of the HCP CLI profile to use. The project of the profile is used if `project_id` isn't set, " +
+						"and the login cached by the HCP CLI if no other credentials are configured. " +
+						"You can alternatively set the HCP_PROFILE environment variable.",
+				},
+				"token_cache_dir": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "The directory in which the tokens of service principals and workload identities are cached, encrypted with " +
+						"`token_cache_key`, such that provider runs with the same credentials reuse them instead of exchanging new ones. " +
+						"You can alternatively set the HCP_TOKEN_CACHE_DIR environment variable. Tokens are not cached if unset.",
+				},
+				"token_cache_key": {
+					Type:      schema.TypeString,
+					Optional:  true,
+					Sensitive: true,
+					Description: "The key the tokens in the `token_cache_dir` are encrypted with, e.g. a long random string. " +
+						"Required if `token_cache_dir` is set. You can alternatively set the HCP_TOKEN_CACHE_KEY environment variable.",
+				},
 				"credential_file": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -121,11 +141,56 @@ func New() func() *schema.Provider {
 					Default:     false,
 					Description: "When set to true, the provider will skip checking the HCP status page for service outages or returning warnings.",
 				},
+				"status_page_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "The URL of the HCP status page summary that is checked for service outages, e.g. a mirror reachable from an air-gapped network. " +
+						"You can alternatively set the HCP_STATUS_PAGE_URL environment variable. Default is the status page of the `geography`.",
+				},
+				"status_check_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description: "The timeout, in seconds, of the request to the HCP status page. " +
+						"You can alternatively set the HCP_STATUS_CHECK_TIMEOUT environment variable. Default is `1`.",
+				},
 				"geography": {
 					Type:        schema.TypeString,
 					Optional:    true,
 					Description: "The geography in which HCP resources should be created. Default is `us`.",
 				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description: "The maximum number of times a request to the HCP API is retried if it fails with a transient error, " +
+						"such as a rate limit or a temporarily unavailable service. Set to `0` to disable retries. Default is `5`.",
+				},
+				"rate_limits": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Description: "Limits the rate and the concurrency of requests the provider sends to each HCP service. " +
+						"The limits apply to every service separately, unless overridden in a `service` block.",
+					Elem: &schema.Resource{
+						Schema: withRateLimitSchema(map[string]*schema.Schema{
+							"service": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Overrides the limits for requests to a single HCP service. Arguments that are not set default to the limits of the enclosing `rate_limits` block.",
+								Elem: &schema.Resource{
+									Schema: withRateLimitSchema(map[string]*schema.Schema{
+										"name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice(clients.RateLimitServices, false),
+											Description:  "The name of the service. Valid values are " + clients.RateLimitServicesMarkdown() + ".",
+										},
+									}),
+								},
+							},
+						}),
+					},
+				},
 			},
 			ProviderMetaSchema: map[string]*schema.Schema{
 				"module_name": {
@@ -142,6 +207,25 @@ func New() func() *schema.Provider {
 	}
 }
 
+// NewProtocol6 upgrades the provider p to protocol 6, and returns a server
+// for it that resolves the HCP client of the provider once resources use it,
+// see clients.NewProviderServer.
+func NewProtocol6(ctx context.Context, p *schema.Provider) (func() tfprotov6.ProviderServer, error) {
+	upgradedSdkProvider, err := tf5to6server.UpgradeServer(ctx, p.GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	server := func() tfprotov6.ProviderServer {
+		return upgradedSdkProvider
+	}
+	client := func() *clients.Client {
+		client, _ := p.Meta().(*clients.Client)
+		return client
+	}
+	return clients.NewProviderServer(server, client), nil
+}
+
 func configure(p *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		var diags diag.Diagnostics
@@ -151,15 +235,43 @@ func configure(p *schema.Provider) func(context.Context, *schema.ResourceData) (
 			ClientSecret:   d.Get("client_secret").(string),
 			CredentialFile: d.Get("credential_file").(string),
 			ProjectID:      d.Get("project_id").(string),
+			Profile:        d.Get("profile").(string),
+			TokenCacheDir:  d.Get("token_cache_dir").(string),
+			TokenCacheKey:  d.Get("token_cache_key").(string),
 			Geography:      d.Get("geography").(string),
 			SourceChannel:  p.UserAgent("terraform-provider-hcp", version.ProviderVersion),
+			MaxRetries:     clients.DefaultMaxRetries,
+		}
+		// An explicit zero disables retries, so the raw config is checked to
+		// tell it apart from an unset value.
+		if !d.GetRawConfig().GetAttr("max_retries").IsNull() {
+			clientConfig.MaxRetries = d.Get("max_retries").(int)
 		}
 		// Determine if status check should be skipped via provider configuration or environment variable.
 		// Previously, skipping depended on the value of HCP_API_HOST but is now controlled explicitly by users.
 		skipStatusCheck := d.Get("skip_status_check").(bool) || os.Getenv("HCP_SKIP_STATUS_CHECK") == "true"
 		if !skipStatusCheck {
-			// This helper verifies HCP's status and returns a warning for degraded performance.
-			diags = statuspage.IsHCPOperationalSDKv2(clientConfig.Geography)
+			// This helper fetches HCP's status, incidents are reported as warnings
+			// once resources of the affected services are used.
+			err := statuspage.Check(statuspage.Options{
+				Geography: clientConfig.Geography,
+				URL:       d.Get("status_page_url").(string),
+				Timeout:   time.Duration(d.Get("status_check_timeout").(int)) * time.Second,
+			})
+			if err != nil {
+				return nil, diag.Errorf("invalid status check configuration: %v", err)
+			}
+		}
+
+		// Read the rate_limits configuration. The raw configuration is used
+		// to tell unset limits apart from explicit zero values.
+		if rl := d.GetRawConfig().GetAttr("rate_limits"); rl.IsKnown() && !rl.IsNull() && rl.LengthInt() == 1 {
+			var moreDiags diag.Diagnostics
+			clientConfig, moreDiags = readRateLimits(rl.Index(cty.NumberIntVal(0)), clientConfig)
+			diags = append(diags, moreDiags...)
+			if moreDiags.HasError() {
+				return nil, diags
+			}
 		}
 
 		// Read the workload_identity configuration
@@ -172,48 +284,17 @@ func configure(p *schema.Provider) func(context.Context, *schema.ResourceData) (
 			}
 		}
 
-		client, err := clients.NewClient(clientConfig)
-		if err != nil {
-			diags = append(diags, diag.Errorf("unable to create HCP api client: %v", err)...)
-			return nil, diags
-		}
-
 		// Attempt to source from the environment if unset.
 		if clientConfig.ProjectID == "" {
 			clientConfig.ProjectID = os.Getenv("HCP_PROJECT_ID")
 		}
 
-		if clientConfig.ProjectID != "" {
-			getProjParams := project_service.NewProjectServiceGetParams()
-			getProjParams.ID = clientConfig.ProjectID
-			project, err := clients.RetryProjectServiceGet(client, getProjParams)
-			if err != nil {
-				diags = append(diags, diag.Errorf("unable to fetch project %q: %v", clientConfig.ProjectID, err)...)
-				return nil, diags
-			}
-
-			client.Config.ProjectID = project.Payload.Project.ID
-			client.Config.OrganizationID = project.Payload.Project.Parent.ID
-
-		} else {
-			// For the initial release of the HCP TFP, since only one project was allowed per organization at the time,
-			// the provider handled used the single organization's single project by default, instead of requiring the
-			// user to set it. Once multiple projects are available, this helper issues a warning: when multiple projects exist within the org,
-			// a project ID should be set on the provider or on each resource. Otherwise, the oldest project will be used by default.
-			// This helper will eventually be deprecated after a migration period.
-			project, projDiags := getProjectFromCredentials(ctx, client)
-			if projDiags != nil {
-				if !projDiags.HasError() {
-					diags = append(diags, projDiags...)
-				} else {
-					projDiags = append(projDiags, diag.Errorf("unable to get project from credentials")...)
-					diags = append(diags, projDiags...)
-					return nil, diags
-				}
-			}
-
-			client.Config.OrganizationID = project.Parent.ID
-			client.Config.ProjectID = project.ID
+		// The client is only resolved once resources use it, such that the
+		// provider can validate configurations without network access.
+		client, err := clients.NewDeferredClient(clientConfig)
+		if err != nil {
+			diags = append(diags, diag.Errorf("unable to create HCP api client: %v", err)...)
+			return nil, diags
 		}
 
 		return client, diags
@@ -246,69 +327,77 @@ func readWorkloadIdentity(v interface{}, clientConfig clients.ClientConfig) (cli
 	return clientConfig, diags
 }
 
-// getProjectFromCredentials uses the configured client credentials to
-// fetch the associated organization and returns that organization's
-// single project.
-func getProjectFromCredentials(ctx context.Context, client *clients.Client) (project *models.HashicorpCloudResourcemanagerProject, diags diag.Diagnostics) {
-	// Get the organization ID.
-	listOrgParams := organization_service.NewOrganizationServiceListParams()
-	listOrgResp, err := clients.RetryOrganizationServiceList(client, listOrgParams)
-	if err != nil {
-		diags = append(diags, diag.Errorf("unable to fetch organization list: %v", err)...)
-		return nil, diags
+// withRateLimitSchema adds the arguments configuring the limits of a service
+// in the rate_limits block to the passed schema.
+func withRateLimitSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["requests_per_second"] = &schema.Schema{
+		Type:         schema.TypeFloat,
+		Optional:     true,
+		ValidateFunc: validation.FloatAtLeast(0),
+		Description:  "The sustained number of requests per second sent to the service. Rate limiting is disabled if unset.",
 	}
-	orgLen := len(listOrgResp.Payload.Organizations)
-	if orgLen == 0 {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "The configured credentials do not have access to any organization.",
-			Detail:   "Please assign at least one organization to the configured credentials to use this provider.",
-		})
-		return nil, diags
+	s["burst"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The number of requests that may be sent at once before `requests_per_second` applies. Default is `1`.",
 	}
-	if orgLen > 1 {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "There is more than one organization associated with the configured credentials.",
-			Detail:   "Please configure a specific project in the HCP provider config block",
-		})
-		return nil, diags
+	s["max_concurrent_requests"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The maximum number of requests to the service that may be in flight at once. Concurrency is not limited if unset.",
 	}
+	return s
+}
 
-	orgID := listOrgResp.Payload.Organizations[0].ID
+// readRateLimits reads the rate_limits block from the raw configuration.
+func readRateLimits(v cty.Value, clientConfig clients.ClientConfig) (clients.ClientConfig, diag.Diagnostics) {
+	var serviceConfigs []clients.ServiceRateLimitConfig
+	if services := v.GetAttr("service"); services.IsKnown() && !services.IsNull() {
+		for it := services.ElementIterator(); it.Next(); {
+			_, service := it.Element()
 
-	// Get the project using the organization ID.
-	listProjParams := project_service.NewProjectServiceListParams()
-	listProjParams.ScopeID = &orgID
-	scopeType := string(models.HashicorpCloudResourcemanagerResourceIDResourceTypeORGANIZATION)
-	listProjParams.ScopeType = &scopeType
-	listProjResp, err := clients.RetryProjectServiceList(client, listProjParams)
-	if err != nil {
-		diags = append(diags, diag.Errorf("unable to fetch project id: %v", err)...)
-		return nil, diags
+			name := service.GetAttr("name")
+			if !name.IsKnown() || name.IsNull() {
+				continue
+			}
+
+			serviceConfigs = append(serviceConfigs, clients.ServiceRateLimitConfig{
+				Name:            name.AsString(),
+				RateLimitConfig: rateLimitConfig(service),
+			})
+		}
 	}
-	if len(listProjResp.Payload.Projects) > 1 {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Warning,
-			Summary:  "There is more than one project associated with the organization of the configured credentials.",
-			Detail:   `The oldest project has been selected as the default. To configure which project is used as default, set a project in the HCP provider config block. Resources may also be configured with different projects.`,
-		})
-		return GetOldestProject(listProjResp.Payload.Projects), diags
+
+	rateLimits, err := clients.NewRateLimits(rateLimitConfig(v), serviceConfigs)
+	if err != nil {
+		return clientConfig, diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "invalid rate_limits",
+			Detail:        err.Error(),
+			AttributePath: cty.GetAttrPath("rate_limits"),
+		}}
 	}
-	project = listProjResp.Payload.Projects[0]
-	return project, diags
-}
+	clientConfig.RateLimits = rateLimits
 
-// GetOldestProject retrieves the oldest project from a list based on its created_at time.
-func GetOldestProject(projects []*models.HashicorpCloudResourcemanagerProject) (oldestProj *models.HashicorpCloudResourcemanagerProject) {
-	oldestTime := time.Now()
+	return clientConfig, nil
+}
 
-	for _, proj := range projects {
-		projTime := time.Time(proj.CreatedAt)
-		if projTime.Before(oldestTime) {
-			oldestProj = proj
-			oldestTime = projTime
-		}
+// rateLimitConfig returns the limits set in v.
+func rateLimitConfig(v cty.Value) clients.RateLimitConfig {
+	var config clients.RateLimitConfig
+	if rps := v.GetAttr("requests_per_second"); rps.IsKnown() && !rps.IsNull() {
+		f, _ := rps.AsBigFloat().Float64()
+		config.RequestsPerSecond = &f
+	}
+	if burst := v.GetAttr("burst"); burst.IsKnown() && !burst.IsNull() {
+		b, _ := burst.AsBigFloat().Int64()
+		config.Burst = &b
+	}
+	if mcr := v.GetAttr("max_concurrent_requests"); mcr.IsKnown() && !mcr.IsNull() {
+		m, _ := mcr.AsBigFloat().Int64()
+		config.MaxConcurrentRequests = &m
 	}
-	return oldestProj
+	return config
 }
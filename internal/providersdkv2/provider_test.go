@@ -12,7 +12,6 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
@@ -44,19 +43,14 @@ var testAccProviderConfigure sync.Once
 var testProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"hcp": func() (tfprotov6.ProviderServer, error) {
 		// Upgrade the provider sdkv2 version to protocol 6
-		upgradedSdkProvider, err := tf5to6server.UpgradeServer(
-			context.Background(),
-			New()().GRPCProvider,
-		)
+		sdkProvider, err := NewProtocol6(context.Background(), New()())
 		if err != nil {
 			return nil, err
 		}
 
 		providers := []func() tfprotov6.ProviderServer{
-			providerserver.NewProtocol6(provider.NewFrameworkProvider(version.ProviderVersion)()),
-			func() tfprotov6.ProviderServer {
-				return upgradedSdkProvider
-			},
+			provider.NewProtocol6(provider.NewFrameworkProvider(version.ProviderVersion)()),
+			sdkProvider,
 		}
 		return tf6muxserver.NewMuxServer(context.Background(), providers...)
 	},
@@ -137,6 +131,10 @@ func testAccPreCheck(t *testing.T, requiredCreds map[string]bool) {
 		if testDiag.HasError() {
 			t.Fatalf("unexpected error, exiting test: %#v", testDiag)
 		}
+
+		if err := testAccProvider.Meta().(*clients.Client).Resolve(context.Background()); err != nil {
+			t.Fatalf("unexpected error resolving the client, exiting test: %v", err)
+		}
 	})
 }
 
@@ -120,13 +120,6 @@ If a project is not configured in the HCP Provider config block, the oldest proj
 func resourceAwsNetworkPeeringCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client)
 
-	var err error
-	// Updates the source channel to include data about the module used.
-	client, err = client.UpdateSourceChannel(d)
-	if err != nil {
-		log.Printf("[DEBUG] Failed to update analytics with module name (%s)", err)
-	}
-
 	peeringID := d.Get("peering_id").(string)
 	hvnID := d.Get("hvn_id").(string)
 	peerAccountID := d.Get("peer_account_id").(string)
@@ -349,6 +342,14 @@ func setAwsPeeringResourceData(d *schema.ResourceData, peering *networkmodels.Ha
 	return nil
 }
 
+// awsNetworkPeeringIdentity is the identity of hcp_aws_network_peering, see withIdentity.
+var awsNetworkPeeringIdentity = resourceIdentity{
+	attributes: []identityAttribute{
+		{name: "hvn_id", description: "The ID of the HashiCorp Virtual Network (HVN)."},
+		{name: "peering_id", description: "The ID of the network peering."},
+	},
+}
+
 // resourceAwsNetworkPeeringImport implements the logic necessary to import an
 // un-tracked (by Terraform) network peering resource into Terraform state.
 func resourceAwsNetworkPeeringImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
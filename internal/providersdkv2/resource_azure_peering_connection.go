@@ -143,13 +143,6 @@ func resourceAzurePeeringConnectionCreate(ctx context.Context, d *schema.Resourc
 
 	client := meta.(*clients.Client)
 
-	var err error
-	// Updates the source channel to include data about the module used.
-	client, err = client.UpdateSourceChannel(d)
-	if err != nil {
-		log.Printf("[DEBUG] Failed to update analytics with module name (%s)", err)
-	}
-
 	peeringID := d.Get("peering_id").(string)
 	peerSubscriptionID := d.Get("peer_subscription_id").(string)
 	peerVnetID := d.Get("peer_vnet_name").(string)
@@ -391,6 +384,14 @@ func setAzurePeeringResourceData(d *schema.ResourceData, peering *networkmodels.
 	return nil
 }
 
+// azurePeeringConnectionIdentity is the identity of hcp_azure_peering_connection, see withIdentity.
+var azurePeeringConnectionIdentity = resourceIdentity{
+	attributes: []identityAttribute{
+		{name: "hvn_id", description: "The ID of the HashiCorp Virtual Network (HVN).", linkAttribute: "hvn_link"},
+		{name: "peering_id", description: "The ID of the peering connection."},
+	},
+}
+
 // resourceAzurePeeringConnectionImport implements the logic necessary to import an
 // un-tracked (by Terraform) peering connection resource into Terraform state.
 func resourceAzurePeeringConnectionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	boundarymodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-boundary-service/stable/2021-12-21/models"
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -66,12 +67,29 @@ func resourceBoundaryCluster() *schema.Resource {
 				ValidateDiagFunc: validateBoundaryUsername,
 			},
 			"password": {
-				Description:      "The password of the initial admin user. This must be at least 8 characters in length. Note that this may show up in logs, and it will be stored in the state file.",
+				Description:      "The password of the initial admin user. This must be at least 8 characters in length. Note that this may show up in logs, and it will be stored in the state file. Exactly one of `password` or `password_wo` must be set.",
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				ForceNew:         true,
 				ValidateDiagFunc: validateBoundaryPassword,
 				Sensitive:        true,
+				ExactlyOneOf:     []string{"password", "password_wo"},
+			},
+			"password_wo": {
+				Description:      "The password of the initial admin user. This must be at least 8 characters in length. This value is write-only and is not stored in the state file. Requires Terraform 1.11 or later.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				WriteOnly:        true,
+				ValidateDiagFunc: validateBoundaryPassword,
+				Sensitive:        true,
+				ExactlyOneOf:     []string{"password", "password_wo"},
+			},
+			"password_wo_version": {
+				Description:  "Version of the `password_wo` value. Terraform cannot detect changes to write-only values, change this version to recreate the cluster with the new password.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"password_wo"},
 			},
 			// Optional inputs
 			"project_id": {
@@ -216,6 +234,13 @@ func resourceBoundaryClusterCreate(ctx context.Context, d *schema.ResourceData,
 	clusterID := d.Get("cluster_id").(string)
 	username := d.Get("username").(string)
 	password := d.Get("password").(string)
+	if password == "" {
+		var diags diag.Diagnostics
+		password, diags = getWriteOnlyString(d, cty.GetAttrPath("password_wo"))
+		if diags.HasError() {
+			return diags
+		}
+	}
 	projectID, err := GetProjectID(d.Get("project_id").(string), client.Config.ProjectID)
 	if err != nil {
 		return diag.Errorf("unable to retrieve project ID: %v", err)
@@ -491,6 +516,13 @@ func resourceBoundaryClusterDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// boundaryClusterIdentity is the identity of hcp_boundary_cluster, see withIdentity.
+var boundaryClusterIdentity = resourceIdentity{
+	attributes: []identityAttribute{
+		{name: "cluster_id", description: "The ID of the Boundary cluster."},
+	},
+}
+
 func resourceBoundaryClusterImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// with multi-projects, import arguments must become dynamic:
 	// use explicit project ID with terraform import:
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
 	"github.com/hashicorp/terraform-provider-hcp/internal/consul"
+	"github.com/hashicorp/terraform-provider-hcp/internal/customdiags"
 	"github.com/hashicorp/terraform-provider-hcp/internal/input"
 )
 
@@ -196,11 +197,19 @@ If a project is not configured in the HCP Provider config block, the oldest proj
 				Description: "The cluster config encoded as a Base64 string.",
 				Type:        schema.TypeString,
 				Computed:    true,
+				Deprecated: `
+Reading the client configuration of the cluster from the 'hcp_consul_cluster' resource is deprecated, as it stores the configuration in the Terraform state.
+Use the 'hcp_consul_cluster_root_token' ephemeral resource instead, which requires Terraform 1.10 or later.
+`,
 			},
 			"consul_ca_file": {
 				Description: "The cluster CA file encoded as a Base64 string.",
 				Type:        schema.TypeString,
 				Computed:    true,
+				Deprecated: `
+Reading the client configuration of the cluster from the 'hcp_consul_cluster' resource is deprecated, as it stores the configuration in the Terraform state.
+Use the 'hcp_consul_cluster_root_token' ephemeral resource instead, which requires Terraform 1.10 or later.
+`,
 			},
 			"consul_version": {
 				Description: "The Consul version of the cluster.",
@@ -243,12 +252,20 @@ If a project is not configured in the HCP Provider config block, the oldest proj
 				Description: "The accessor ID of the root ACL token that is generated upon cluster creation.",
 				Type:        schema.TypeString,
 				Computed:    true,
+				Deprecated: `
+Reading the root ACL token of the cluster from the 'hcp_consul_cluster' resource is deprecated, as it stores the token in the Terraform state.
+Use the 'hcp_consul_cluster_root_token' ephemeral resource instead, which requires Terraform 1.10 or later. It generates a new root token every time it's opened, which invalidates the previous one.
+`,
 			},
 			"consul_root_token_secret_id": {
 				Description: "The secret ID of the root ACL token that is generated upon cluster creation.",
 				Type:        schema.TypeString,
 				Computed:    true,
 				Sensitive:   true,
+				Deprecated: `
+Reading the root ACL token of the cluster from the 'hcp_consul_cluster' resource is deprecated, as it stores the token in the Terraform state.
+Use the 'hcp_consul_cluster_root_token' ephemeral resource instead, which requires Terraform 1.10 or later. It generates a new root token every time it's opened, which invalidates the previous one.
+`,
 			},
 			"scale": {
 				Description: "The number of Consul server nodes in the cluster.",
@@ -267,13 +284,6 @@ If a project is not configured in the HCP Provider config block, the oldest proj
 func resourceConsulClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client)
 
-	var err error
-	// Updates the source channel to include data about the module used.
-	client, err = client.UpdateSourceChannel(d)
-	if err != nil {
-		log.Printf("[DEBUG] Failed to update analytics with module name (%s)", err)
-	}
-
 	projectID, err := GetProjectID(d.Get("project_id").(string), client.Config.ProjectID)
 	if err != nil {
 		return diag.Errorf("unable to retrieve project ID: %v", err)
@@ -289,7 +299,7 @@ func resourceConsulClusterCreate(ctx context.Context, d *schema.ResourceData, me
 	// Use the hvn to get provider and region.
 	hvn, err := clients.GetHvnByID(ctx, client, loc, hvnID)
 	if err != nil {
-		return diag.Errorf("unable to find existing HVN (%s): %v", hvnID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to find existing HVN (%s)", hvnID), err, nil)
 	}
 	loc.Region = &sharedmodels.HashicorpCloudLocationRegion{
 		Provider: hvn.Location.Region.Provider,
@@ -300,7 +310,7 @@ func resourceConsulClusterCreate(ctx context.Context, d *schema.ResourceData, me
 	_, err = clients.GetConsulClusterByID(ctx, client, loc, clusterID)
 	if err != nil {
 		if !clients.IsResponseCodeNotFound(err) {
-			return diag.Errorf("unable to check for presence of an existing Consul cluster (%s): %v", clusterID, err)
+			return customdiags.SDKv2APIError(fmt.Sprintf("unable to check for presence of an existing Consul cluster (%s)", clusterID), err, nil)
 		}
 
 		// a 404 indicates a Consul cluster was not found
@@ -312,7 +322,7 @@ func resourceConsulClusterCreate(ctx context.Context, d *schema.ResourceData, me
 	// fetch available version from HCP
 	availableConsulVersions, err := clients.GetAvailableHCPConsulVersionsForLocation(ctx, loc, client)
 	if err != nil || availableConsulVersions == nil {
-		return diag.Errorf("error fetching available HCP Consul versions: %v", err)
+		return customdiags.SDKv2APIError("error fetching available HCP Consul versions", err, nil)
 	}
 
 	// determine recommended version
@@ -348,7 +358,7 @@ func resourceConsulClusterCreate(ctx context.Context, d *schema.ResourceData, me
 		// fetch the primary cluster
 		primaryConsulCluster, err := clients.GetConsulClusterByID(ctx, client, primary.Location, primary.ID)
 		if err != nil {
-			return diag.Errorf("unable to check for presence of an existing primary Consul cluster (%s): %v", primary.ID, err)
+			return customdiags.SDKv2APIError(fmt.Sprintf("unable to check for presence of an existing primary Consul cluster (%s)", primary.ID), err, nil)
 		}
 		primary.Location.Region = primaryConsulCluster.Location.Region
 	}
@@ -413,7 +423,7 @@ func resourceConsulClusterCreate(ctx context.Context, d *schema.ResourceData, me
 
 	payload, err := clients.CreateConsulCluster(ctx, client, loc, consulCuster)
 	if err != nil {
-		return diag.Errorf("unable to create Consul cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to create Consul cluster (%s)", clusterID), err, nil)
 	}
 
 	link := newLink(loc, ConsulClusterResourceType, clusterID)
@@ -426,7 +436,7 @@ func resourceConsulClusterCreate(ctx context.Context, d *schema.ResourceData, me
 
 	// wait for the Consul cluster to be created
 	if err := clients.WaitForOperation(ctx, client, "create Consul cluster", loc, payload.Operation.ID); err != nil {
-		return diag.Errorf("unable to create Consul cluster (%s): %v", payload.Cluster.ID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to create Consul cluster (%s)", payload.Cluster.ID), err, nil)
 	}
 
 	log.Printf("[INFO] Created Consul cluster (%s)", payload.Cluster.ID)
@@ -434,7 +444,7 @@ func resourceConsulClusterCreate(ctx context.Context, d *schema.ResourceData, me
 	// get the created Consul cluster
 	cluster, err := clients.GetConsulClusterByID(ctx, client, loc, payload.Cluster.ID)
 	if err != nil {
-		return diag.Errorf("unable to retrieve Consul cluster (%s): %v", payload.Cluster.ID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to retrieve Consul cluster (%s)", payload.Cluster.ID), err, nil)
 	}
 
 	if err := setConsulClusterResourceData(d, cluster); err != nil {
@@ -455,7 +465,7 @@ func resourceConsulClusterCreate(ctx context.Context, d *schema.ResourceData, me
 	// create customer root ACL token
 	rootACLToken, err := clients.CreateCustomerRootACLToken(ctx, client, loc, payload.Cluster.ID)
 	if err != nil {
-		return diag.Errorf("unable to create root ACL token for cluster (%s): %v", payload.Cluster.ID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to create root ACL token for cluster (%s)", payload.Cluster.ID), err, nil)
 	}
 
 	// Only set root token keys after create
@@ -634,7 +644,7 @@ func resourceConsulClusterRead(ctx context.Context, d *schema.ResourceData, meta
 			return nil
 		}
 
-		return diag.Errorf("unable to fetch Consul cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to fetch Consul cluster (%s)", clusterID), err, nil)
 	}
 
 	// we should only ever get a CodeNotFound response if the cluster is deleted. The below is precautionary
@@ -683,7 +693,7 @@ func resourceConsulClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 			return nil
 		}
 
-		return diag.Errorf("unable to fetch Consul cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to fetch Consul cluster (%s)", clusterID), err, nil)
 	}
 
 	// Confirm update fields have been changed
@@ -711,7 +721,7 @@ func resourceConsulClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 		// Fetch available upgrade versions
 		upgradeVersions, err := clients.ListConsulUpgradeVersions(ctx, client, cluster.Location, clusterID)
 		if err != nil {
-			return diag.Errorf("unable to list Consul upgrade versions (%s): %v", clusterID, err)
+			return customdiags.SDKv2APIError(fmt.Sprintf("unable to list Consul upgrade versions (%s)", clusterID), err, nil)
 		}
 		version := d.Get("min_consul_version")
 		newConsulVersion := input.NormalizeVersion(version.(string))
@@ -767,18 +777,18 @@ func resourceConsulClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 	// Invoke update cluster endpoint
 	updateResp, err := clients.UpdateConsulCluster(ctx, client, &targetCluster)
 	if err != nil {
-		return diag.Errorf("error updating Consul cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("error updating Consul cluster (%s)", clusterID), err, nil)
 	}
 
 	// Wait for the update cluster operation
 	if err := clients.WaitForOperation(ctx, client, "update Consul cluster", cluster.Location, updateResp.Operation.ID); err != nil {
-		return diag.Errorf("unable to update Consul cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to update Consul cluster (%s)", clusterID), err, nil)
 	}
 
 	// Get updated Consul cluster
 	updatedCluster, err := clients.GetConsulClusterByID(ctx, client, loc, clusterID)
 	if err != nil {
-		return diag.Errorf("unable to retrieve Consul cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to retrieve Consul cluster (%s)", clusterID), err, nil)
 	}
 
 	if err := setConsulClusterResourceData(d, updatedCluster); err != nil {
@@ -819,17 +829,24 @@ func resourceConsulClusterDelete(ctx context.Context, d *schema.ResourceData, me
 			return nil
 		}
 
-		return diag.Errorf("unable to delete Consul cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to delete Consul cluster (%s)", clusterID), err, nil)
 	}
 
 	// Wait for the delete cluster operation
 	if err := clients.WaitForOperation(ctx, client, "delete Consul cluster", loc, deleteResp.Operation.ID); err != nil {
-		return diag.Errorf("unable to delete Consul cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to delete Consul cluster (%s)", clusterID), err, nil)
 	}
 
 	return nil
 }
 
+// consulClusterIdentity is the identity of hcp_consul_cluster, see withIdentity.
+var consulClusterIdentity = resourceIdentity{
+	attributes: []identityAttribute{
+		{name: "cluster_id", description: "The ID of the HCP Consul cluster."},
+	},
+}
+
 func resourceConsulClusterImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// with multi-projects, import arguments must become dynamic:
 	// use explicit project ID with terraform import:
@@ -248,6 +248,20 @@ func resourceDNSForwardingRead(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+// dnsForwardingIdentity is the identity of hcp_dns_forwarding, see withIdentity.
+var dnsForwardingIdentity = resourceIdentity{
+	attributes: []identityAttribute{
+		{name: "hvn_id", description: "The ID of the HVN that this DNS forwarding belongs to."},
+		{name: "dns_forwarding_id", description: "The ID of the DNS forwarding configuration."},
+	},
+	importID: func(projectID string, values []string) string {
+		if projectID == "" {
+			return strings.Join(values, ":")
+		}
+		return fmt.Sprintf("/project/%s/hvn/%s/dns-forwarding/%s", projectID, values[0], values[1])
+	},
+}
+
 func resourceDNSForwardingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// The import ID is expected to be in the format:
 	// /project/{project_id}/hvn/{hvn_id}/dns-forwarding/{dns_forwarding_id}
@@ -258,6 +258,21 @@ func resourceDNSForwardingRuleDelete(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
+// dnsForwardingRuleIdentity is the identity of hcp_dns_forwarding_rule, see withIdentity.
+var dnsForwardingRuleIdentity = resourceIdentity{
+	attributes: []identityAttribute{
+		{name: "hvn_id", description: "The ID of the HVN that this DNS forwarding rule belongs to."},
+		{name: "dns_forwarding_id", description: "The ID of the DNS forwarding configuration this rule belongs to."},
+		{name: "rule_id", description: "The ID of the DNS forwarding rule."},
+	},
+	importID: func(projectID string, values []string) string {
+		if projectID == "" {
+			return strings.Join(values, ":")
+		}
+		return fmt.Sprintf("/project/%s/hvn/%s/dns-forwarding/%s/dns-forwarding-rule/%s", projectID, values[0], values[1], values[2])
+	},
+}
+
 func resourceDNSForwardingRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// The import ID is expected to be in the format:
 	// /project/{project_id}/hvn/{hvn_id}/dns-forwarding/{dns_forwarding_id}/dns-forwarding-rule/{rule_id}
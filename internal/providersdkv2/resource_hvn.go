@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/customdiags"
 )
 
 var hvnDefaultTimeout = time.Minute * 1
@@ -147,7 +148,7 @@ func resourceHvnCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	_, err = clients.GetHvnByID(ctx, client, loc, hvnID)
 	if err != nil {
 		if !clients.IsResponseCodeNotFound(err) {
-			return diag.Errorf("unable to check for presence of an existing HVN (%s): %v", hvnID, err)
+			return customdiags.SDKv2APIError(fmt.Sprintf("unable to check for presence of an existing HVN (%s)", hvnID), err, nil)
 		}
 
 		log.Printf("[INFO] HVN (%s) not found, proceeding with create", hvnID)
@@ -169,7 +170,7 @@ func resourceHvnCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	log.Printf("[INFO] Creating HVN (%s)", hvnID)
 	createNetworkResponse, err := client.Network.Create(createNetworkParams, nil)
 	if err != nil {
-		return diag.Errorf("unable to create HVN (%s): %v", hvnID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to create HVN (%s)", hvnID), err, nil)
 	}
 
 	link := newLink(loc, HvnResourceType, hvnID)
@@ -181,7 +182,7 @@ func resourceHvnCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	// Wait for HVN to be created
 	if err := clients.WaitForOperation(ctx, client, "create HVN", loc, createNetworkResponse.Payload.Operation.ID); err != nil {
-		return diag.Errorf("unable to create HVN (%s): %v", createNetworkResponse.Payload.Network.ID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to create HVN (%s)", createNetworkResponse.Payload.Network.ID), err, nil)
 	}
 
 	log.Printf("[INFO] Created HVN (%s)", createNetworkResponse.Payload.Network.ID)
@@ -189,7 +190,7 @@ func resourceHvnCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 	// Get the updated HVN
 	hvn, err := clients.GetHvnByID(ctx, client, loc, createNetworkResponse.Payload.Network.ID)
 	if err != nil {
-		return diag.Errorf("unable to retrieve HVN (%s): %v", createNetworkResponse.Payload.Network.ID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to retrieve HVN (%s)", createNetworkResponse.Payload.Network.ID), err, nil)
 	}
 
 	if err := setHvnResourceData(d, hvn); err != nil {
@@ -219,7 +220,7 @@ func resourceHvnRead(ctx context.Context, d *schema.ResourceData, meta interface
 			return nil
 		}
 
-		return diag.Errorf("unable to retrieve HVN (%s): %v", hvnID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to retrieve HVN (%s)", hvnID), err, nil)
 	}
 
 	// The HVN has already been deleted, remove from state.
@@ -261,12 +262,12 @@ func resourceHvnDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 			return nil
 		}
 
-		return diag.Errorf("unable to delete HVN (%s): %v", hvnID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to delete HVN (%s)", hvnID), err, nil)
 	}
 
 	// Wait for delete hvn operation
 	if err := clients.WaitForOperation(ctx, client, "delete HVN", loc, deleteResponse.Payload.Operation.ID); err != nil {
-		return diag.Errorf("unable to delete HVN (%s): %v", hvnID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to delete HVN (%s)", hvnID), err, nil)
 	}
 
 	log.Printf("[INFO] HVN (%s) deleted, removing from state", hvnID)
@@ -323,6 +324,13 @@ func setHvnResourceData(d *schema.ResourceData, hvn *networkmodels.HashicorpClou
 	return nil
 }
 
+// hvnIdentity is the identity of hcp_hvn, see withIdentity.
+var hvnIdentity = resourceIdentity{
+	attributes: []identityAttribute{
+		{name: "hvn_id", description: "The ID of the HashiCorp Virtual Network (HVN)."},
+	},
+}
+
 // resourceHvnImport implements the logic necessary to import an un-tracked
 // (by Terraform) HVN resource into Terraform state.
 func resourceHvnImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
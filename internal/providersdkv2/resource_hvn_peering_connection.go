@@ -111,17 +111,6 @@ func resourceHvnPeeringConnectionCustomizeDiff(ctx context.Context, d *schema.Re
 func resourceHvnPeeringConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client)
 
-	var err error
-	// Updates the source channel to include data about the module used.
-	client, err = client.UpdateSourceChannel(d)
-	if err != nil {
-		log.Printf("[DEBUG] Failed to update analytics with module name (%s)", err)
-	}
-
-	if err != nil {
-		return diag.Errorf("unable to retrieve project ID: %v", err)
-	}
-
 	hvn1Link, err := buildLinkFromURL(d.Get("hvn_1").(string), HvnResourceType, client.Config.OrganizationID)
 	if err != nil {
 		return diag.FromErr(err)
@@ -276,6 +265,14 @@ func resourceHvnPeeringConnectionDelete(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
+// hvnPeeringConnectionIdentity is the identity of hcp_hvn_peering_connection, see withIdentity.
+var hvnPeeringConnectionIdentity = resourceIdentity{
+	attributes: []identityAttribute{
+		{name: "hvn_1_id", description: "The ID of the HVN of `hvn_1`.", linkAttribute: "hvn_1"},
+		{name: "peering_id", description: "The ID of the peering connection."},
+	},
+}
+
 func resourceHvnPeeringConnectionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// with multi-projects, import arguments must become dynamic:
 	// use explicit project ID with terraform import:
@@ -339,6 +339,14 @@ func setHVNRouteResourceData(d *schema.ResourceData, route *networkmodels.Hashic
 	return nil
 }
 
+// hvnRouteIdentity is the identity of hcp_hvn_route, see withIdentity.
+var hvnRouteIdentity = resourceIdentity{
+	attributes: []identityAttribute{
+		{name: "hvn_id", description: "The ID of the HashiCorp Virtual Network (HVN).", linkAttribute: "hvn_link"},
+		{name: "hvn_route_id", description: "The ID of the HVN route."},
+	},
+}
+
 // resourceHVNRouteImport implements the logic necessary to import an
 // un-tracked (by Terraform) HVN route resource into Terraform state.
 func resourceHVNRouteImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -353,12 +361,6 @@ func resourceHVNRouteImport(ctx context.Context, d *schema.ResourceData, meta in
 	hvnID := ""
 	routeID := ""
 	var err error
-	// Updates the source channel to include data about the module used.
-	client, err = client.UpdateSourceChannel(d)
-	if err != nil {
-		log.Printf("[DEBUG] Failed to update analytics with module name (%s)", err)
-	}
-
 	idParts := strings.SplitN(d.Id(), ":", 3)
 	if len(idParts) == 3 { // {project_id}:{hvn_id}:{hvn_route_id}
 		if idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
@@ -264,6 +264,14 @@ func resourcePackerChannelDelete(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
+// packerChannelIdentity is the identity of hcp_packer_channel, see withIdentity.
+var packerChannelIdentity = resourceIdentity{
+	attributes: []identityAttribute{
+		{name: "bucket_name", description: "The name of the HCP Packer Registry bucket of the channel."},
+		{name: "name", description: "The name of the channel."},
+	},
+}
+
 func resourcePackerChannelImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// with multi-projects, import arguments must become dynamic:
 	// use explicit project ID with terraform import:
@@ -276,12 +284,6 @@ func resourcePackerChannelImport(ctx context.Context, d *schema.ResourceData, me
 	channelName := ""
 	projectID := ""
 	var err error
-	// Updates the source channel to include data about the module used.
-	client, err = client.UpdateSourceChannel(d)
-	if err != nil {
-		log.Printf("[DEBUG] Failed to update analytics with module name (%s)", err)
-	}
-
 	idParts := strings.SplitN(d.Id(), ":", 3)
 	if len(idParts) == 3 { // {project_id}:{bucket_name}:{channel_name}
 		if idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
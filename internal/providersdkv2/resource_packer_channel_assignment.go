@@ -6,7 +6,6 @@ package providersdkv2
 import (
 	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
@@ -208,6 +207,14 @@ func resourcePackerChannelAssignmentDelete(ctx context.Context, d *schema.Resour
 	return nil
 }
 
+// packerChannelAssignmentIdentity is the identity of hcp_packer_channel_assignment, see withIdentity.
+var packerChannelAssignmentIdentity = resourceIdentity{
+	attributes: []identityAttribute{
+		{name: "bucket_name", description: "The slug of the HCP Packer bucket where the channel is located."},
+		{name: "channel_name", description: "The name of the HCP Packer channel being managed."},
+	},
+}
+
 func resourcePackerChannelAssignmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// with multi-projects, import arguments must become dynamic:
 	// use explicit project ID with terraform import:
@@ -220,12 +227,6 @@ func resourcePackerChannelAssignmentImport(ctx context.Context, d *schema.Resour
 	channelName := ""
 	projectID := ""
 	var err error
-	// Updates the source channel to include data about the module used.
-	client, err = client.UpdateSourceChannel(d)
-	if err != nil {
-		log.Printf("[DEBUG] Failed to update analytics with module name (%s)", err)
-	}
-
 	idParts := strings.SplitN(d.Id(), ":", 3)
 	if len(idParts) == 3 { // {project_id}:{bucket_name}:{channel_name}
 		if idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
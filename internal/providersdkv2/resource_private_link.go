@@ -120,13 +120,6 @@ func resourcePrivateLink() *schema.Resource {
 func resourcePrivateLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client)
 
-	var err error
-	// Updates the source channel to include data about the module used.
-	client, err = client.UpdateSourceChannel(d)
-	if err != nil {
-		log.Printf("[DEBUG] Failed to update analytics with module name (%s)", err)
-	}
-
 	privateLinkID := d.Get("private_link_id").(string)
 	hvnID := d.Get("hvn_id").(string)
 	vaultClusterID := d.Get("vault_cluster_id").(string)
@@ -487,6 +480,14 @@ func resourcePrivateLinkDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+// privateLinkIdentity is the identity of hcp_private_link, see withIdentity.
+var privateLinkIdentity = resourceIdentity{
+	attributes: []identityAttribute{
+		{name: "hvn_id", description: "The ID of the HVN associated with the private link."},
+		{name: "private_link_id", description: "The ID of the private link."},
+	},
+}
+
 func resourcePrivateLinkImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// with multi-projects, import arguments must become dynamic:
 	// use explicit project ID with terraform import:
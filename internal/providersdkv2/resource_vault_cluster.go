@@ -10,12 +10,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	sharedmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-shared/v1/models"
 	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-hcp/internal/clients"
+	"github.com/hashicorp/terraform-provider-hcp/internal/customdiags"
 	"github.com/hashicorp/terraform-provider-hcp/internal/input"
 )
 
@@ -173,7 +175,7 @@ If a project is not configured in the HCP Provider config block, the oldest proj
 				MaxItems:    1,
 				Optional:    true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: withObservabilityWriteOnlySecrets("metrics_config", map[string]*schema.Schema{
 						"grafana_endpoint": {
 							Description: "Grafana endpoint for streaming metrics",
 							Type:        schema.TypeString,
@@ -321,7 +323,7 @@ If a project is not configured in the HCP Provider config block, the oldest proj
 							Type:        schema.TypeString,
 							Optional:    true,
 						},
-					},
+					}),
 				},
 			},
 			"audit_log_config": {
@@ -330,7 +332,7 @@ If a project is not configured in the HCP Provider config block, the oldest proj
 				MaxItems:    1,
 				Optional:    true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
+					Schema: withObservabilityWriteOnlySecrets("audit_log_config", map[string]*schema.Schema{
 						"grafana_endpoint": {
 							Description: "Grafana endpoint for streaming audit logs",
 							Type:        schema.TypeString,
@@ -484,7 +486,7 @@ If a project is not configured in the HCP Provider config block, the oldest proj
 							Type:        schema.TypeString,
 							Optional:    true,
 						},
-					},
+					}),
 				},
 			},
 			"vault_version": {
@@ -597,7 +599,7 @@ func resourceVaultClusterCreate(ctx context.Context, d *schema.ResourceData, met
 	// Use the hvn to get provider and region.
 	hvn, err := clients.GetHvnByID(ctx, client, loc, hvnID)
 	if err != nil {
-		return diag.Errorf("unable to find existing HVN (%s): %v", hvnID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to find existing HVN (%s)", hvnID), err, nil)
 	}
 	loc.Region = &sharedmodels.HashicorpCloudLocationRegion{
 		Provider: hvn.Location.Region.Provider,
@@ -616,7 +618,7 @@ func resourceVaultClusterCreate(ctx context.Context, d *schema.ResourceData, met
 	_, err = clients.GetVaultClusterByID(ctx, client, loc, clusterID)
 	if err != nil {
 		if !clients.IsResponseCodeNotFound(err) {
-			return diag.Errorf("unable to check for presence of an existing Vault cluster (%s): %v", clusterID, err)
+			return customdiags.SDKv2APIError(fmt.Sprintf("unable to check for presence of an existing Vault cluster (%s)", clusterID), err, nil)
 		}
 
 		// A 404 indicates a Vault cluster was not found.
@@ -744,7 +746,7 @@ func resourceVaultClusterCreate(ctx context.Context, d *schema.ResourceData, met
 
 	payload, err := clients.CreateVaultCluster(ctx, client, loc, vaultCluster)
 	if err != nil {
-		return diag.Errorf("unable to create Vault cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to create Vault cluster (%s)", clusterID), err, nil)
 	}
 
 	link := newLink(loc, VaultClusterResourceType, clusterID)
@@ -756,7 +758,7 @@ func resourceVaultClusterCreate(ctx context.Context, d *schema.ResourceData, met
 
 	// Wait for the Vault cluster to be created.
 	if err := clients.WaitForOperation(ctx, client, "create Vault cluster", loc, payload.Operation.ID); err != nil {
-		return diag.Errorf("unable to create Vault cluster (%s): %v", payload.ClusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to create Vault cluster (%s)", payload.ClusterID), err, nil)
 	}
 
 	log.Printf("[INFO] Created Vault cluster (%s)", payload.ClusterID)
@@ -765,7 +767,7 @@ func resourceVaultClusterCreate(ctx context.Context, d *schema.ResourceData, met
 	cluster, err := clients.GetVaultClusterByID(ctx, client, loc, payload.ClusterID)
 
 	if err != nil {
-		return diag.Errorf("unable to retrieve Vault cluster (%s): %v", payload.ClusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to retrieve Vault cluster (%s)", payload.ClusterID), err, nil)
 	}
 	clusterRegionShared := &sharedmodels.HashicorpCloudLocationRegion{}
 	if cluster.Location.Region != nil {
@@ -783,13 +785,13 @@ func resourceVaultClusterCreate(ctx context.Context, d *schema.ResourceData, met
 	if mvuConfig != nil {
 		_, err := clients.UpdateVaultMajorVersionUpgradeConfig(ctx, client, clusterLocationShared, payload.ClusterID, mvuConfig)
 		if err != nil {
-			return diag.Errorf("error updating Vault cluster major version upgrade config (%s): %v", payload.ClusterID, err)
+			return customdiags.SDKv2APIError(fmt.Sprintf("error updating Vault cluster major version upgrade config (%s)", payload.ClusterID), err, nil)
 		}
 
 		// refresh the created Vault cluster.
 		cluster, err = clients.GetVaultClusterByID(ctx, client, loc, payload.ClusterID)
 		if err != nil {
-			return diag.Errorf("unable to retrieve Vault cluster (%s): %v", payload.ClusterID, err)
+			return customdiags.SDKv2APIError(fmt.Sprintf("unable to retrieve Vault cluster (%s)", payload.ClusterID), err, nil)
 		}
 	}
 
@@ -821,7 +823,7 @@ func resourceVaultClusterRead(ctx context.Context, d *schema.ResourceData, meta
 			return nil
 		}
 
-		return diag.Errorf("unable to fetch Vault cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to fetch Vault cluster (%s)", clusterID), err, nil)
 	}
 
 	// The Vault cluster was already deleted, remove from state.
@@ -860,7 +862,7 @@ func resourceVaultClusterUpdate(ctx context.Context, d *schema.ResourceData, met
 			return nil
 		}
 
-		return diag.Errorf("unable to fetch Vault cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to fetch Vault cluster (%s)", clusterID), err, nil)
 	}
 	clusterRegionShared := &sharedmodels.HashicorpCloudLocationRegion{}
 	if cluster.Location.Region != nil {
@@ -906,23 +908,23 @@ func resourceVaultClusterUpdate(ctx context.Context, d *schema.ResourceData, met
 				Paths: pathStrings,
 			})
 			if err != nil {
-				return diag.Errorf("error updating Vault cluster paths filter (%s): %v", clusterID, err)
+				return customdiags.SDKv2APIError(fmt.Sprintf("error updating Vault cluster paths filter (%s)", clusterID), err, nil)
 			}
 
 			// Wait for the update paths filter operation.
 			if err := clients.WaitForOperation(ctx, client, "update Vault cluster paths filter", clusterLocationShared, updateResp.Operation.ID); err != nil {
-				return diag.Errorf("unable to update Vault cluster paths filter (%s): %v", clusterID, err)
+				return customdiags.SDKv2APIError(fmt.Sprintf("unable to update Vault cluster paths filter (%s)", clusterID), err, nil)
 			}
 		} else {
 			// paths_filter is not present. Delete the paths_filter.
 			deleteResp, err := clients.DeleteVaultPathsFilter(ctx, client, clusterLocationShared, clusterID)
 			if err != nil {
-				return diag.Errorf("error deleting Vault cluster paths filter (%s): %v", clusterID, err)
+				return customdiags.SDKv2APIError(fmt.Sprintf("error deleting Vault cluster paths filter (%s)", clusterID), err, nil)
 			}
 
 			// Wait for the delete paths filter operation.
 			if err := clients.WaitForOperation(ctx, client, "delete Vault cluster paths filter", clusterLocationShared, deleteResp.Operation.ID); err != nil {
-				return diag.Errorf("unable to delete Vault cluster paths filter (%s): %v", clusterID, err)
+				return customdiags.SDKv2APIError(fmt.Sprintf("unable to delete Vault cluster paths filter (%s)", clusterID), err, nil)
 			}
 		}
 	}
@@ -930,7 +932,7 @@ func resourceVaultClusterUpdate(ctx context.Context, d *schema.ResourceData, met
 	if mvuConfig != nil {
 		_, err := clients.UpdateVaultMajorVersionUpgradeConfig(ctx, client, clusterLocationShared, clusterID, mvuConfig)
 		if err != nil {
-			return diag.Errorf("error updating Vault cluster major version upgrade config (%s): %v", clusterID, err)
+			return customdiags.SDKv2APIError(fmt.Sprintf("error updating Vault cluster major version upgrade config (%s)", clusterID), err, nil)
 		}
 	}
 
@@ -938,7 +940,7 @@ func resourceVaultClusterUpdate(ctx context.Context, d *schema.ResourceData, met
 	cluster, err = clients.GetVaultClusterByID(ctx, client, loc, clusterID)
 
 	if err != nil {
-		return diag.Errorf("unable to retrieve Vault cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to retrieve Vault cluster (%s)", clusterID), err, nil)
 	}
 
 	if err := setVaultClusterResourceData(d, cluster); err != nil {
@@ -968,12 +970,12 @@ func resourceVaultClusterDelete(ctx context.Context, d *schema.ResourceData, met
 			return nil
 		}
 
-		return diag.Errorf("unable to delete Vault cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to delete Vault cluster (%s)", clusterID), err, nil)
 	}
 
 	// Wait for the delete cluster operation.
 	if err := clients.WaitForOperation(ctx, client, "delete Vault cluster", loc, deleteResp.Operation.ID); err != nil {
-		return diag.Errorf("unable to delete Vault cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to delete Vault cluster (%s)", clusterID), err, nil)
 	}
 
 	return nil
@@ -1029,12 +1031,12 @@ func updateVaultClusterConfig(ctx context.Context, client *clients.Client, d *sc
 					if d.HasChange("metrics_config") || d.HasChange("audit_log_config") {
 						updateResp, err := clients.UpdateVaultClusterConfig(ctx, client, clusterSharedLoc, cluster.ID, destTier, publicIpsEnabled, httpProxyOption, metricsConfig, auditConfig, ipAllowlist)
 						if err != nil {
-							return diag.Errorf("error updating Vault cluster (%s): %v", clusterID, err)
+							return customdiags.SDKv2APIError(fmt.Sprintf("error updating Vault cluster (%s)", clusterID), err, nil)
 						}
 
 						// Wait for the update cluster operation.
 						if err := clients.WaitForOperation(ctx, client, "update Vault cluster", clusterSharedLoc, updateResp.Operation.ID); err != nil {
-							return diag.Errorf("unable to update Vault cluster (%s): %v", clusterID, err)
+							return customdiags.SDKv2APIError(fmt.Sprintf("unable to update Vault cluster (%s)", clusterID), err, nil)
 						}
 					}
 					var getPrimaryErr diag.Diagnostics
@@ -1055,11 +1057,11 @@ func updateVaultClusterConfig(ctx context.Context, client *clients.Client, d *sc
 	// Invoke update endpoint.
 	updateResp, err := clients.UpdateVaultClusterConfig(ctx, client, clusterSharedLoc, cluster.ID, destTier, publicIpsEnabled, httpProxyOption, metricsConfig, auditConfig, ipAllowlist)
 	if err != nil {
-		return diag.Errorf("error updating Vault cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("error updating Vault cluster (%s)", clusterID), err, nil)
 	}
 	// Wait for the update cluster operation.
 	if err := clients.WaitForOperation(ctx, client, "update Vault cluster", clusterSharedLoc, updateResp.Operation.ID); err != nil {
-		return diag.Errorf("unable to update Vault cluster (%s): %v", clusterID, err)
+		return customdiags.SDKv2APIError(fmt.Sprintf("unable to update Vault cluster (%s)", clusterID), err, nil)
 	}
 
 	return nil
@@ -1275,6 +1277,15 @@ func flattenObservabilityConfig(config *vaultmodels.HashicorpCloudVault20201125O
 
 	configMap := map[string]interface{}{}
 
+	// The API doesn't know about the versions of the write-only secrets, so we keep the configured ones.
+	if configParam, ok := d.GetOk(propertyName); ok && len(configParam.([]interface{})) > 0 {
+		if config, ok := configParam.([]interface{})[0].(map[string]interface{}); ok {
+			for _, secret := range observabilityWriteOnlySecrets {
+				configMap[secret+"_wo_version"] = config[secret+"_wo_version"]
+			}
+		}
+	}
+
 	if grafana := config.Grafana; grafana != nil {
 		configMap["grafana_endpoint"] = grafana.Endpoint
 		configMap["grafana_user"] = grafana.User
@@ -1408,6 +1419,43 @@ func flattenObservabilityConfig(config *vaultmodels.HashicorpCloudVault20201125O
 	return []interface{}{configMap}
 }
 
+// observabilityWriteOnlySecrets lists the sensitive fields of the metrics and audit logs configurations
+// that can alternatively be set with a write-only argument, named after the field with a `_wo` suffix.
+var observabilityWriteOnlySecrets = []string{
+	"grafana_password",
+	"splunk_token",
+	"datadog_api_key",
+	"cloudwatch_secret_access_key",
+	"elasticsearch_password",
+	"http_basic_password",
+	"http_bearer_token",
+	"newrelic_license_key",
+}
+
+// withObservabilityWriteOnlySecrets adds the write-only variant of each sensitive field of an observability
+// configuration schema, along with the version used to trigger updates of the write-only value. A field and
+// its write-only variant conflict with each other; propertyName is the name of the block holding the schema.
+func withObservabilityWriteOnlySecrets(propertyName string, s map[string]*schema.Schema) map[string]*schema.Schema {
+	for _, secret := range observabilityWriteOnlySecrets {
+		field := fmt.Sprintf("%s.0.%s", propertyName, secret)
+		s[secret].ConflictsWith = []string{field + "_wo"}
+		s[secret+"_wo"] = &schema.Schema{
+			Description:   fmt.Sprintf("%s. This value is write-only and is not stored in the state file, it conflicts with `%s`. Requires Terraform 1.11 or later.", s[secret].Description, secret),
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			WriteOnly:     true,
+			ConflictsWith: []string{field},
+		}
+		s[secret+"_wo_version"] = &schema.Schema{
+			Description: fmt.Sprintf("Version of the `%s_wo` value. Terraform cannot detect changes to write-only values, change this version to update it.", secret),
+			Type:        schema.TypeInt,
+			Optional:    true,
+		}
+	}
+	return s
+}
+
 func getObservabilityConfig(propertyName string, d *schema.ResourceData) (*vaultmodels.HashicorpCloudVault20201125ObservabilityConfig, diag.Diagnostics) {
 	if !d.HasChange(propertyName) {
 		return nil, nil
@@ -1437,6 +1485,17 @@ func getObservabilityConfig(propertyName string, d *schema.ResourceData) (*vault
 		return &emptyConfig, nil
 	}
 
+	// Write-only secrets are not part of the plan, read them from the configuration instead.
+	for _, secret := range observabilityWriteOnlySecrets {
+		value, diags := getWriteOnlyString(d, cty.GetAttrPath(propertyName).IndexInt(0).GetAttr(secret+"_wo"))
+		if diags.HasError() {
+			return nil, diags
+		}
+		if value != "" {
+			config[secret] = value
+		}
+	}
+
 	return getValidObservabilityConfig(config)
 }
 
@@ -1724,6 +1783,13 @@ func flattenMajorVersionUpgradeConfig(config *vaultmodels.HashicorpCloudVault202
 	return []interface{}{configMap}
 }
 
+// vaultClusterIdentity is the identity of hcp_vault_cluster, see withIdentity.
+var vaultClusterIdentity = resourceIdentity{
+	attributes: []identityAttribute{
+		{name: "cluster_id", description: "The ID of the HCP Vault cluster."},
+	},
+}
+
 func resourceVaultClusterImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// with multi-projects, import arguments must become dynamic:
 	// use explicit project ID with terraform import:
@@ -1822,7 +1888,7 @@ func getPrimaryClusterFromLink(ctx context.Context, client *clients.Client, link
 			return nil, diag.Errorf("primary cluster (%s) does not exist", primaryClusterLink.ID)
 
 		}
-		return nil, diag.Errorf("unable to check for presence of an existing primary Vault cluster (%s): %v", primaryClusterLink.ID, err)
+		return nil, customdiags.SDKv2APIError(fmt.Sprintf("unable to check for presence of an existing primary Vault cluster (%s)", primaryClusterLink.ID), err, nil)
 	}
 	return primaryCluster, nil
 }
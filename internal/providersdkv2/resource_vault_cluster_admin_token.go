@@ -20,12 +20,12 @@ import (
 // before an admin token operation should timeout.
 var defaultVaultAdminTokenTimeout = time.Minute * 5
 
-// adminTokenExpiry is the length of the time in seconds before a generated admin token expires.
-var adminTokenExpiry = time.Second * 3600 * 6
-
 func resourceVaultClusterAdminToken() *schema.Resource {
 	return &schema.Resource{
-		Description:   "The Vault cluster admin token resource generates an admin-level token for the HCP Vault cluster.",
+		Description: "The Vault cluster admin token resource generates an admin-level token for the HCP Vault cluster.",
+		DeprecationMessage: "The hcp_vault_cluster_admin_token resource stores the admin token in the Terraform state. " +
+			"Use the hcp_vault_cluster_admin_token ephemeral resource instead, which is available in Terraform 1.10 and later " +
+			"and never persists the token.",
 		CreateContext: resourceVaultClusterAdminTokenCreate,
 		ReadContext:   resourceVaultClusterAdminTokenRead,
 		DeleteContext: resourceVaultClusterAdminTokenDelete,
@@ -186,7 +186,7 @@ func resourceVaultClusterAdminTokenRead(ctx context.Context, d *schema.ResourceD
 		)
 
 		// The refresh window starts five minutes before the 6h expiry.
-		expiry := adminTokenExpiry - (time.Second * 60 * 5)
+		expiry := clients.VaultClusterAdminTokenExpiry - (time.Second * 60 * 5)
 
 		t, err := time.Parse(time.RFC3339, createdAt)
 		if err != nil {
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	vaultmodels "github.com/hashicorp/hcp-sdk-go/clients/cloud-vault-service/stable/2020-11-25/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestGetValidObservabilityConfig(t *testing.T) {
@@ -230,3 +231,28 @@ func TestGetValidMajorVersionUpgradeConfig(t *testing.T) {
 		}
 	}
 }
+
+func TestObservabilityWriteOnlySecretsConflict(t *testing.T) {
+	for _, block := range []string{"metrics_config", "audit_log_config"} {
+		t.Run(block, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"cluster_id": "test",
+				"hvn_id":     "test",
+				block: []interface{}{map[string]interface{}{
+					"grafana_user":        "test",
+					"grafana_endpoint":    "https://grafana",
+					"grafana_password":    "pwd",
+					"grafana_password_wo": "pwd",
+				}},
+			})
+
+			diags := resourceVaultCluster().Validate(config)
+			if !diags.HasError() {
+				t.Fatal("expected grafana_password and grafana_password_wo to conflict")
+			}
+			if !strings.Contains(diags[0].Summary, "Conflicting configuration arguments") {
+				t.Fatalf("unexpected error: %s", diags[0].Summary)
+			}
+		})
+	}
+}
@@ -255,6 +255,17 @@ func setVaultPluginResourceData(d *schema.ResourceData, projectID string, cluste
 	return nil
 }
 
+// vaultPluginIdentity is the identity of hcp_vault_plugin, see withIdentity.
+var vaultPluginIdentity = resourceIdentity{
+	attributes: []identityAttribute{
+		{name: "cluster_id", description: "The ID of the HCP Vault cluster."},
+		{name: "plugin_type", description: "The type of the plugin."},
+		{name: "plugin_name", description: "The name of the plugin."},
+	},
+	// The type and name of plugins are updated in place.
+	mutable: true,
+}
+
 // resourceHVNRouteImport implements the logic necessary to import an
 // un-tracked (by Terraform) HVN route resource into Terraform state.
 func resourceVaultPluginImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providersdkv2
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// getWriteOnlyString returns the value of a write-only string attribute. Write-only
+// values are never persisted to the plan or the state, so they have to be read from the
+// raw configuration. An empty string is returned if the attribute is not set.
+func getWriteOnlyString(d *schema.ResourceData, p cty.Path) (string, diag.Diagnostics) {
+	value, diags := d.GetRawConfigAt(p)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return "", nil
+	}
+
+	return value.AsString(), nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statuspage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// NewProviderServer wraps the provider server returned by next, such that
+// planning a resource, or reading a data source or an ephemeral resource,
+// warns about the incidents of the HCP services the resource depends on, as
+// reported by the last Check. Every incident is only reported once, so
+// configurations using many resources of a service get a single warning.
+func NewProviderServer(next func() tfprotov6.ProviderServer) func() tfprotov6.ProviderServer {
+	return func() tfprotov6.ProviderServer {
+		return &providerServer{ProviderServer: next()}
+	}
+}
+
+type providerServer struct {
+	tfprotov6.ProviderServer
+}
+
+func (s *providerServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = appendWarning(resp.Diagnostics, req.TypeName)
+	}
+	return resp, err
+}
+
+func (s *providerServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	resp, err := s.ProviderServer.ReadDataSource(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = appendWarning(resp.Diagnostics, req.TypeName)
+	}
+	return resp, err
+}
+
+func (s *providerServer) OpenEphemeralResource(ctx context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	resp, err := s.ProviderServer.OpenEphemeralResource(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = appendWarning(resp.Diagnostics, req.TypeName)
+	}
+	return resp, err
+}
+
+func (s *providerServer) ValidateListResourceConfig(ctx context.Context, req *tfprotov6.ValidateListResourceConfigRequest) (*tfprotov6.ValidateListResourceConfigResponse, error) {
+	listServer, ok := s.ProviderServer.(tfprotov6.ListResourceServer)
+	if !ok {
+		return &tfprotov6.ValidateListResourceConfigResponse{}, nil
+	}
+	return listServer.ValidateListResourceConfig(ctx, req)
+}
+
+func (s *providerServer) ListResource(ctx context.Context, req *tfprotov6.ListResourceRequest) (*tfprotov6.ListResourceServerStream, error) {
+	listServer, ok := s.ProviderServer.(tfprotov6.ListResourceServer)
+	if !ok {
+		return &tfprotov6.ListResourceServerStream{Results: tfprotov6.NoListResults}, nil
+	}
+	return listServer.ListResource(ctx, req)
+}
+
+// appendWarning appends a warning about the unreported incidents that
+// affect resources of the passed type to diags.
+func appendWarning(diags []*tfprotov6.Diagnostic, typeName string) []*tfprotov6.Diagnostic {
+	status := warnings(typeName)
+	if !status.hasDiagnostics() {
+		return diags
+	}
+
+	return append(diags, &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityWarning,
+		Summary:  warnSummary,
+		Detail:   status.diagnosticMessage(),
+	})
+}
@@ -8,17 +8,22 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
-
-	frameworkDiag "github.com/hashicorp/terraform-plugin-framework/diag"
-	sdkv2Diag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 const (
 	warnSummary   = "You may experience issues using HCP."
 	warnDetailFmt = "HCP is reporting the following:\n\n%s\n\nPlease check %s for more details."
+
+	// urlEnvVar and timeoutEnvVar configure the status page check if the
+	// provider configuration doesn't.
+	urlEnvVar     = "HCP_STATUS_PAGE_URL"
+	timeoutEnvVar = "HCP_STATUS_CHECK_TIMEOUT"
 )
 
 type regionalConfig struct {
@@ -30,6 +35,11 @@ type regionalConfig struct {
 	// Groups have a top-level ID that are not returned in the API response so we rely on the group names
 	groupNames []string
 
+	// componentResources maps the names of components and groups to the
+	// prefixes of the resource types that depend on them. Components that
+	// are not listed affect all resource types.
+	componentResources map[string][]string
+
 	statusPageURL string
 	name          string
 	clientTimeout int
@@ -50,6 +60,12 @@ var euConfig = regionalConfig{
 		"HCP Vault Dedicated",
 		"API",
 	},
+	componentResources: map[string][]string{
+		"HCP Terraform":        {"hcp_waypoint_"},
+		"HCP Waypoint":         {"hcp_waypoint_"},
+		"HCP Consul Dedicated": {"hcp_consul_"},
+		"HCP Vault Dedicated":  {"hcp_vault_cluster", "hcp_vault_plugin"},
+	},
 	statusPageURL: "https://status.eu.hashicorp.com/api/v1/summary",
 	clientTimeout: 1,
 	name:          "EU",
@@ -70,6 +86,15 @@ var usConfig = regionalConfig{
 		"HCP Vault Dedicated",
 		"API",
 	},
+	componentResources: map[string][]string{
+		"HCP Boundary":         {"hcp_boundary_"},
+		"HCP Packer":           {"hcp_packer_"},
+		"HCP Vault Radar":      {"hcp_vault_radar_"},
+		"HCP Vault Secrets":    {"hcp_vault_secrets_"},
+		"HCP Waypoint":         {"hcp_waypoint_"},
+		"HCP Consul Dedicated": {"hcp_consul_"},
+		"HCP Vault Dedicated":  {"hcp_vault_cluster", "hcp_vault_plugin"},
+	},
 	statusPageURL: "https://status.hashicorp.com/api/v1/summary",
 	clientTimeout: 1,
 	name:          "US",
@@ -130,58 +155,204 @@ func isHCPComponentAffected(comp affectedComponent, region *regionalConfig) bool
 	return slices.Contains(region.groupNames, comp.GroupName)
 }
 
-// Fetch and parse the API response
-func checkHCPStatus(geography *string) statusCheckResult {
-	var result statusCheckResult
-	var statusBuilder strings.Builder
+// isComponentRelevant returns whether resources of the passed type depend on
+// the affected component. An empty type name matches all components.
+func isComponentRelevant(comp affectedComponent, region *regionalConfig, typeName string) bool {
+	if typeName == "" {
+		return true
+	}
 
-	region, ok := regions[*geography]
+	name := comp.Name
+	if comp.GroupName != "" {
+		name = comp.GroupName
+	}
+
+	prefixes, ok := region.componentResources[name]
+	if !ok {
+		return true
+	}
+
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(typeName, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Options configures the check of the HCP status page.
+type Options struct {
+	// Geography selects the status page and the components to report on.
+	// Unknown geographies default to the US.
+	Geography string
+
+	// URL overrides the status page of the geography, e.g. with a mirror
+	// reachable from an air-gapped network.
+	URL string
+
+	// Timeout is the timeout of the request to the status page.
+	Timeout time.Duration
+}
+
+// check is the status page of one geography, as fetched by this process.
+type check struct {
+	once    sync.Once
+	region  *regionalConfig
+	url     string
+	timeout time.Duration
+
+	// incidents and errorMessage are set once fetched.
+	incidents    []incident
+	errorMessage string
+
+	// reported holds the incidents and errors that were already reported,
+	// such that every one is only reported once.
+	reported map[string]bool
+}
+
+var (
+	// mu guards checks, current and the reported incidents of the checks.
+	mu sync.Mutex
+
+	// checks caches the status pages fetched by this process, keyed by
+	// region and URL.
+	checks = make(map[string]*check)
+
+	// current is the check that was configured last, it's the one warnings
+	// are reported for.
+	current *check
+)
+
+// Check fetches the HCP status page for the passed options, and records it
+// as the status to report warnings for, see NewProviderServer. The status
+// page is only requested once per process and geography, as both providers
+// served by the mux check it while being configured.
+//
+// Options that are not set are read from the environment, an error is
+// returned if they are invalid.
+func Check(opts Options) error {
+	region, ok := regions[opts.Geography]
 	if !ok {
 		region = regions["us"]
 	}
 
-	result.statusPageURL = region.statusPageURL
-	statuspageURL := region.statusPageURL
+	url := opts.URL
+	if url == "" {
+		url = os.Getenv(urlEnvVar)
+	}
+	if url == "" {
+		url = region.statusPageURL
+	}
 
-	req, err := http.NewRequest("GET", statuspageURL, nil)
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		if v := os.Getenv(timeoutEnvVar); v != "" {
+			seconds, err := strconv.Atoi(v)
+			if err != nil || seconds <= 0 {
+				return fmt.Errorf("%s must be a positive number of seconds, got %q", timeoutEnvVar, v)
+			}
+			timeout = time.Duration(seconds) * time.Second
+		}
+	}
+	if timeout <= 0 {
+		timeout = time.Duration(region.clientTimeout) * time.Second
+	}
+
+	key := region.name + " " + url
+
+	mu.Lock()
+	c, ok := checks[key]
+	if !ok {
+		c = &check{
+			region:   region,
+			url:      url,
+			timeout:  timeout,
+			reported: make(map[string]bool),
+		}
+		checks[key] = c
+	}
+	mu.Unlock()
+
+	c.once.Do(c.fetch)
+
+	mu.Lock()
+	current = c
+	mu.Unlock()
+
+	return nil
+}
+
+// fetch requests and parses the status page.
+func (c *check) fetch() {
+	req, err := http.NewRequest("GET", c.url, nil)
 	if err != nil {
-		result.errorMessage = fmt.Sprintf("Unable to create request to verify HCP status: %s", err)
-		return result
+		c.errorMessage = fmt.Sprintf("Unable to create request to verify HCP status: %s", err)
+		return
 	}
 
 	cl := &http.Client{
-		Timeout: time.Duration(region.clientTimeout) * time.Second,
+		Timeout: c.timeout,
 	}
 	resp, err := cl.Do(req)
 	if err != nil {
-		result.errorMessage = fmt.Sprintf("Unable to complete request to verify HCP status: %s", err)
-		return result
+		c.errorMessage = fmt.Sprintf("Unable to complete request to verify HCP status: %s", err)
+		return
 	}
 	defer resp.Body.Close()
 
 	jsBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		result.errorMessage = fmt.Sprintf("Unable to read response to verify HCP status: %s", err)
-		return result
+		c.errorMessage = fmt.Sprintf("Unable to read response to verify HCP status: %s", err)
+		return
 	}
 
 	sp := statuspage{}
 	err = json.Unmarshal(jsBytes, &sp)
 	if err != nil {
-		result.errorMessage = fmt.Sprintf("Unable to unmarshal response to verify HCP status: %s", err)
+		c.errorMessage = fmt.Sprintf("Unable to unmarshal response to verify HCP status: %s", err)
+		return
+	}
+
+	c.incidents = sp.OngoingIncidents
+}
+
+// status returns the incidents that affect resources of the passed type and
+// weren't reported before, and marks them as reported. An empty type name
+// returns the incidents of all components.
+func (c *check) status(typeName string) statusCheckResult {
+	mu.Lock()
+	defer mu.Unlock()
+
+	result := statusCheckResult{statusPageURL: c.url}
+	if c.errorMessage != "" {
+		if !c.reported[c.errorMessage] {
+			result.errorMessage = c.errorMessage
+			c.reported[c.errorMessage] = true
+		}
 		return result
 	}
 
-	for _, inc := range sp.OngoingIncidents {
+	var statusBuilder strings.Builder
+	for _, inc := range c.incidents {
 		reported := make([]string, 0, len(inc.AffectedComponents))
 		for _, comp := range inc.AffectedComponents {
-			if isHCPComponentAffected(comp, region) {
-				prefix := comp.Name
-				if comp.GroupName != "" {
-					prefix = fmt.Sprintf("%s (%s)", comp.GroupName, comp.Name)
-				}
-				reported = append(reported, fmt.Sprintf("%s: %s", prefix, comp.CurrentStatus))
+			if !isHCPComponentAffected(comp, c.region) || !isComponentRelevant(comp, c.region, typeName) {
+				continue
+			}
+
+			prefix := comp.Name
+			if comp.GroupName != "" {
+				prefix = fmt.Sprintf("%s (%s)", comp.GroupName, comp.Name)
 			}
+			line := fmt.Sprintf("%s: %s", prefix, comp.CurrentStatus)
+
+			key := inc.Name + "\x00" + line
+			if c.reported[key] {
+				continue
+			}
+			c.reported[key] = true
+
+			reported = append(reported, line)
 		}
 
 		if len(reported) > 0 {
@@ -194,26 +365,16 @@ func checkHCPStatus(geography *string) statusCheckResult {
 	return result
 }
 
-func IsHCPOperationalFramework(geography string) (diags frameworkDiag.Diagnostics) {
-	status := checkHCPStatus(&geography)
-
-	if status.hasDiagnostics() {
-		diags.AddWarning(warnSummary, status.diagnosticMessage())
-	}
-
-	return diags
-}
-
-func IsHCPOperationalSDKv2(geography string) (diags sdkv2Diag.Diagnostics) {
-	status := checkHCPStatus(&geography)
+// warnings returns the unreported status of the current check for resources
+// of the passed type.
+func warnings(typeName string) statusCheckResult {
+	mu.Lock()
+	c := current
+	mu.Unlock()
 
-	if status.hasDiagnostics() {
-		diags = append(diags, sdkv2Diag.Diagnostic{
-			Severity: sdkv2Diag.Warning,
-			Summary:  warnSummary,
-			Detail:   status.diagnosticMessage(),
-		})
+	if c == nil {
+		return statusCheckResult{}
 	}
 
-	return diags
+	return c.status(typeName)
 }
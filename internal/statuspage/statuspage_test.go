@@ -4,15 +4,16 @@
 package statuspage
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
-	frameworkDiag "github.com/hashicorp/terraform-plugin-framework/diag"
-	sdkv2Diag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Helper functions to create test data
@@ -53,9 +54,19 @@ func createTestServer(t *testing.T, region *regionalConfig, handler http.Handler
 	t.Cleanup(func() {
 		server.Close()
 		region.statusPageURL = prevURL
+		resetChecks()
 	})
 }
 
+// resetChecks forgets the status pages fetched by previous tests.
+func resetChecks() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	checks = make(map[string]*check)
+	current = nil
+}
+
 // stubStatusPage configures a test server to return a simulated status page response
 func stubStatusPage(t *testing.T, region *regionalConfig, incidents []incident) {
 	t.Helper()
@@ -249,9 +260,9 @@ func TestCheckHCPStatus(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			//regions[tc.geography] = &testRegionalConfig
 			tc.setup(t)
-			result := checkHCPStatus(&tc.geography)
+			require.NoError(t, Check(Options{Geography: tc.geography}))
+			result := current.status("")
 
 			assert.Equal(t, tc.expectOutage, result.statusMessage != "", "Operational status mismatch")
 			assert.Equal(t, tc.expectDiagnostics, result.hasDiagnostics(), "Diagnostics presence mismatch")
@@ -273,93 +284,140 @@ func TestCheckHCPStatus(t *testing.T) {
 	}
 }
 
-func TestIsHCPOperational(t *testing.T) {
-	scenarios := []struct {
-		name            string
-		setupFn         func(t *testing.T)
-		expectDiags     bool
-		expectedSummary string
-		detailContains  []string
-		detailExcludes  []string
-		geography       string
-	}{
-		{
-			name: "one warn overall during HCP outages",
-			setupFn: func(t *testing.T) {
-				stubStatusPage(t, regions["us"], []incident{
-					inc("API Outage", "investigating", testComponent("HCP API", "degraded_performance", regions["us"])),
-					inc("Consul Issues", "identified", testGroupedComponent("HCP Consul Dedicated", "partial_outage", regions["us"])),
-					inc("Unrelated Incident", "investigating", testComponent("Other", "major_outage", regions["us"])),
-				})
-			},
-			expectDiags:     true,
-			expectedSummary: warnSummary,
-			detailContains:  []string{"API Outage", "Consul Issues"},
-			detailExcludes:  []string{"Unrelated Incident"},
-			geography:       "us",
-		},
-		{
-			name:            "setup failure warn",
-			setupFn:         func(t *testing.T) { simulateError(t, "serviceDown", regions["us"]) },
-			expectDiags:     true,
-			expectedSummary: warnSummary,
-			detailContains:  []string{"Unable to unmarshal response"},
-			detailExcludes:  []string{"HCP is reporting the following"},
-			geography:       "us",
-		},
-		{
-			name:        "fully operational",
-			setupFn:     func(t *testing.T) { stubStatusPage(t, regions["us"], nil) },
-			expectDiags: false,
-			geography:   "us",
-		},
-	}
+func TestCheck_Cached(t *testing.T) {
+	requests := 0
+	createTestServer(t, regions["us"], func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_ = json.NewEncoder(w).Encode(statuspage{})
+	})
 
-	implementations := []string{"Framework", "SDKv2"}
+	// Both providers of the mux check the status while being configured.
+	require.NoError(t, Check(Options{Geography: "us"}))
+	require.NoError(t, Check(Options{Geography: "us"}))
+	assert.Equal(t, 1, requests)
 
-	for _, impl := range implementations {
-		for _, scenario := range scenarios {
-			t.Run(impl+" "+scenario.name, func(t *testing.T) {
-				scenario.setupFn(t)
+	// The status page of a different geography is fetched separately.
+	stubStatusPage(t, regions["eu"], nil)
+	require.NoError(t, Check(Options{Geography: "eu"}))
+	assert.Equal(t, 1, requests)
+}
 
-				var diags interface{}
-				if impl == "Framework" {
-					diags = IsHCPOperationalFramework(scenario.geography)
-				}
-				if impl == "SDKv2" {
-					diags = IsHCPOperationalSDKv2(scenario.geography)
-				}
+func TestCheck_Options(t *testing.T) {
+	t.Cleanup(resetChecks)
 
-				if !scenario.expectDiags {
-					assert.Empty(t, diags, "Should have no diagnostics when operational")
-					return
-				}
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(statuspage{OngoingIncidents: []incident{
+			inc("API Outage", "investigating", testComponent("HCP API", "major_outage", regions["us"])),
+		}})
+	}))
+	defer mirror.Close()
 
-				assert.Len(t, diags, 1, "Should have one diagnostic")
-
-				switch d := diags.(type) {
-				case frameworkDiag.Diagnostics:
-					assert.Equal(t, frameworkDiag.SeverityWarning, d[0].Severity(), "Severity should be Warning not Error")
-					assert.Equal(t, scenario.expectedSummary, d[0].Summary())
-
-					for _, expectedText := range scenario.detailContains {
-						assert.Contains(t, d[0].Detail(), expectedText)
-					}
-					for _, excludedText := range scenario.detailExcludes {
-						assert.NotContains(t, d[0].Detail(), excludedText)
-					}
-				case sdkv2Diag.Diagnostics:
-					assert.Equal(t, sdkv2Diag.Warning, d[0].Severity, "Severity should be Warning not Error")
-					assert.Equal(t, scenario.expectedSummary, d[0].Summary)
-
-					for _, expectedText := range scenario.detailContains {
-						assert.Contains(t, d[0].Detail, expectedText)
-					}
-					for _, excludedText := range scenario.detailExcludes {
-						assert.NotContains(t, d[0].Detail, excludedText)
-					}
-				}
-			})
-		}
+	t.Run("url", func(t *testing.T) {
+		t.Cleanup(resetChecks)
+
+		require.NoError(t, Check(Options{Geography: "us", URL: mirror.URL}))
+		assert.Equal(t, mirror.URL, current.url)
+		assert.Contains(t, current.status("").diagnosticMessage(), "API Outage")
+	})
+
+	t.Run("url from the environment", func(t *testing.T) {
+		t.Cleanup(resetChecks)
+		t.Setenv(urlEnvVar, mirror.URL)
+
+		require.NoError(t, Check(Options{Geography: "us"}))
+		assert.Contains(t, current.status("").diagnosticMessage(), "API Outage")
+	})
+
+	t.Run("timeout from the environment", func(t *testing.T) {
+		t.Cleanup(resetChecks)
+		t.Setenv(timeoutEnvVar, "5")
+
+		require.NoError(t, Check(Options{Geography: "us", URL: mirror.URL}))
+		assert.Equal(t, 5*time.Second, current.timeout)
+	})
+
+	t.Run("invalid timeout in the environment", func(t *testing.T) {
+		t.Cleanup(resetChecks)
+		t.Setenv(timeoutEnvVar, "soon")
+
+		assert.ErrorContains(t, Check(Options{Geography: "us", URL: mirror.URL}), timeoutEnvVar)
+	})
+}
+
+// fakeProviderServer responds to all requests without diagnostics.
+type fakeProviderServer struct {
+	tfprotov6.ProviderServer
+}
+
+func (fakeProviderServer) PlanResourceChange(context.Context, *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	return &tfprotov6.PlanResourceChangeResponse{}, nil
+}
+
+func (fakeProviderServer) ReadDataSource(context.Context, *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	return &tfprotov6.ReadDataSourceResponse{}, nil
+}
+
+func TestProviderServer(t *testing.T) {
+	ctx := context.Background()
+	server := NewProviderServer(func() tfprotov6.ProviderServer { return fakeProviderServer{} })()
+
+	plan := func(typeName string) []*tfprotov6.Diagnostic {
+		resp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{TypeName: typeName})
+		require.NoError(t, err)
+		return resp.Diagnostics
 	}
+
+	t.Run("not checked", func(t *testing.T) {
+		t.Cleanup(resetChecks)
+		assert.Empty(t, plan("hcp_packer_bucket"))
+	})
+
+	t.Run("incidents of relevant components", func(t *testing.T) {
+		stubStatusPage(t, regions["us"], []incident{
+			inc("Packer Outage", "investigating", testComponent("HCP Packer", "major_outage", regions["us"])),
+			inc("Vault Issues", "identified", testGroupedComponent("HCP Vault Dedicated", "partial_outage", regions["us"])),
+		})
+		require.NoError(t, Check(Options{Geography: "us"}))
+
+		// Incidents of services the resource doesn't use are not reported.
+		assert.Empty(t, plan("hcp_hvn"))
+
+		diags := plan("hcp_packer_bucket")
+		require.Len(t, diags, 1)
+		assert.Equal(t, tfprotov6.DiagnosticSeverityWarning, diags[0].Severity)
+		assert.Equal(t, warnSummary, diags[0].Summary)
+		assert.Contains(t, diags[0].Detail, "Packer Outage")
+		assert.NotContains(t, diags[0].Detail, "Vault Issues")
+
+		// Every incident is only reported once.
+		assert.Empty(t, plan("hcp_packer_channel"))
+
+		resp, err := server.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{TypeName: "hcp_vault_cluster"})
+		require.NoError(t, err)
+		require.Len(t, resp.Diagnostics, 1)
+		assert.Contains(t, resp.Diagnostics[0].Detail, "Vault Issues")
+	})
+
+	t.Run("incidents of shared components", func(t *testing.T) {
+		stubStatusPage(t, regions["us"], []incident{
+			inc("API Outage", "investigating", testComponent("HCP API", "degraded_performance", regions["us"])),
+		})
+		require.NoError(t, Check(Options{Geography: "us"}))
+
+		diags := plan("hcp_hvn")
+		require.Len(t, diags, 1)
+		assert.Contains(t, diags[0].Detail, "API Outage")
+	})
+
+	t.Run("check failure", func(t *testing.T) {
+		simulateError(t, "serviceDown", regions["us"])
+		require.NoError(t, Check(Options{Geography: "us"}))
+
+		diags := plan("hcp_hvn")
+		require.Len(t, diags, 1)
+		assert.Contains(t, diags[0].Detail, "Unable to unmarshal response")
+		assert.NotContains(t, diags[0].Detail, "HCP is reporting the following")
+
+		assert.Empty(t, plan("hcp_hvn"))
+	})
 }
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package telemetry exports the OpenTelemetry traces of the provider.
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// ServiceName is the name of the provider in its traces, it can be
+	// overridden with OTEL_SERVICE_NAME.
+	ServiceName = "terraform-provider-hcp"
+
+	// tracesExporterEnvVar selects the exporter of the traces, tracing is
+	// disabled unless it's set.
+	tracesExporterEnvVar = "OTEL_TRACES_EXPORTER"
+
+	// tracesFileEnvVar is the file the file exporter appends traces to.
+	tracesFileEnvVar = "HCP_OTEL_TRACES_FILE"
+
+	// defaultTracesFile is the file the file exporter appends traces to if
+	// tracesFileEnvVar isn't set.
+	defaultTracesFile = "terraform-provider-hcp-traces.json"
+)
+
+// Start exports the traces of the provider as configured by the OTEL_*
+// environment variables, by setting the global tracer provider. The exporter
+// is selected with OTEL_TRACES_EXPORTER:
+//
+//   - otlp: traces are sent to an OTLP collector, configured with the
+//     OTEL_EXPORTER_OTLP_* environment variables. The protocol defaults to
+//     http/protobuf.
+//   - file: traces are appended as JSON lines to the file set by
+//     HCP_OTEL_TRACES_FILE.
+//   - none: traces aren't exported, this is the default.
+//
+// The returned function flushes the traces that weren't exported yet, and
+// has to be called before the provider exits.
+func Start(ctx context.Context, version string) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+
+	exporter, err := newExporter(ctx, strings.TrimSpace(os.Getenv(tracesExporterEnvVar)))
+	if err != nil || exporter == nil {
+		return noop, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			attribute.String("service.name", ServiceName),
+			attribute.String("service.version", version),
+		),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return noop, fmt.Errorf("failed to describe the provider for tracing: %w", err)
+	}
+
+	// The sampler and batching are configured with the OTEL_TRACES_SAMPLER
+	// and OTEL_BSP_* environment variables.
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// newExporter returns the exporter selected by name, or nil if traces aren't
+// exported.
+func newExporter(ctx context.Context, name string) (sdktrace.SpanExporter, error) {
+	switch name {
+	case "", "none":
+		return nil, nil
+	case "otlp":
+		protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+		if protocol == "" {
+			protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+		}
+
+		switch protocol {
+		case "", "http/protobuf":
+			return otlptracehttp.New(ctx)
+		case "grpc":
+			return otlptracegrpc.New(ctx)
+		default:
+			return nil, fmt.Errorf("unsupported OTLP protocol %q, supported protocols are grpc and http/protobuf", protocol)
+		}
+	case "file":
+		path := os.Getenv(tracesFileEnvVar)
+		if path == "" {
+			path = defaultTracesFile
+		}
+
+		// The file is appended to, such that the traces of the provider
+		// processes of a Terraform run end up in the same file.
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open traces file: %w", err)
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return &fileExporter{SpanExporter: exporter, file: f}, nil
+	default:
+		return nil, fmt.Errorf("unsupported %s %q, supported exporters are otlp, file and none", tracesExporterEnvVar, name)
+	}
+}
+
+// fileExporter exports spans to a file, and closes it once it's shut down.
+type fileExporter struct {
+	sdktrace.SpanExporter
+	file *os.File
+}
+
+func (e *fileExporter) Shutdown(ctx context.Context) error {
+	if err := e.SpanExporter.Shutdown(ctx); err != nil {
+		e.file.Close()
+		return err
+	}
+	return e.file.Close()
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package telemetry
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+)
+
+func TestStart(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Setenv(tracesExporterEnvVar, "")
+
+		shutdown, err := Start(ctx, "dev")
+		require.NoError(t, err)
+		require.NoError(t, shutdown(ctx))
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		t.Setenv(tracesExporterEnvVar, "zipkin")
+
+		_, err := Start(ctx, "dev")
+		require.ErrorContains(t, err, `unsupported OTEL_TRACES_EXPORTER "zipkin"`)
+	})
+
+	t.Run("unsupported protocol", func(t *testing.T) {
+		t.Setenv(tracesExporterEnvVar, "otlp")
+		t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "http/json")
+
+		_, err := Start(ctx, "dev")
+		require.ErrorContains(t, err, `unsupported OTLP protocol "http/json"`)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "traces.json")
+		t.Setenv(tracesExporterEnvVar, "file")
+		t.Setenv(tracesFileEnvVar, path)
+
+		shutdown, err := Start(ctx, "dev")
+		require.NoError(t, err)
+
+		_, span := otel.Tracer("test").Start(ctx, "ApplyResourceChange hcp_vault_cluster")
+		span.End()
+		require.NoError(t, shutdown(ctx))
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Contains(t, string(data), "ApplyResourceChange hcp_vault_cluster")
+		require.Contains(t, string(data), ServiceName)
+	})
+}
@@ -7,15 +7,16 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
-	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 
 	provider "github.com/hashicorp/terraform-provider-hcp/internal/provider"
 	providersdkv2 "github.com/hashicorp/terraform-provider-hcp/internal/providersdkv2"
+	"github.com/hashicorp/terraform-provider-hcp/internal/statuspage"
+	"github.com/hashicorp/terraform-provider-hcp/internal/telemetry"
 	"github.com/hashicorp/terraform-provider-hcp/version"
 )
 
@@ -35,6 +36,13 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	// Traces are exported as configured by the OTEL_* environment variables,
+	// the provider works without them if tracing can't be started.
+	shutdownTracing, err := telemetry.Start(context.Background(), version.ProviderVersion)
+	if err != nil {
+		log.Printf("[WARN] failed to start tracing: %v", err)
+	}
+
 	provider, err := New()
 	if err != nil {
 		return
@@ -47,6 +55,14 @@ func main() {
 	}
 
 	err = tf6server.Serve("registry.terraform.io/hashicorp/hcp", provider, serveOpts...)
+
+	// Flush the traces of the operations once Terraform stopped the provider.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("[WARN] failed to flush traces: %v", err)
+	}
+	cancel()
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -56,19 +72,16 @@ func New() (func() tfprotov6.ProviderServer, error) {
 	ctx := context.Background()
 
 	// Upgrade the provider sdkv2 version to protocol 6
-	upgradedSdkProvider, err := tf5to6server.UpgradeServer(
-		context.Background(),
-		providersdkv2.New()().GRPCProvider,
-	)
+	sdkProvider, err := providersdkv2.NewProtocol6(ctx, providersdkv2.New()())
 	if err != nil {
 		return nil, err
 	}
 
+	// Both providers defer resolving their HCP client until resources use
+	// it, such that configurations can be validated without network access.
 	providers := []func() tfprotov6.ProviderServer{
-		func() tfprotov6.ProviderServer {
-			return upgradedSdkProvider
-		},
-		providerserver.NewProtocol6(
+		sdkProvider,
+		provider.NewProtocol6(
 			provider.NewFrameworkProvider(version.ProviderVersion)(),
 		),
 	}
@@ -78,5 +91,7 @@ func New() (func() tfprotov6.ProviderServer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return muxServer.ProviderServer, nil
+	// Incidents reported on the HCP status page are only warned about once
+	// resources of the affected services are used.
+	return statuspage.NewProviderServer(muxServer.ProviderServer), nil
 }